@@ -0,0 +1,122 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up/internal/filesystem"
+	"github.com/upbound/up/internal/schemas/generator"
+	"github.com/upbound/up/internal/schemas/runner"
+	"github.com/upbound/up/internal/version"
+)
+
+// schemaCache stores generated schemas across runs, keyed by a hash of the
+// CRDs they were generated from. Schemas for a language are stored in a
+// directory named for the hash under the language's directory, so unchanged
+// CRDs never need to be regenerated.
+type schemaCache struct {
+	fs afero.Fs
+}
+
+// newSchemaCache returns a schema cache rooted at the given filesystem.
+func newSchemaCache(fs afero.Fs) *schemaCache {
+	return &schemaCache{fs: fs}
+}
+
+// GenerateFromCRD returns schemas for the CRDs in crdFS from the cache, using
+// gen to generate and cache them if they aren't already cached. It reports
+// whether the schemas came from the cache.
+func (c *schemaCache) GenerateFromCRD(ctx context.Context, gen generator.Interface, crdFS afero.Fs, r runner.SchemaRunner) (afero.Fs, bool, error) {
+	lang := gen.Language()
+	hash, err := crdHash(crdFS, lang)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to hash CRDs")
+	}
+	dir := filepath.Join(lang, hash)
+
+	ok, err := afero.DirExists(c.fs, dir)
+	if err != nil {
+		return nil, false, err
+	}
+	if ok {
+		return afero.NewReadOnlyFs(afero.NewBasePathFs(c.fs, dir)), true, nil
+	}
+
+	schemaFS, err := gen.GenerateFromCRD(ctx, crdFS, r)
+	if err != nil {
+		return nil, false, err
+	}
+	if schemaFS == nil {
+		return nil, false, nil
+	}
+	if err := c.put(dir, schemaFS); err != nil {
+		return nil, false, errors.Wrapf(err, "failed to cache schemas for language %s", lang)
+	}
+	return schemaFS, false, nil
+}
+
+// put stores schemas in the given cache directory. The schemas are written to
+// a temporary directory that's renamed into place, so that a partially written
+// directory is never mistaken for cached schemas.
+func (c *schemaCache) put(dir string, schemaFS afero.Fs) error {
+	if err := c.fs.MkdirAll(filepath.Dir(dir), 0o755); err != nil {
+		return err
+	}
+	tmp, err := afero.TempDir(c.fs, filepath.Dir(dir), ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer c.fs.RemoveAll(tmp) //nolint:errcheck // Nothing is left to remove once the directory has been renamed.
+
+	if err := filesystem.CopyFilesBetweenFs(schemaFS, afero.NewBasePathFs(c.fs, tmp)); err != nil {
+		return err
+	}
+	if err := c.fs.Rename(tmp, dir); err != nil {
+		// Another generator running concurrently may have cached the same
+		// schemas first.
+		if ok, _ := afero.DirExists(c.fs, dir); ok {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// crdHash returns a hash of the CRD files in crdFS, which identifies the
+// schemas generated from them for the given language by this version of up.
+func crdHash(crdFS afero.Fs, lang string) (string, error) {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s\x00%s\x00", version.Version(), lang)
+	err := afero.Walk(crdFS, ".", func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		f, err := crdFS.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close() //nolint:errcheck // Read-only file.
+
+		_, _ = fmt.Fprintf(h, "%s\x00%d\x00", path, info.Size())
+		_, err = io.Copy(h, f)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+
+	"github.com/upbound/up/internal/schemas/runner"
+)
+
+// countingGenerator generates a schema file per run, counting its runs.
+type countingGenerator struct {
+	runs int
+}
+
+func (g *countingGenerator) Language() string { return "test" }
+
+func (g *countingGenerator) GenerateFromCRD(_ context.Context, fs afero.Fs, _ runner.SchemaRunner) (afero.Fs, error) {
+	g.runs++
+	crd, err := afero.ReadFile(fs, "crd.yaml")
+	if err != nil {
+		return nil, err
+	}
+	out := afero.NewMemMapFs()
+	if err := afero.WriteFile(out, "models/schema.txt", append([]byte("schema for "), crd...), 0o644); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (g *countingGenerator) GenerateFromOpenAPI(context.Context, afero.Fs, runner.SchemaRunner) (afero.Fs, error) {
+	return nil, nil
+}
+
+func TestSchemaCacheGenerateFromCRD(t *testing.T) {
+	type run struct {
+		crd    string
+		cached bool
+		schema string
+	}
+
+	cases := map[string]struct {
+		reason string
+		runs   []run
+		want   int
+	}{
+		"IdenticalCRDs": {
+			reason: "A second run with identical CRDs should be a cache hit.",
+			runs: []run{
+				{crd: "a", schema: "schema for a"},
+				{crd: "a", cached: true, schema: "schema for a"},
+			},
+			want: 1,
+		},
+		"ChangedCRDs": {
+			reason: "A run with changed CRDs should regenerate schemas, and the previous schemas should remain cached.",
+			runs: []run{
+				{crd: "a", schema: "schema for a"},
+				{crd: "b", schema: "schema for b"},
+				{crd: "a", cached: true, schema: "schema for a"},
+			},
+			want: 2,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gen := &countingGenerator{}
+			cache := newSchemaCache(afero.NewMemMapFs())
+
+			for i, r := range tc.runs {
+				crdFS := afero.NewMemMapFs()
+				if err := afero.WriteFile(crdFS, "crd.yaml", []byte(r.crd), 0o644); err != nil {
+					t.Fatal(err)
+				}
+
+				schemaFS, cached, err := cache.GenerateFromCRD(context.Background(), gen, crdFS, nil)
+				if err != nil {
+					t.Fatalf("\n%s\nrun %d: GenerateFromCRD(...): unexpected error: %v", tc.reason, i, err)
+				}
+				if diff := cmp.Diff(r.cached, cached); diff != "" {
+					t.Errorf("\n%s\nrun %d: GenerateFromCRD(...): -want cached, +got cached:\n%s", tc.reason, i, diff)
+				}
+				schema, err := afero.ReadFile(schemaFS, "models/schema.txt")
+				if err != nil {
+					t.Fatalf("\n%s\nrun %d: ReadFile(...): unexpected error: %v", tc.reason, i, err)
+				}
+				if diff := cmp.Diff(r.schema, string(schema)); diff != "" {
+					t.Errorf("\n%s\nrun %d: GenerateFromCRD(...): -want schema, +got schema:\n%s", tc.reason, i, diff)
+				}
+			}
+
+			if diff := cmp.Diff(tc.want, gen.runs); diff != "" {
+				t.Errorf("\n%s\nGenerateFromCRD(...): -want generator runs, +got generator runs:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/afero"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	xpkgmarshaler "github.com/upbound/up/internal/xpkg/dep/marshaler/xpkg"
+)
+
+const (
+	errNoCRDs      = "the package contains no CRDs, so there are no schemas to generate; schema-generator only supports packages that contain CRDs, such as providers"
+	errNotCRDFmt   = "%s is not a CRD"
+	errWriteCRDFmt = "failed to write CRD %s"
+)
+
+// crdFS returns a filesystem containing the package's CRDs, with each CRD in a
+// file named for its group and plural name. Objects that aren't CRDs are
+// skipped, calling warn for each, unless strict is true, in which case they
+// return an error. An error is returned if the package contains no CRDs.
+func crdFS(pkg *xpkgmarshaler.ParsedPackage, strict bool, warn func(msg string)) (afero.Fs, error) {
+	fs := afero.NewMemMapFs()
+	n := 0
+	for _, o := range pkg.Objects() {
+		crd, ok := o.(*extv1.CustomResourceDefinition)
+		if !ok {
+			if strict {
+				return nil, errors.Errorf(errNotCRDFmt, describe(o))
+			}
+			warn(fmt.Sprintf("Skipping %s: not a CRD.", describe(o)))
+			continue
+		}
+
+		// CRDs decoded from packages don't necessarily have their type
+		// metadata set, but the generators require it.
+		crd = crd.DeepCopy()
+		crd.SetGroupVersionKind(extv1.SchemeGroupVersion.WithKind("CustomResourceDefinition"))
+		bs, err := yaml.Marshal(crd)
+		if err != nil {
+			return nil, errors.Wrapf(err, errWriteCRDFmt, crd.GetName())
+		}
+		if err := afero.WriteFile(fs, fmt.Sprintf("%s_%s.yaml", crd.Spec.Group, crd.Spec.Names.Plural), bs, 0o600); err != nil {
+			return nil, errors.Wrapf(err, errWriteCRDFmt, crd.GetName())
+		}
+		n++
+	}
+	if n == 0 {
+		return nil, errors.New(errNoCRDs)
+	}
+	return fs, nil
+}
+
+// describe returns a human-readable description of an object, such as
+// `Composition "xbuckets"`.
+func describe(o runtime.Object) string {
+	kind := o.GetObjectKind().GroupVersionKind().Kind
+	if kind == "" {
+		kind = fmt.Sprintf("%T", o)
+	}
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return kind
+	}
+	return fmt.Sprintf("%s %q", kind, m.GetName())
+}
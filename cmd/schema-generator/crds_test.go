@@ -0,0 +1,117 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	xpextv1 "github.com/crossplane/crossplane/v2/apis/apiextensions/v1"
+
+	xpkgmarshaler "github.com/upbound/up/internal/xpkg/dep/marshaler/xpkg"
+)
+
+func TestCRDFS(t *testing.T) {
+	crd := &extv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "buckets.s3.aws.upbound.io"},
+		Spec: extv1.CustomResourceDefinitionSpec{
+			Group: "s3.aws.upbound.io",
+			Names: extv1.CustomResourceDefinitionNames{Kind: "Bucket", Plural: "buckets"},
+		},
+	}
+	comp := &xpextv1.Composition{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apiextensions.crossplane.io/v1", Kind: "Composition"},
+		ObjectMeta: metav1.ObjectMeta{Name: "xbuckets"},
+	}
+
+	type args struct {
+		objs   []runtime.Object
+		strict bool
+	}
+	type want struct {
+		files    []string
+		warnings []string
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NoObjects": {
+			reason: "A package without objects has no CRDs to generate schemas for.",
+			args:   args{},
+			want: want{
+				err: errors.New(errNoCRDs),
+			},
+		},
+		"NoCRDs": {
+			reason: "A package without CRDs should return an error, rather than generate empty schemas.",
+			args: args{
+				objs: []runtime.Object{comp},
+			},
+			want: want{
+				warnings: []string{`Skipping Composition "xbuckets": not a CRD.`},
+				err:      errors.New(errNoCRDs),
+			},
+		},
+		"MixedObjects": {
+			reason: "Objects that aren't CRDs should be skipped with a warning.",
+			args: args{
+				objs: []runtime.Object{comp, crd},
+			},
+			want: want{
+				files:    []string{"s3.aws.upbound.io_buckets.yaml"},
+				warnings: []string{`Skipping Composition "xbuckets": not a CRD.`},
+			},
+		},
+		"MixedObjectsStrict": {
+			reason: "Objects that aren't CRDs should return an error in strict mode.",
+			args: args{
+				objs:   []runtime.Object{crd, comp},
+				strict: true,
+			},
+			want: want{
+				err: errors.Errorf(errNotCRDFmt, `Composition "xbuckets"`),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var warnings []string
+			fs, err := crdFS(&xpkgmarshaler.ParsedPackage{Objs: tc.args.objs}, tc.args.strict, func(msg string) {
+				warnings = append(warnings, msg)
+			})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ncrdFS(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.warnings, warnings); diff != "" {
+				t.Errorf("\n%s\ncrdFS(...): -want warnings, +got warnings:\n%s", tc.reason, diff)
+			}
+			if fs == nil {
+				return
+			}
+
+			var files []string
+			infos, err := afero.ReadDir(fs, ".")
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, i := range infos {
+				files = append(files, i.Name())
+			}
+			if diff := cmp.Diff(tc.want.files, files); diff != "" {
+				t.Errorf("\n%s\ncrdFS(...): -want files, +got files:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"os"
 	"sync"
 
 	"github.com/alecthomas/kong"
@@ -17,14 +16,13 @@ import (
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/mutate"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/spf13/afero"
 	"golang.org/x/sync/errgroup"
-	"golang.org/x/term"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
 
 	"github.com/upbound/up/internal/config"
 	"github.com/upbound/up/internal/schemas/generator"
-	"github.com/upbound/up/internal/schemas/manager"
 	"github.com/upbound/up/internal/schemas/runner"
 	"github.com/upbound/up/internal/upbound"
 	"github.com/upbound/up/internal/upterm"
@@ -40,14 +38,20 @@ type cli struct {
 	Pretty *bool         `env:"PRETTY"                help:"Pretty print output." name:"pretty"`
 	DryRun bool          `help:"dry-run output."      name:"dry-run"`
 
-	SourceImage string `help:"The source image to pull."    required:""`
-	TargetImage string `help:"The target image to push to." required:""`
+	SourceImage   string `help:"The source image to pull."                                                            required:""`
+	TargetImage   string `help:"The target image to push to."                                                         required:""`
+	RequireDigest bool   `help:"Require the source and target images to be referenced by digest, rather than by tag."`
 
 	PythonExcludes []string `help:"List of CRD filenames to exclude from Python schema generation."`
 	KclExcludes    []string `help:"List of CRD filenames to exclude from KCL schema generation."`
 	GoExcludes     []string `help:"List of CRD filenames to exclude from Go schema generation."`
 	JSONExcludes   []string `help:"List of CRD filenames to exclude from JSON schema generation."`
 
+	Strict bool `help:"Fail if the source image contains objects that aren't CRDs, rather than skipping them."`
+
+	SchemaCacheDir string `default:"~/.up/cache/schemas"                                                       help:"Directory used to cache generated schemas across runs." type:"path"`
+	NoSchemaCache  bool   `help:"Always generate schemas, rather than using schemas cached by a previous run."`
+
 	Flags upbound.Flags `embed:""`
 }
 
@@ -66,6 +70,14 @@ Examples:
 	--target-image docker.io/haarchri/provider-gcp-datalossprevention:v1.8.3 \
 	--python-excludes "datalossprevention.gcp.upbound.io_deidentifytemplates.yaml"
 		Pulls the source image, appends schema layers, but excludes specific CRD files from the Python schema generation, then pushes to the target image.
+
+Schemas generated from a set of CRDs are cached in --schema-cache-dir and reused
+by later runs for the same CRDs. Use --no-schema-cache to always regenerate them.
+
+The digest of the pushed target image is printed, so it can be pinned even when
+pushing to a tag. Use --require-digest to reject source and target images that
+are referenced only by tag. A target referenced by digest must match the digest
+of the generated image.
 `
 
 // AfterApply configures global settings before executing commands.
@@ -76,12 +88,7 @@ func (c *cli) AfterApply(ctx *kong.Context) error {
 	}
 	ctx.Bind(upCtx)
 
-	var pretty bool
-	if c.Pretty != nil {
-		pretty = *c.Pretty
-	} else {
-		pretty = term.IsTerminal(int(os.Stdout.Fd()))
-	}
+	pretty := upterm.ResolvePretty(c.Pretty, c.Quiet)
 
 	stdout := ctx.Stdout
 	if c.Quiet {
@@ -117,17 +124,35 @@ func (c *cli) Run(upCtx *upbound.Context, printer upterm.Printer) error {
 func (c *cli) generateSchema(ctx context.Context, upCtx *upbound.Context, printer upterm.Printer) error { //nolint:gocyclo // schemas
 	var (
 		processedImages []v1.Image
+		warned          = make(map[string]bool)
 		mu              sync.Mutex
 	)
 
+	// Each architecture's image contains the same package, so only warn
+	// about each of its objects once.
+	warn := func(msg string) {
+		mu.Lock()
+		defer mu.Unlock()
+		if !warned[msg] {
+			warned[msg] = true
+			printer.PrintWarning(msg)
+		}
+	}
+
 	// Explicitly pass the default keychain to remote.* calls so we look for Docker credentials.
 	keychain := remote.WithAuthFromKeychain(upCtx.RegistryKeychain())
+	transport := remote.WithTransport(upCtx.Transport())
 
-	indexRef, err := name.ParseReference(c.SourceImage, name.StrictValidation)
+	indexRef, err := parseImageRef("--source-image", c.SourceImage, c.RequireDigest)
+	if err != nil {
+		return err
+	}
+	targetRef, err := parseImageRef("--target-image", c.TargetImage, c.RequireDigest)
 	if err != nil {
-		return errors.Wrapf(err, "error parsing source image reference")
+		return err
 	}
-	index, err := remote.Index(indexRef, keychain)
+
+	index, err := remote.Index(indexRef, keychain, transport)
 	if err != nil {
 		return errors.Wrapf(err, "error pulling image index")
 	}
@@ -142,7 +167,7 @@ func (c *cli) generateSchema(ctx context.Context, upCtx *upbound.Context, printe
 	for _, desc := range indexManifest.Manifests {
 		g.Go(func() error {
 			digestRef := indexRef.Context().Digest(desc.Digest.String())
-			img, err := remote.Image(digestRef, keychain)
+			img, err := remote.Image(digestRef, keychain, transport)
 			if err != nil {
 				return errors.Wrapf(err, "error pulling architecture-specific image %s", desc.Digest)
 			}
@@ -162,8 +187,13 @@ func (c *cli) generateSchema(ctx context.Context, upCtx *upbound.Context, printe
 				return errors.Wrapf(err, "error parsing image")
 			}
 
+			fromFS, err := crdFS(parsedPkg, c.Strict, warn)
+			if err != nil {
+				return errors.Wrapf(err, "cannot generate schemas for %s", c.SourceImage)
+			}
+
 			err = printer.WrapWithSuccessSpinner("Schema Generation", func() error {
-				img, err = c.runSchemaGeneration(gCtx, parsedPkg, img, configFile.Config)
+				img, err = c.runSchemaGeneration(gCtx, fromFS, img, configFile.Config)
 				return err
 			})
 			if err != nil {
@@ -189,10 +219,14 @@ func (c *cli) generateSchema(ctx context.Context, upCtx *upbound.Context, printe
 		return errors.Wrapf(err, "error building multi-architecture index")
 	}
 
-	// Parse the target image reference
-	targetRef, err := name.ParseReference(c.TargetImage, name.StrictValidation)
+	digest, err := multiArchIndex.Digest()
 	if err != nil {
-		return errors.Wrapf(err, "error parsing target image reference")
+		return errors.Wrapf(err, "error computing multi-architecture index digest")
+	}
+	// A target referenced by digest can only be pushed if it's the digest of
+	// the index we built.
+	if d, ok := targetRef.(name.Digest); ok && d.DigestStr() != digest.String() {
+		return errors.Errorf("built index digest %s does not match target image digest %s", digest, d.DigestStr())
 	}
 
 	// Push the new multi-arch index using remote.WriteIndex
@@ -200,30 +234,37 @@ func (c *cli) generateSchema(ctx context.Context, upCtx *upbound.Context, printe
 		return remote.WriteIndex(
 			targetRef,
 			multiArchIndex,
-			keychain)
+			keychain,
+			transport)
 	})
 	if err != nil {
 		return errors.Wrapf(err, "error pushing multi-arch image to registry %v", c.TargetImage)
 	}
 
+	// Print the pushed index's digest so it can be pinned, even if the target
+	// was referenced by tag.
+	printer.PrintResult(targetRef.Context().Digest(digest.String()).String())
+
 	return nil
 }
 
-// runSchemaGeneration generates the schema and applies mutators to the base configuration.
-func (c *cli) runSchemaGeneration(ctx context.Context, pkg *xpkgmarshaler.ParsedPackage, image v1.Image, cfg v1.Config) (v1.Image, error) {
+// runSchemaGeneration generates schemas for the CRDs in fromFS and applies
+// mutators to the base configuration.
+func (c *cli) runSchemaGeneration(ctx context.Context, fromFS afero.Fs, image v1.Image, cfg v1.Config) (v1.Image, error) {
 	generators := generator.AllLanguages()
 	r := runner.NewRealSchemaRunner()
+	cache := newSchemaCache(afero.NewBasePathFs(afero.NewOsFs(), c.SchemaCacheDir))
 
-	src := manager.NewXpkgSource(pkg)
-	fromFS, err := src.Resources(ctx)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get resources from package")
-	}
-
+	var err error
 	for _, gen := range generators {
 		lang := gen.Language()
 
-		schemaFS, err := gen.GenerateFromCRD(ctx, fromFS, r)
+		var schemaFS afero.Fs
+		if c.NoSchemaCache {
+			schemaFS, err = gen.GenerateFromCRD(ctx, fromFS, r)
+		} else {
+			schemaFS, _, err = cache.GenerateFromCRD(ctx, gen, fromFS, r)
+		}
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to generate schemas for language %s", lang)
 		}
@@ -0,0 +1,25 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package main
+
+import (
+	"github.com/google/go-containerregistry/pkg/name"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+const errDigestRequiredFmt = "%s %q must be referenced by digest (e.g. registry/repository@sha256:...) when --require-digest is set"
+
+// parseImageRef parses an image reference given by the named flag. If
+// requireDigest is true the reference must include a digest.
+func parseImageRef(flag, ref string, requireDigest bool) (name.Reference, error) {
+	r, err := name.ParseReference(ref, name.StrictValidation)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing %s reference", flag)
+	}
+	if _, ok := r.(name.Digest); requireDigest && !ok {
+		return nil, errors.Errorf(errDigestRequiredFmt, flag, ref)
+	}
+	return r, nil
+}
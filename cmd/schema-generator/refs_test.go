@@ -0,0 +1,86 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestParseImageRef(t *testing.T) {
+	const digest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	type args struct {
+		ref           string
+		requireDigest bool
+	}
+	type want struct {
+		ref string
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Tag": {
+			reason: "A tag reference should be accepted when a digest isn't required.",
+			args: args{
+				ref: "xpkg.upbound.io/upbound/provider-aws-s3:v1.0.0",
+			},
+			want: want{
+				ref: "xpkg.upbound.io/upbound/provider-aws-s3:v1.0.0",
+			},
+		},
+		"TagDigestRequired": {
+			reason: "A tag reference should be rejected when a digest is required.",
+			args: args{
+				ref:           "xpkg.upbound.io/upbound/provider-aws-s3:v1.0.0",
+				requireDigest: true,
+			},
+			want: want{
+				err: errors.Errorf(errDigestRequiredFmt, "--source-image", "xpkg.upbound.io/upbound/provider-aws-s3:v1.0.0"),
+			},
+		},
+		"DigestDigestRequired": {
+			reason: "A digest reference should be accepted when a digest is required.",
+			args: args{
+				ref:           "xpkg.upbound.io/upbound/provider-aws-s3@" + digest,
+				requireDigest: true,
+			},
+			want: want{
+				ref: "xpkg.upbound.io/upbound/provider-aws-s3@" + digest,
+			},
+		},
+		"Invalid": {
+			reason: "An invalid reference should return an error.",
+			args: args{
+				ref: "xpkg.upbound.io/upbound/provider-aws-s3",
+			},
+			want: want{
+				err: errors.Wrap(errors.New("could not parse reference: xpkg.upbound.io/upbound/provider-aws-s3"), "error parsing --source-image reference"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ref, err := parseImageRef("--source-image", tc.args.ref, tc.args.requireDigest)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nparseImageRef(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			var got string
+			if ref != nil {
+				got = ref.String()
+			}
+			if diff := cmp.Diff(tc.want.ref, got); diff != "" {
+				t.Errorf("\n%s\nparseImageRef(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
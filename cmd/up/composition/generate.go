@@ -76,11 +76,12 @@ const (
 )
 
 type generateCmd struct {
-	Resource  string `arg:""                                                          help:"File path to Composite Resource Claim (XRC) or Composite Resource (XR) or CompositeResourceDefinition (XRD)." required:""`
-	Name      string `help:"Name for the new composition."                            optional:""`
-	Plural    string `help:"Optional custom plural for the CompositeTypeRef.Kind"     optional:""`
-	Input     string `default:""                                                      enum:"rgd,ResourceGraphDefinition,"                                                                                 help:"Input format: rgd or ResourceGraphDefinition." optional:""`
-	InputFile string `help:"Path to input file (e.g., ResourceGraphDefinition file)." optional:""`
+	Resource          string `arg:""                                                                                                                                   help:"File path to Composite Resource Claim (XRC) or Composite Resource (XR) or CompositeResourceDefinition (XRD)." required:""`
+	Name              string `help:"Name for the new composition."                                                                                                     optional:""`
+	Plural            string `help:"Optional custom plural for the CompositeTypeRef.Kind"                                                                              optional:""`
+	Input             string `default:""                                                                                                                               enum:"rgd,ResourceGraphDefinition,"                                                                                 help:"Input format: rgd or ResourceGraphDefinition." optional:""`
+	InputFile         string `help:"Path to input file (e.g., ResourceGraphDefinition file)."                                                                          optional:""`
+	ProviderConfigRef string `help:"Name of the provider config that managed resource templates in generated function inputs use. Existing references aren't changed." optional:""`
 
 	Path        string `help:"Optional path to the output file where the generated Composition will be saved." optional:""`
 	ProjectFile string `default:"upbound.yaml"                                                                 help:"Path to project definition file." short:"f"`
@@ -145,10 +146,9 @@ func (c *generateCmd) Run(ctx context.Context, p upterm.Printer) error { //nolin
 		return errors.Wrap(err, "failed to create composition")
 	}
 
-	// Convert Composition to YAML format
-	compositionYAML, err := yaml.Marshal(composition)
+	compositionYAML, err := marshalComposition(composition)
 	if err != nil {
-		return errors.Wrap(err, "failed to marshal composition to yaml")
+		return err
 	}
 
 	switch c.Output {
@@ -214,6 +214,16 @@ func (c *generateCmd) Run(ctx context.Context, p upterm.Printer) error { //nolin
 	return nil
 }
 
+// marshalComposition converts a Composition to YAML. The YAML marshaler
+// converts through JSON and sorts object keys, including those of function
+// inputs, so the same Composition always produces the same bytes and
+// regenerated files only differ where the Composition does. Pipeline steps keep
+// their order.
+func marshalComposition(composition *apiextv1.Composition) ([]byte, error) {
+	b, err := yaml.Marshal(composition)
+	return b, errors.Wrap(err, "failed to marshal composition to yaml")
+}
+
 // newComposition to create a new Composition.
 func (c *generateCmd) newComposition(ctx context.Context) (*apiextv1.Composition, string, error) { //nolint:gocyclo // construct the composition
 	group, version, kind, plural, matchLabels, err := c.processResource()
@@ -319,9 +329,74 @@ func (c *generateCmd) createPipelineFromProject(ctx context.Context) ([]apiextv1
 		return nil, errors.New("no functions found")
 	}
 
+	if c.ProviderConfigRef != "" {
+		for i := range pipelineSteps {
+			if err := setProviderConfigRef(&pipelineSteps[i], c.ProviderConfigRef); err != nil {
+				return nil, errors.Wrapf(err, "failed to set provider config reference for step %s", pipelineSteps[i].Step)
+			}
+		}
+	}
+
 	return reorderPipelineSteps(pipelineSteps), nil
 }
 
+// setProviderConfigRef sets the provider config reference of the managed
+// resource templates in a step's input. Both patch-and-transform inputs, whose
+// resources have a base, and kro inputs, whose resources have a template, are
+// supported. Resources in a Kubernetes API group, which have no dots, aren't
+// managed resources and are left alone, as are templates that already
+// reference a provider config. The input is unchanged if it has no templates
+// to update.
+func setProviderConfigRef(step *apiextv1.PipelineStep, name string) error {
+	if step.Input == nil || len(step.Input.Raw) == 0 {
+		return nil
+	}
+
+	var input map[string]any
+	if err := json.Unmarshal(step.Input.Raw, &input); err != nil {
+		return errors.Wrap(err, "failed to unmarshal input")
+	}
+	resources, ok := input["resources"].([]any)
+	if !ok {
+		return nil
+	}
+
+	changed := false
+	for _, r := range resources {
+		res, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+		for _, key := range []string{"base", "template"} {
+			tmpl, ok := res[key].(map[string]any)
+			if !ok {
+				continue
+			}
+			u := unstructured.Unstructured{Object: tmpl}
+			if !strings.Contains(u.GroupVersionKind().Group, ".") {
+				continue
+			}
+			if _, found, _ := unstructured.NestedFieldNoCopy(tmpl, "spec", "providerConfigRef"); found {
+				continue
+			}
+			if err := unstructured.SetNestedField(tmpl, name, "spec", "providerConfigRef", "name"); err != nil {
+				return errors.Wrap(err, "failed to set provider config reference")
+			}
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal input")
+	}
+	step.Input = &runtime.RawExtension{Raw: raw}
+	return nil
+}
+
 // ensureFunction is a generic helper to ensure a function dependency exists.
 func (c *generateCmd) ensureFunction(ctx context.Context, fnDeps []pkgmetav1.Dependency, packageName, functionName string) ([]pkgmetav1.Dependency, error) {
 	for _, dep := range fnDeps {
@@ -397,6 +472,7 @@ func isFunction(dep pkgmetav1.Dependency) bool {
 }
 
 // reorderPipelineSteps ensures the step with functionref.name == "crossplane-contrib-function-auto-ready" is the last one.
+// The other steps keep the order of the project's dependencies.
 func reorderPipelineSteps(pipelineSteps []apiextv1.PipelineStep) []apiextv1.PipelineStep {
 	var reorderedSteps []apiextv1.PipelineStep
 	var autoReadyStep *apiextv1.PipelineStep
@@ -443,8 +519,10 @@ func (c *generateCmd) setRawExtension(crd apiextensionsv1.CustomResourceDefiniti
 		rawExtensionContent = patTemplate
 	default:
 		// nothing matches so we generate the default required fields
-		// only required fields from function crd
-		yamlData, err := xcrd.GenerateExample(crd, true, false)
+		// only required fields from function crd. Random values for fields
+		// with a pattern are skipped so that regenerating a composition
+		// produces the same input.
+		yamlData, err := xcrd.GenerateExample(crd, true, true)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed generating schema")
 		}
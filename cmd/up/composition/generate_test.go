@@ -5,15 +5,18 @@ package composition
 
 import (
 	"embed"
+	"encoding/json"
 	"io/fs"
 	"net/url"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/spf13/afero"
 	"gotest.tools/v3/assert"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -213,44 +216,9 @@ func TestNewComposition(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			outFS := afero.NewMemMapFs()
-			// Set up a mock cache directory in Afero
-			cchFS := afero.NewBasePathFs(outFS, "/cache")
-
-			// Embed test data into projectFS
-			projFS := afero.NewMemMapFs()
-			err := embedToAferoFS(tc.embeddedFS, projFS, "testdata", "/")
-			assert.NilError(t, err)
-
-			// Parse project config
-			proj, err := project.Parse(projFS, "/upbound.yaml")
-			assert.NilError(t, err)
-			proj.Default()
-
-			ep, err := url.Parse("https://donotuse.example.com")
-			assert.NilError(t, err)
-			upCtx := &upbound.Context{
-				Domain:           &url.URL{},
-				RegistryEndpoint: ep,
-			}
-			dm, err := project.NewDependencyManager(upCtx, proj, projFS,
-				project.WithFetcher(&image.FSFetcher{FS: tc.packages}),
-				project.WithSchemaGenerators(nil),
-				project.WithCacheFS(cchFS),
-				project.WithProjectFile("/upbound.yaml"),
-			)
-			assert.NilError(t, err)
-
-			generateCmd := generateCmd{
-				Name:        tc.name,
-				Plural:      tc.plural,
-				Resource:    "/test.yaml",
-				ProjectFile: "/upbound.yaml",
-				proj:        proj,
-				projFS:      projFS,
-				apisFS:      projFS,
-				depManager:  dm,
-			}
+			generateCmd := newTestGenerateCmd(t, tc.embeddedFS, tc.packages)
+			generateCmd.Name = tc.name
+			generateCmd.Plural = tc.plural
 
 			// Call newComposition and check results
 			got, _, err := generateCmd.newComposition(t.Context())
@@ -264,6 +232,183 @@ func TestNewComposition(t *testing.T) {
 	}
 }
 
+func TestSetProviderConfigRef(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		input  string
+		want   string
+	}{
+		"PatchAndTransform": {
+			reason: "Managed resource bases in a patch-and-transform input should reference the provider config.",
+			input:  `{"apiVersion":"pt.fn.crossplane.io/v1beta1","kind":"Resources","resources":[{"name":"bucket","base":{"apiVersion":"s3.aws.upbound.io/v1beta1","kind":"Bucket","spec":{"forProvider":{"region":"us-east-1"}}}}]}`,
+			want:   `{"apiVersion":"pt.fn.crossplane.io/v1beta1","kind":"Resources","resources":[{"name":"bucket","base":{"apiVersion":"s3.aws.upbound.io/v1beta1","kind":"Bucket","spec":{"forProvider":{"region":"us-east-1"},"providerConfigRef":{"name":"team-a"}}}}]}`,
+		},
+		"ResourceGraph": {
+			reason: "Managed resource templates in a kro input should reference the provider config.",
+			input:  `{"apiVersion":"kro.fn.crossplane.io/v1beta1","kind":"ResourceGraph","resources":[{"id":"vpc","template":{"apiVersion":"ec2.aws.upbound.io/v1beta1","kind":"VPC"}}]}`,
+			want:   `{"apiVersion":"kro.fn.crossplane.io/v1beta1","kind":"ResourceGraph","resources":[{"id":"vpc","template":{"apiVersion":"ec2.aws.upbound.io/v1beta1","kind":"VPC","spec":{"providerConfigRef":{"name":"team-a"}}}}]}`,
+		},
+		"KubernetesResourcesUnchanged": {
+			reason: "Resources in Kubernetes API groups aren't managed resources and shouldn't be changed.",
+			input:  `{"apiVersion":"kro.fn.crossplane.io/v1beta1","kind":"ResourceGraph","resources":[{"id":"config","template":{"apiVersion":"v1","kind":"ConfigMap"}},{"id":"app","template":{"apiVersion":"apps/v1","kind":"Deployment"}}]}`,
+			want:   `{"apiVersion":"kro.fn.crossplane.io/v1beta1","kind":"ResourceGraph","resources":[{"id":"config","template":{"apiVersion":"v1","kind":"ConfigMap"}},{"id":"app","template":{"apiVersion":"apps/v1","kind":"Deployment"}}]}`,
+		},
+		"ExistingReferenceUnchanged": {
+			reason: "Templates that already reference a provider config shouldn't be changed.",
+			input:  `{"apiVersion":"pt.fn.crossplane.io/v1beta1","kind":"Resources","resources":[{"name":"bucket","base":{"apiVersion":"s3.aws.upbound.io/v1beta1","kind":"Bucket","spec":{"providerConfigRef":{"name":"default","kind":"ClusterProviderConfig"}}}}]}`,
+			want:   `{"apiVersion":"pt.fn.crossplane.io/v1beta1","kind":"Resources","resources":[{"name":"bucket","base":{"apiVersion":"s3.aws.upbound.io/v1beta1","kind":"Bucket","spec":{"providerConfigRef":{"name":"default","kind":"ClusterProviderConfig"}}}}]}`,
+		},
+		"EmptyTemplate": {
+			reason: "The default patch-and-transform template has no resources and should be left as is.",
+			input:  patTemplate,
+			want:   patTemplate,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			step := v1.PipelineStep{Step: "step", Input: &runtime.RawExtension{Raw: []byte(tc.input)}}
+			err := setProviderConfigRef(&step, "team-a")
+			assert.NilError(t, err)
+
+			var got, want map[string]any
+			assert.NilError(t, json.Unmarshal(step.Input.Raw, &got))
+			assert.NilError(t, json.Unmarshal([]byte(tc.want), &want))
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("\n%s\nsetProviderConfigRef(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSetRawExtensionDeterministic(t *testing.T) {
+	crd := apiextensionsv1.CustomResourceDefinition{
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "example.fn.crossplane.io",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: "Input"},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{
+				Name:    "v1beta1",
+				Served:  true,
+				Storage: true,
+				Schema: &apiextensionsv1.CustomResourceValidation{
+					OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+						Type:     "object",
+						Required: []string{"spec"},
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"spec": {
+								Type:     "object",
+								Required: []string{"name"},
+								Properties: map[string]apiextensionsv1.JSONSchemaProps{
+									"name": {Type: "string", Pattern: "^[a-z]{8}$"},
+								},
+							},
+						},
+					},
+				},
+			}},
+		},
+	}
+
+	c := &generateCmd{}
+	first, err := c.setRawExtension(crd)
+	assert.NilError(t, err)
+	second, err := c.setRawExtension(crd)
+	assert.NilError(t, err)
+
+	// Fields with a pattern shouldn't get a random value that changes each
+	// time a composition is generated.
+	if diff := cmp.Diff(string(first.Raw), string(second.Raw)); diff != "" {
+		t.Errorf("setRawExtension(...): -first, +second:\n%s", diff)
+	}
+}
+
+func TestGenerateDeterministic(t *testing.T) {
+	xrd, err := projectCFS.ReadFile("testdata/projectC/test.yaml")
+	assert.NilError(t, err)
+
+	generate := func() []byte {
+		generateCmd := newTestGenerateCmd(t, projectAFS, afero.NewBasePathFs(afero.FromIOFS{FS: packagesFS}, "testdata/packages"))
+		generateCmd.Resource = "/xrd.yaml"
+		assert.NilError(t, afero.WriteFile(generateCmd.projFS, generateCmd.Resource, xrd, 0o644))
+
+		composition, _, err := generateCmd.newComposition(t.Context())
+		assert.NilError(t, err)
+		b, err := marshalComposition(composition)
+		assert.NilError(t, err)
+		return b
+	}
+
+	first, second := generate(), generate()
+	if diff := cmp.Diff(string(first), string(second)); diff != "" {
+		t.Errorf("marshalComposition(...): regenerated composition: -first, +second:\n%s", diff)
+	}
+
+	var fields, steps []string
+	for _, line := range strings.Split(string(first), "\n") {
+		if line != "" && !strings.HasPrefix(line, " ") {
+			fields = append(fields, strings.TrimSuffix(strings.Fields(line)[0], ":"))
+		}
+		if step, ok := strings.CutPrefix(line, "    step: "); ok {
+			steps = append(steps, step)
+		}
+	}
+	if diff := cmp.Diff([]string{"apiVersion", "kind", "metadata", "spec"}, fields); diff != "" {
+		t.Errorf("marshalComposition(...): -want field order, +got field order:\n%s", diff)
+	}
+	wantSteps := []string{
+		"crossplane-contrib-function-kcl",
+		"crossplane-contrib-function-go-templating",
+		"crossplane-contrib-function-patch-and-transform",
+		"crossplane-contrib-function-auto-ready",
+	}
+	if diff := cmp.Diff(wantSteps, steps); diff != "" {
+		t.Errorf("marshalComposition(...): -want steps, +got steps:\n%s", diff)
+	}
+}
+
+// newTestGenerateCmd returns a generateCmd for the test project in the
+// embedded FS, with dependencies fetched from the given packages.
+func newTestGenerateCmd(t *testing.T, embeddedFS embed.FS, packages afero.Fs) generateCmd {
+	t.Helper()
+
+	outFS := afero.NewMemMapFs()
+	// Set up a mock cache directory in Afero
+	cchFS := afero.NewBasePathFs(outFS, "/cache")
+
+	// Embed test data into projectFS
+	projFS := afero.NewMemMapFs()
+	err := embedToAferoFS(embeddedFS, projFS, "testdata", "/")
+	assert.NilError(t, err)
+
+	// Parse project config
+	proj, err := project.Parse(projFS, "/upbound.yaml")
+	assert.NilError(t, err)
+	proj.Default()
+
+	ep, err := url.Parse("https://donotuse.example.com")
+	assert.NilError(t, err)
+	upCtx := &upbound.Context{
+		Domain:           &url.URL{},
+		RegistryEndpoint: ep,
+	}
+	dm, err := project.NewDependencyManager(upCtx, proj, projFS,
+		project.WithFetcher(&image.FSFetcher{FS: packages}),
+		project.WithSchemaGenerators(nil),
+		project.WithCacheFS(cchFS),
+		project.WithProjectFile("/upbound.yaml"),
+	)
+	assert.NilError(t, err)
+
+	return generateCmd{
+		Resource:    "/test.yaml",
+		ProjectFile: "/upbound.yaml",
+		proj:        proj,
+		projFS:      projFS,
+		apisFS:      projFS,
+		depManager:  dm,
+	}
+}
+
 // embedToAferoFS walks through an embedded FS and writes files to Afero FS.
 func embedToAferoFS(embeddedFS embed.FS, aferoFS afero.Fs, sourceDir string, targetDir string) error {
 	err := fs.WalkDir(embeddedFS, sourceDir, func(path string, d fs.DirEntry, err error) error {
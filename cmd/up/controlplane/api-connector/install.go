@@ -250,7 +250,7 @@ func (c *installCmd) deploy(ctx context.Context, upCtx *upbound.Context, printer
 			provisioner.results.OrganizationName = c.organization
 		} else { // No token provided, so we need to create a robot and a token, and wire things up.
 			// Step 2.1: Read organization configuration.
-			printer.Printfln("Creating a robot named %s in the organization %s.", nice(c.name), nice(upCtx.Profile.Organization))
+			printer.Printfln("Creating a robot named %s in the organization %s.", nice(c.name), nice(upCtx.Organization))
 			if err := printer.WrapWithSuccessSpinner(
 				upterm.StepCounter("Reading organization configuration", 2, totalSteps),
 				func() error {
@@ -0,0 +1,167 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package controlplane
+
+import (
+	"context"
+	"strings"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+	"github.com/upbound/up/cmd/up/controlplane/requires"
+	ctxcmd "github.com/upbound/up/cmd/up/ctx"
+	intctx "github.com/upbound/up/internal/ctx"
+	"github.com/upbound/up/internal/kube"
+	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/upterm"
+)
+
+// connectCmd writes a kubeconfig context for a control plane in the current
+// space, without navigating to it interactively.
+type connectCmd struct {
+	requires.Space
+
+	ControlPlane string `arg:""                                                                                                                         help:"The control plane to connect to, as <group>/<name>. If only a name is given, the group of the current context is used." predictor:"ctps"                         required:""`
+	KubeContext  string `default:"upbound"                                                                                                              env:"UP_CONTEXT"                                                                                                              help:"Kubernetes context to operate on." name:"context"`
+	File         string `help:"Kubeconfig to modify when saving the new context. Overrides the --kubeconfig flag. Use '-' to write to standard output." short:"f"`
+	PrintServer  bool   `help:"Print the control plane's API server URL without modifying the kubeconfig."`
+
+	group string
+	name  string
+}
+
+// AfterApply sets default values in command after assignment and validation.
+func (c *connectCmd) AfterApply(upCtx *upbound.Context) error {
+	group, name, err := resolveControlPlanePath(upCtx, c.ControlPlane)
+	if err != nil {
+		return err
+	}
+	c.group, c.name = group, name
+	return nil
+}
+
+// resolveControlPlanePath splits a control plane path of the form
+// [<group>/]<name> into its group and name, using the group of the current
+// context if the path doesn't include one.
+func resolveControlPlanePath(upCtx *upbound.Context, path string) (group, name string, err error) {
+	group, name, err = parseControlPlanePath(path)
+	if err != nil {
+		return "", "", err
+	}
+	if group == "" {
+		group, err = upCtx.GetCurrentContextNamespace()
+		if err != nil {
+			return "", "", err
+		}
+	}
+	return group, name, nil
+}
+
+// parseControlPlanePath splits a control plane path of the form
+// [<group>/]<name> into its group and name.
+func parseControlPlanePath(path string) (group, name string, err error) {
+	parts := strings.Split(path, "/")
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		return "", parts[0], nil
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return parts[0], parts[1], nil
+	default:
+		return "", "", errors.Errorf("invalid control plane %q: must be of the form <group>/<name>", path)
+	}
+}
+
+// controlPlaneClientset returns a clientset for the named control plane in the
+// current space, built from the space's kubeconfig for the control plane. It
+// also returns the current space.
+func controlPlaneClientset(ctx context.Context, upCtx *upbound.Context, cl client.Client, nn types.NamespacedName) (kubernetes.Interface, ctxcmd.Space, error) {
+	space, _, err := intctx.GetCurrentGroup(ctx, upCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ctp spacesv1beta1.ControlPlane
+	if err := cl.Get(ctx, nn, &ctp); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, nil, errors.Errorf("control plane %q not found in group %q of space %q", nn.Name, nn.Namespace, space.Name())
+		}
+		return nil, nil, errors.Wrap(err, "error getting control plane")
+	}
+
+	kubeconfig, err := space.BuildKubeconfig(nn)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot build control plane kubeconfig")
+	}
+	rest, err := kubeconfig.ClientConfig()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot build control plane kubeconfig")
+	}
+	cs, err := kubernetes.NewForConfig(rest)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot create control plane client")
+	}
+	return cs, space, nil
+}
+
+// Run executes the connect command.
+func (c *connectCmd) Run(ctx context.Context, upCtx *upbound.Context, p upterm.Printer, cl client.Client) error {
+	space, _, err := intctx.GetCurrentGroup(ctx, upCtx)
+	if err != nil {
+		return err
+	}
+
+	var ctp spacesv1beta1.ControlPlane
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: c.group, Name: c.name}, &ctp); err != nil {
+		if kerrors.IsNotFound(err) {
+			return errors.Errorf("control plane %q not found in group %q of space %q", c.name, c.group, space.Name())
+		}
+		return errors.Wrap(err, "error getting control plane")
+	}
+
+	path := append(space.Breadcrumbs(), c.group, c.name).String()
+	conf, err := ctxcmd.GetKubeconfigForPath(ctx, upCtx, path)
+	if err != nil {
+		return errors.Wrapf(err, "cannot get kubeconfig for control plane %q", path)
+	}
+
+	if c.PrintServer {
+		server, err := ctxcmd.ServerURL(conf)
+		if err != nil {
+			return err
+		}
+		p.PrintResult(server)
+		return nil
+	}
+
+	if c.File == "-" {
+		b, err := clientcmd.Write(*conf)
+		if err != nil {
+			return err
+		}
+		p.PrintResult(string(b))
+		return nil
+	}
+
+	if err := kube.NewFileWriter(upCtx, c.File, c.KubeContext).Write(conf); err != nil {
+		return err
+	}
+
+	upCtx.Profile.CurrentKubeContext = path
+	if err := upCtx.Cfg.AddOrUpdateUpboundProfile(upCtx.ProfileName, upCtx.Profile); err != nil {
+		return err
+	}
+	if err := upCtx.CfgSrc.UpdateConfig(upCtx.Cfg); err != nil {
+		return err
+	}
+
+	p.Printfln("Kubeconfig context %q: %s", c.KubeContext, path)
+	return nil
+}
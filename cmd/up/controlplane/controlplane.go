@@ -5,6 +5,7 @@ package controlplane
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/alecthomas/kong"
@@ -54,38 +55,69 @@ func (c *Cmd) AfterApply(kongCtx *kong.Context, upCtx *upbound.Context) error {
 	return nil
 }
 
-// PredictControlPlanes provides a predictor for control planes.
+// predictTimeout bounds how long completion waits for the space, so that
+// completing while offline doesn't hang the shell.
+const predictTimeout = 5 * time.Second
+
+// PredictControlPlanes provides a predictor for control planes in the group of
+// the current context. If the word being completed has a group prefix, as in
+// <group>/<name>, control planes in that group are predicted instead. No
+// control planes are predicted if the current context isn't a space or group
+// context, or the space can't be reached.
 // This function is used by the kongplete.Complete package for shell autocompletion.
 func PredictControlPlanes() complete.Predictor {
-	return complete.PredictFunc(func(_ complete.Args) (prediction []string) {
-		upCtx, err := upbound.NewFromFlags(upbound.Flags{})
+	return complete.PredictFunc(func(a complete.Args) (prediction []string) {
+		upCtx, err := upbound.NewFromFlags(upbound.Flags{}, upbound.HideLogging())
 		if err != nil {
 			return nil
 		}
 		upCtx.SetupLogging()
 
-		client, err := upCtx.BuildCurrentContextClient()
-		if err != nil {
+		if _, ctp, inSpace := upCtx.GetCurrentSpaceContextScope(); !inSpace || ctp.Name != "" {
 			return nil
 		}
 
-		var l spacesv1beta1.ControlPlaneList
-		if err := client.List(context.Background(), &l); err != nil {
-			return nil
+		group, _, prefixed := strings.Cut(a.Last, "/")
+		if !prefixed {
+			if group, err = upCtx.GetCurrentContextNamespace(); err != nil {
+				return nil
+			}
 		}
 
-		if len(l.Items) == 0 {
+		cl, err := upCtx.BuildCurrentContextClient()
+		if err != nil {
 			return nil
 		}
 
-		data := make([]string, len(l.Items))
-		for i, ctp := range l.Items {
-			data[i] = ctp.Name
+		ctx, cancel := context.WithTimeout(context.Background(), predictTimeout)
+		defer cancel()
+
+		var l spacesv1beta1.ControlPlaneList
+		if err := cl.List(ctx, &l, client.InNamespace(group)); err != nil {
+			return nil
 		}
-		return data
+
+		return controlPlaneNames(l.Items, prefixed)
 	})
 }
 
+// controlPlaneNames returns the names of the control planes, prefixed with
+// their group if prefixed is true.
+func controlPlaneNames(ctps []spacesv1beta1.ControlPlane, prefixed bool) []string {
+	if len(ctps) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(ctps))
+	for i, ctp := range ctps {
+		names[i] = ctp.GetName()
+		if prefixed {
+			names[i] = ctp.GetNamespace() + "/" + ctp.GetName()
+		}
+	}
+	return names
+}
+
 // Cmd contains commands for interacting with control planes.
 //
 // Each subcommand struct must embed a struct from the `requires` package to
@@ -95,10 +127,13 @@ type Cmd struct {
 
 	// Commands for managing control planes in Spaces. These require a space
 	// context.
-	Create createCmd `cmd:"" help:"Create a Spaces control plane."`
-	Delete deleteCmd `cmd:"" help:"Delete a Spaces control plane."`
-	List   listCmd   `cmd:"" help:"List control planes in a Space."`
-	Get    getCmd    `cmd:"" help:"Get a single Spaces control plane."`
+	Create  createCmd  `cmd:"" help:"Create a Spaces control plane."`
+	Delete  deleteCmd  `cmd:"" help:"Delete a Spaces control plane."`
+	List    listCmd    `cmd:"" help:"List control planes in a Space."`
+	Get     getCmd     `cmd:"" help:"Get a single Spaces control plane."`
+	Connect connectCmd `cmd:"" help:"Write a kubeconfig context for a Spaces control plane without navigating to it."`
+	Logs    logsCmd    `cmd:"" help:"Stream logs from the Crossplane, provider, and function pods of a Spaces control plane."`
+	Events  eventsCmd  `cmd:"" help:"List recent events in a Spaces control plane."`
 
 	// Commands for managing the connector. These require a control plane
 	// context.
@@ -135,7 +170,7 @@ type Cmd struct {
 func extractSpaceFields(obj any) []string {
 	ctp, ok := obj.(spacesv1beta1.ControlPlane)
 	if !ok {
-		return []string{"unknown", "unknown", "", "", "", "", ""}
+		return []string{"unknown", "unknown", "", "", "", "", "", ""}
 	}
 
 	v := ""
@@ -146,6 +181,7 @@ func extractSpaceFields(obj any) []string {
 	return []string{
 		ctp.GetNamespace(),
 		ctp.GetName(),
+		ctp.Spec.Class,
 		v,
 		string(ctp.GetCondition(xpcommonv1.TypeReady).Status),
 		string(ctp.GetCondition(spacesv1beta1.ConditionTypeHealthy).Status),
@@ -166,6 +202,7 @@ func tabularPrint(obj any, printer upterm.ResultPrinter) error {
 	spacefieldNames := []string{
 		"GROUP",
 		"NAME",
+		"CLASS",
 		"CROSSPLANE",
 		"READY",
 		"HEALTHY",
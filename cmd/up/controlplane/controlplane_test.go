@@ -5,10 +5,17 @@ package controlplane
 
 import (
 	"testing"
+	"time"
 
 	"github.com/alecthomas/kong"
 	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
+	xpcommonv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
 	"github.com/upbound/up/cmd/up/controlplane/requires"
 )
 
@@ -25,3 +32,260 @@ func TestRequirements(t *testing.T) {
 		}
 	}
 }
+
+func TestParseControlPlanePath(t *testing.T) {
+	tcs := map[string]struct {
+		path      string
+		wantGroup string
+		wantName  string
+		wantErr   bool
+	}{
+		"GroupAndName": {
+			path:      "default/ctp1",
+			wantGroup: "default",
+			wantName:  "ctp1",
+		},
+		"NameOnly": {
+			path:     "ctp1",
+			wantName: "ctp1",
+		},
+		"Empty": {
+			path:    "",
+			wantErr: true,
+		},
+		"EmptyName": {
+			path:    "default/",
+			wantErr: true,
+		},
+		"TooManySegments": {
+			path:    "org/default/ctp1",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			group, name, err := parseControlPlanePath(tc.path)
+			if tc.wantErr {
+				assert.Assert(t, err != nil)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, group, tc.wantGroup)
+			assert.Equal(t, name, tc.wantName)
+		})
+	}
+}
+
+func TestControlPlaneNames(t *testing.T) {
+	ctps := []spacesv1beta1.ControlPlane{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ctp1"}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ctp2"}},
+	}
+
+	assert.DeepEqual(t, controlPlaneNames(ctps, false), []string{"ctp1", "ctp2"})
+	// Completing <group>/<name> keeps the group so that the shell can match
+	// the word being completed.
+	assert.DeepEqual(t, controlPlaneNames(ctps, true), []string{"default/ctp1", "default/ctp2"})
+	assert.Assert(t, controlPlaneNames(nil, false) == nil)
+}
+
+func TestExtractSpaceFields(t *testing.T) {
+	ctp := spacesv1beta1.ControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "ctp1",
+		},
+		Spec: spacesv1beta1.ControlPlaneSpec{
+			Class: "small",
+			Crossplane: spacesv1beta1.CrossplaneSpec{
+				Version: ptr.To("1.20.0"),
+			},
+		},
+	}
+	ctp.SetConditions(xpcommonv1.Available())
+
+	got := extractSpaceFields(ctp)
+	assert.DeepEqual(t, got[:5], []string{"default", "ctp1", "small", "1.20.0", "True"})
+
+	// Anything other than a control plane is unknown, with one value per
+	// column.
+	assert.Equal(t, len(extractSpaceFields("not a control plane")), len(got))
+}
+
+func TestDevControlPlanes(t *testing.T) {
+	dev := spacesv1beta1.ControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "dev",
+			Annotations: map[string]string{"upbound.io/development-control-plane": "true"},
+		},
+	}
+	prod := spacesv1beta1.ControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "prod",
+		},
+	}
+
+	tcs := map[string]struct {
+		force bool
+		want  []string
+	}{
+		"DevOnly": {
+			want: []string{"dev"},
+		},
+		"Force": {
+			force: true,
+			want:  []string{"dev", "prod"},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			for _, ctp := range devControlPlanes([]spacesv1beta1.ControlPlane{dev, prod}, tc.force) {
+				got = append(got, ctp.GetName())
+			}
+			assert.DeepEqual(t, got, tc.want)
+		})
+	}
+}
+
+func TestDeleteValidate(t *testing.T) {
+	tcs := map[string]struct {
+		cmd     deleteCmd
+		wantErr bool
+	}{
+		"Name": {
+			cmd: deleteCmd{Name: "ctp1"},
+		},
+		"AllDev": {
+			cmd: deleteCmd{AllDev: true, AllGroups: true, Force: true},
+		},
+		"NameAndAllDev": {
+			cmd:     deleteCmd{Name: "ctp1", AllDev: true},
+			wantErr: true,
+		},
+		"Neither": {
+			cmd:     deleteCmd{},
+			wantErr: true,
+		},
+		"ForceWithoutAllDev": {
+			cmd:     deleteCmd{Name: "ctp1", Force: true},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			err := tc.cmd.Validate()
+			assert.Equal(t, err != nil, tc.wantErr)
+		})
+	}
+}
+
+func TestSelectPods(t *testing.T) {
+	pod := func(name string, labels map[string]string) corev1.Pod {
+		return corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	pods := []corev1.Pod{
+		pod("crossplane-abc", map[string]string{"app": "crossplane"}),
+		pod("provider-aws-s3-def", map[string]string{"pkg.crossplane.io/provider": "provider-aws-s3", "app": "ignored"}),
+		pod("function-kcl-ghi", map[string]string{"pkg.crossplane.io/function": "function-kcl"}),
+		pod("unlabelled", nil),
+	}
+
+	tcs := map[string]struct {
+		components []string
+		want       []string
+	}{
+		"All": {
+			want: []string{"crossplane-abc", "provider-aws-s3-def", "function-kcl-ghi", "unlabelled"},
+		},
+		"Provider": {
+			components: []string{"provider-aws-s3"},
+			want:       []string{"provider-aws-s3-def"},
+		},
+		"Several": {
+			components: []string{"crossplane", "function-kcl"},
+			want:       []string{"crossplane-abc", "function-kcl-ghi"},
+		},
+		"NoMatch": {
+			components: []string{"provider-gcp"},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			for _, p := range selectPods(pods, tc.components) {
+				got = append(got, p.GetName())
+			}
+			assert.DeepEqual(t, got, tc.want)
+		})
+	}
+
+	assert.DeepEqual(t, podComponents(pods), []string{"crossplane", "function-kcl", "provider-aws-s3", "unlabelled"})
+}
+
+func TestLogPrefix(t *testing.T) {
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "crossplane-abc", Labels: map[string]string{"app": "crossplane"}},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "crossplane"}}},
+	}
+	assert.Equal(t, logPrefix(pod, "crossplane"), "crossplane/crossplane-abc")
+
+	// The container is only included when the pod has several.
+	pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: "sidecar"})
+	assert.Equal(t, logPrefix(pod, "sidecar"), "crossplane/crossplane-abc/sidecar")
+}
+
+func TestSortEvents(t *testing.T) {
+	now := time.Now()
+	events := []corev1.Event{
+		{ObjectMeta: metav1.ObjectMeta{Name: "series"}, Series: &corev1.EventSeries{LastObservedTime: metav1.NewMicroTime(now)}, LastTimestamp: metav1.NewTime(now.Add(-time.Hour))},
+		{ObjectMeta: metav1.ObjectMeta{Name: "last"}, LastTimestamp: metav1.NewTime(now.Add(-time.Minute))},
+		{ObjectMeta: metav1.ObjectMeta{Name: "created", CreationTimestamp: metav1.NewTime(now.Add(-time.Hour))}},
+	}
+
+	sortEvents(events)
+	var got []string
+	for _, e := range events {
+		got = append(got, e.GetName())
+	}
+	assert.DeepEqual(t, got, []string{"created", "last", "series"})
+}
+
+func TestEventsFieldSelector(t *testing.T) {
+	tcs := map[string]struct {
+		cmd  eventsCmd
+		want string
+	}{
+		"None": {
+			cmd: eventsCmd{},
+		},
+		"KindAndName": {
+			cmd:  eventsCmd{Kind: "XBucket", Name: "my-bucket"},
+			want: "involvedObject.kind=XBucket,involvedObject.name=my-bucket",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.cmd.fieldSelector().String(), tc.want)
+		})
+	}
+}
+
+func TestExtractEventFields(t *testing.T) {
+	e := corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Namespace: "default"},
+		InvolvedObject: corev1.ObjectReference{Kind: "XBucket", Name: "my-bucket"},
+		Type:           corev1.EventTypeWarning,
+		Reason:         "ComposeResources",
+		Message:        "cannot compose resources",
+	}
+
+	got := extractEventFields(e)
+	assert.DeepEqual(t, got[2:], []string{"Warning", "ComposeResources", "XBucket/my-bucket", "cannot compose resources"})
+	assert.Equal(t, len(extractEventFields("not an event")), len(got))
+}
@@ -6,15 +6,18 @@ package controlplane
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/blang/semver/v4"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
 
 	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
 	"github.com/upbound/up/cmd/up/controlplane/requires"
+	intctp "github.com/upbound/up/internal/ctp"
 	"github.com/upbound/up/internal/upbound"
 	"github.com/upbound/up/internal/upterm"
 )
@@ -24,7 +27,7 @@ type createCmd struct {
 	requires.Space
 
 	Name  string `arg:""     help:"Name of control plane."                                                                                                      required:""`
-	Group string `default:"" help:"The control plane group that the control plane is contained in. This defaults to the group specified in the current context" short:"g"`
+	Group string `default:"" help:"The control plane group that the control plane is contained in. This defaults to the group specified in the current context" predictor:"groups" short:"g"`
 
 	Crossplane struct {
 		Version     string `default:"" help:"The version of Universal Crossplane to use. The default depends on the selected auto-upgrade channel."`
@@ -34,6 +37,9 @@ type createCmd struct {
 	} `embed:"" prefix:"crossplane-"`
 
 	SecretName string `help:"The name of the control plane's secret. Defaults to 'kubeconfig-{control plane name}'. Only applicable for Space control planes."`
+
+	Wait    bool          `help:"Wait for the control plane to become ready before returning."`
+	Timeout time.Duration `default:"10m"                                                       help:"How long to wait for the control plane to become ready. Only applicable with --wait."`
 }
 
 // Validate performs custom argument validation for the create command.
@@ -88,5 +94,12 @@ func (c *createCmd) Run(ctx context.Context, p upterm.Printer, client client.Cli
 	}
 
 	p.Printfln("%s created", c.Name)
-	return nil
+	if !c.Wait {
+		return nil
+	}
+
+	nn := types.NamespacedName{Namespace: ctp.GetNamespace(), Name: ctp.GetName()}
+	return p.WrapWithSuccessSpinner(fmt.Sprintf("Waiting for %s to become ready", c.Name), func() error {
+		return intctp.WaitForControlPlaneReady(ctx, client, nn, c.Timeout)
+	})
 }
@@ -6,15 +6,18 @@ package controlplane
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
 
 	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
 	"github.com/upbound/up/cmd/up/controlplane/requires"
+	intctp "github.com/upbound/up/internal/ctp"
 	"github.com/upbound/up/internal/upbound"
 	"github.com/upbound/up/internal/upterm"
 )
@@ -23,13 +26,33 @@ import (
 type deleteCmd struct {
 	requires.Space
 
-	Name  string `arg:""     help:"Name of control plane."                                                                                                      predictor:"ctps"`
-	Group string `default:"" help:"The control plane group that the control plane is contained in. This defaults to the group specified in the current context" short:"g"`
+	Name      string `arg:""                                                                                                                             help:"Name of control plane."                                                                                                      optional:""        predictor:"ctps"`
+	Group     string `default:""                                                                                                                         help:"The control plane group that the control plane is contained in. This defaults to the group specified in the current context" predictor:"groups" short:"g"`
+	AllDev    bool   `help:"Delete all development control planes, such as those left behind by interrupted 'up test run' or 'up project run' sessions." name:"all-dev"`
+	AllGroups bool   `default:"false"                                                                                                                    help:"With --all-dev, delete development control planes across all groups."                                                        short:"A"`
+	Force     bool   `help:"With --all-dev, also delete control planes that are not development control planes."`
+	Yes       bool   `default:"false"                                                                                                                    help:"When set to true, automatically accepts any confirmation prompts."                                                           short:"y"`
+}
+
+// Validate performs custom argument validation for the delete command.
+func (c *deleteCmd) Validate() error {
+	switch {
+	case c.AllDev && c.Name != "":
+		return errors.New("cannot specify a control plane name with --all-dev")
+	case !c.AllDev && c.Name == "":
+		return errors.New("a control plane name is required unless --all-dev is set")
+	case !c.AllDev && (c.AllGroups || c.Force):
+		return errors.New("--all-groups and --force can only be used with --all-dev")
+	}
+	return nil
 }
 
 // AfterApply sets default values in command after assignment and validation.
 func (c *deleteCmd) AfterApply(upCtx *upbound.Context) error {
-	if c.Group == "" {
+	// `-A` prevails over `-g`.
+	if c.AllGroups {
+		c.Group = ""
+	} else if c.Group == "" {
 		ns, err := upCtx.GetCurrentContextNamespace()
 		if err != nil {
 			return err
@@ -41,6 +64,10 @@ func (c *deleteCmd) AfterApply(upCtx *upbound.Context) error {
 
 // Run executes the delete command.
 func (c *deleteCmd) Run(ctx context.Context, p upterm.Printer, client client.Client) error {
+	if c.AllDev {
+		return c.deleteAllDev(ctx, p, client)
+	}
+
 	ctp := &spacesv1beta1.ControlPlane{
 		ObjectMeta: v1.ObjectMeta{
 			Name:      c.Name,
@@ -57,3 +84,59 @@ func (c *deleteCmd) Run(ctx context.Context, p upterm.Printer, client client.Cli
 	p.Printfln("%s deleted", c.Name)
 	return nil
 }
+
+func (c *deleteCmd) deleteAllDev(ctx context.Context, p upterm.Printer, cl client.Client) error {
+	var l spacesv1beta1.ControlPlaneList
+	if err := cl.List(ctx, &l, client.InNamespace(c.Group)); err != nil {
+		return errors.Wrap(err, "error getting control planes")
+	}
+
+	ctps := devControlPlanes(l.Items, c.Force)
+	if len(ctps) == 0 {
+		p.Println("No control planes to delete")
+		return nil
+	}
+
+	paths := make([]string, len(ctps))
+	for i, ctp := range ctps {
+		paths[i] = ctp.GetNamespace() + "/" + ctp.GetName()
+	}
+
+	if !c.Yes {
+		kind := "development control planes"
+		if c.Force {
+			kind = "control planes, including non-development control planes"
+		}
+		msg := fmt.Sprintf("The following %d %s will be deleted:\n  %s\nAre you sure you want to continue?", len(ctps), kind, strings.Join(paths, "\n  "))
+		proceed, err := upterm.Confirm(msg, false)
+		if err != nil {
+			return err
+		}
+		if !proceed {
+			return errors.New("operation canceled")
+		}
+	}
+
+	var errs []error
+	for i, ctp := range ctps {
+		nn := types.NamespacedName{Namespace: ctp.GetNamespace(), Name: ctp.GetName()}
+		if err := intctp.DeleteControlPlane(ctx, cl, nn, c.Force); err != nil {
+			errs = append(errs, errors.Wrapf(err, "cannot delete control plane %q", paths[i]))
+			continue
+		}
+		p.Printfln("%s deleted", paths[i])
+	}
+	return errors.Join(errs...)
+}
+
+// devControlPlanes returns the development control planes in ctps. If force is
+// true, all control planes are returned.
+func devControlPlanes(ctps []spacesv1beta1.ControlPlane, force bool) []spacesv1beta1.ControlPlane {
+	var out []spacesv1beta1.ControlPlane
+	for _, ctp := range ctps {
+		if force || intctp.IsDevControlPlane(&ctp) {
+			out = append(out, ctp)
+		}
+	}
+	return out
+}
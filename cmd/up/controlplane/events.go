@@ -0,0 +1,159 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package controlplane
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up/cmd/up/controlplane/requires"
+	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/upterm"
+)
+
+// eventFieldNames are the columns printed for each event.
+var eventFieldNames = []string{"NAMESPACE", "LAST SEEN", "TYPE", "REASON", "OBJECT", "MESSAGE"} //nolint:gochecknoglobals // This is effectively a constant.
+
+// eventsCmd lists recent events in a control plane in the current space.
+type eventsCmd struct {
+	requires.Space
+
+	ControlPlane string `arg:""                                                                 help:"The control plane to list events from, as <group>/<name>. If only a name is given, the group of the current context is used." predictor:"ctps" required:""`
+	Namespace    string `help:"Only list events in this namespace. Defaults to all namespaces." short:"n"`
+	Kind         string `help:"Only list events about objects of this kind, such as XBucket."`
+	Name         string `help:"Only list events about objects with this name."`
+	Watch        bool   `help:"Keep watching for new events after listing existing events."     short:"w"`
+
+	group string
+	name  string
+}
+
+// AfterApply sets default values in command after assignment and validation.
+func (c *eventsCmd) AfterApply(upCtx *upbound.Context) error {
+	group, name, err := resolveControlPlanePath(upCtx, c.ControlPlane)
+	if err != nil {
+		return err
+	}
+	c.group, c.name = group, name
+	return nil
+}
+
+// Run executes the events command.
+func (c *eventsCmd) Run(ctx context.Context, upCtx *upbound.Context, printer upterm.Printer, cl client.Client) error {
+	cs, _, err := controlPlaneClientset(ctx, upCtx, cl, types.NamespacedName{Namespace: c.group, Name: c.name})
+	if err != nil {
+		return err
+	}
+
+	opts := metav1.ListOptions{FieldSelector: c.fieldSelector().String()}
+	events, err := cs.CoreV1().Events(c.Namespace).List(ctx, opts)
+	if err != nil {
+		return errors.Wrap(err, "cannot list control plane events")
+	}
+
+	sortEvents(events.Items)
+	switch {
+	case len(events.Items) > 0:
+		if err := printer.PrintObject(events.Items, eventFieldNames, extractEventFields); err != nil {
+			return err
+		}
+	case !c.Watch:
+		printer.Println("No events found")
+		return nil
+	}
+	if !c.Watch {
+		return nil
+	}
+
+	opts.ResourceVersion = events.GetResourceVersion()
+	w, err := cs.CoreV1().Events(c.Namespace).Watch(ctx, opts)
+	if err != nil {
+		return errors.Wrap(err, "cannot watch control plane events")
+	}
+	defer w.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-w.ResultChan():
+			if !ok {
+				return errors.New("stopped watching control plane events")
+			}
+			if e.Type != watch.Added && e.Type != watch.Modified {
+				continue
+			}
+			ev, ok := e.Object.(*corev1.Event)
+			if !ok {
+				continue
+			}
+			if err := printer.PrintObject(*ev, eventFieldNames, extractEventFields); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// fieldSelector returns a selector for events about the objects selected by
+// the command's flags.
+func (c *eventsCmd) fieldSelector() fields.Selector {
+	set := fields.Set{}
+	if c.Kind != "" {
+		set["involvedObject.kind"] = c.Kind
+	}
+	if c.Name != "" {
+		set["involvedObject.name"] = c.Name
+	}
+	return fields.SelectorFromSet(set)
+}
+
+// eventTime returns the time an event was last seen.
+func eventTime(e corev1.Event) time.Time {
+	switch {
+	case e.Series != nil && !e.Series.LastObservedTime.IsZero():
+		return e.Series.LastObservedTime.Time
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	case !e.FirstTimestamp.IsZero():
+		return e.FirstTimestamp.Time
+	default:
+		return e.CreationTimestamp.Time
+	}
+}
+
+// sortEvents sorts events from the least to the most recently seen.
+func sortEvents(events []corev1.Event) {
+	slices.SortStableFunc(events, func(a, b corev1.Event) int {
+		return eventTime(a).Compare(eventTime(b))
+	})
+}
+
+func extractEventFields(obj any) []string {
+	e, ok := obj.(corev1.Event)
+	if !ok {
+		return []string{"unknown", "unknown", "", "", "", ""}
+	}
+
+	return []string{
+		e.GetNamespace(),
+		formatAge(ptr.To(time.Since(eventTime(e)))),
+		e.Type,
+		e.Reason,
+		e.InvolvedObject.Kind + "/" + e.InvolvedObject.Name,
+		e.Message,
+	}
+}
@@ -37,8 +37,8 @@ func (c *getCmd) AfterApply(upCtx *upbound.Context) error {
 type getCmd struct {
 	requires.Space
 
-	Name  string `arg:""     help:"Name of control plane."                                                                                                      predictor:"ctps" required:""`
-	Group string `default:"" help:"The control plane group that the control plane is contained in. This defaults to the group specified in the current context" short:"g"`
+	Name  string `arg:""     help:"Name of control plane."                                                                                                      predictor:"ctps"   required:""`
+	Group string `default:"" help:"The control plane group that the control plane is contained in. This defaults to the group specified in the current context" predictor:"groups" short:"g"`
 }
 
 // Run executes the get command.
@@ -6,6 +6,7 @@ package controlplane
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
@@ -21,7 +22,20 @@ type listCmd struct {
 	requires.Space
 
 	AllGroups bool   `default:"false" help:"List control planes across all groups."                                                                                      short:"A"`
-	Group     string `default:""      help:"The control plane group that the control plane is contained in. This defaults to the group specified in the current context" short:"g"`
+	Group     string `default:""      help:"The control plane group that the control plane is contained in. This defaults to the group specified in the current context" predictor:"groups" short:"g"`
+	Selector  string `default:""      help:"Only list control planes matching this label selector, e.g. 'env=prod,tier!=dev'."                                           short:"l"`
+
+	selector labels.Selector
+}
+
+// Validate performs custom argument validation for the list command.
+func (c *listCmd) Validate() error {
+	sel, err := labels.Parse(c.Selector)
+	if err != nil {
+		return errors.Wrapf(err, "invalid selector %q", c.Selector)
+	}
+	c.selector = sel
+	return nil
 }
 
 // AfterApply sets default values in command after assignment and validation.
@@ -42,7 +56,7 @@ func (c *listCmd) AfterApply(upCtx *upbound.Context) error {
 // Run executes the list command.
 func (c *listCmd) Run(ctx context.Context, printer upterm.Printer, cl client.Client) error {
 	var l spacesv1beta1.ControlPlaneList
-	if err := cl.List(ctx, &l, client.InNamespace(c.Group)); err != nil {
+	if err := cl.List(ctx, &l, client.InNamespace(c.Group), client.MatchingLabelsSelector{Selector: c.selector}); err != nil {
 		return errors.Wrap(err, "error getting control planes")
 	}
 
@@ -0,0 +1,200 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package controlplane
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up/cmd/up/controlplane/requires"
+	"github.com/upbound/up/internal/upbound"
+)
+
+const (
+	// Labels used to determine which component a pod belongs to, in order of
+	// preference.
+	labelProvider = "pkg.crossplane.io/provider"
+	labelFunction = "pkg.crossplane.io/function"
+	labelApp      = "app"
+
+	errLogsUnavailableFmt = "cannot read component logs of control plane %q in space %q: its pods aren't accessible from the control plane's API"
+)
+
+// logsCmd streams logs from the Crossplane, provider, and function pods of a
+// control plane in the current space.
+type logsCmd struct {
+	requires.Space
+
+	ControlPlane string        `arg:""                                                                                                                           help:"The control plane to read logs from, as <group>/<name>. If only a name is given, the group of the current context is used." predictor:"ctps" required:""`
+	Component    []string      `help:"Only show logs from these components, such as crossplane or provider-aws-s3. May be repeated. Defaults to all components." short:"c"`
+	Since        time.Duration `help:"Only show logs newer than a relative duration, such as 5m or 1h. Defaults to all logs."`
+	Follow       bool          `help:"Keep streaming new logs until interrupted."                                                                                short:"f"`
+	Namespace    string        `default:"crossplane-system"                                                                                                      help:"Namespace of the control plane's components."                                                                               short:"n"`
+
+	group string
+	name  string
+}
+
+// AfterApply sets default values in command after assignment and validation.
+func (c *logsCmd) AfterApply(upCtx *upbound.Context) error {
+	group, name, err := resolveControlPlanePath(upCtx, c.ControlPlane)
+	if err != nil {
+		return err
+	}
+	c.group, c.name = group, name
+	return nil
+}
+
+// Run executes the logs command.
+func (c *logsCmd) Run(ctx context.Context, upCtx *upbound.Context, cl client.Client) error {
+	nn := types.NamespacedName{Namespace: c.group, Name: c.name}
+	cs, space, err := controlPlaneClientset(ctx, upCtx, cl, nn)
+	if err != nil {
+		return err
+	}
+
+	pods, err := cs.CoreV1().Pods(c.Namespace).List(ctx, metav1.ListOptions{})
+	switch {
+	case kerrors.IsForbidden(err) || kerrors.IsNotFound(err) || kerrors.IsMethodNotSupported(err):
+		// Control planes in cloud spaces don't necessarily expose their
+		// pods. There's no other log API to fall back to, so explain why
+		// there are no logs rather than surfacing the API error.
+		return errors.Wrapf(err, errLogsUnavailableFmt, nn.String(), space.Name())
+	case err != nil:
+		return errors.Wrap(err, "cannot list control plane pods")
+	}
+
+	selected := selectPods(pods.Items, c.Component)
+	if len(selected) == 0 {
+		if len(c.Component) > 0 {
+			return errors.Errorf("no pods found for components %v in namespace %q; available components: %v", c.Component, c.Namespace, podComponents(pods.Items))
+		}
+		return errors.Errorf("no pods found in namespace %q of control plane %q", c.Namespace, nn.String())
+	}
+
+	opts := &corev1.PodLogOptions{Follow: c.Follow}
+	if c.Since > 0 {
+		opts.SinceSeconds = ptr.To(int64(c.Since.Seconds()))
+	}
+
+	out := &lineWriter{w: os.Stdout}
+	var wg sync.WaitGroup
+	errs := make([]error, 0)
+	var mu sync.Mutex
+	for _, pod := range selected {
+		for _, ctr := range pod.Spec.Containers {
+			prefix := logPrefix(pod, ctr.Name)
+			o := opts.DeepCopy()
+			o.Container = ctr.Name
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := streamLogs(ctx, cs, pod, o, prefix, out); err != nil {
+					mu.Lock()
+					errs = append(errs, errors.Wrapf(err, "cannot stream logs for %s", prefix))
+					mu.Unlock()
+				}
+			}()
+		}
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// streamLogs copies the logs of one container to out, prefixing each line.
+func streamLogs(ctx context.Context, cs kubernetes.Interface, pod corev1.Pod, opts *corev1.PodLogOptions, prefix string, out *lineWriter) error {
+	rc, err := cs.CoreV1().Pods(pod.GetNamespace()).GetLogs(pod.GetName(), opts).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer rc.Close() //nolint:errcheck // Read-only stream.
+
+	s := bufio.NewScanner(rc)
+	s.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for s.Scan() {
+		out.WriteLine(prefix, s.Text())
+	}
+	if err := s.Err(); err != nil && ctx.Err() == nil {
+		return err
+	}
+	return nil
+}
+
+// lineWriter writes whole lines to a writer shared by concurrent streams, so
+// that lines from different containers aren't interleaved.
+type lineWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// WriteLine writes a prefixed line.
+func (l *lineWriter) WriteLine(prefix, line string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, _ = fmt.Fprintf(l.w, "[%s] %s\n", prefix, line)
+}
+
+// podComponent returns the name of the control plane component a pod belongs
+// to, or the pod's name if it can't be determined from its labels.
+func podComponent(pod corev1.Pod) string {
+	for _, l := range []string{labelProvider, labelFunction, labelApp} {
+		if v := pod.GetLabels()[l]; v != "" {
+			return v
+		}
+	}
+	return pod.GetName()
+}
+
+// podComponents returns the sorted, distinct components of the given pods.
+func podComponents(pods []corev1.Pod) []string {
+	cs := make([]string, 0, len(pods))
+	for _, p := range pods {
+		if c := podComponent(p); !slices.Contains(cs, c) {
+			cs = append(cs, c)
+		}
+	}
+	slices.Sort(cs)
+	return cs
+}
+
+// selectPods returns the pods that belong to one of the given components, or
+// all pods if no components are given.
+func selectPods(pods []corev1.Pod, components []string) []corev1.Pod {
+	if len(components) == 0 {
+		return pods
+	}
+	selected := make([]corev1.Pod, 0, len(pods))
+	for _, p := range pods {
+		if slices.Contains(components, podComponent(p)) {
+			selected = append(selected, p)
+		}
+	}
+	return selected
+}
+
+// logPrefix returns the prefix for lines logged by a container, which
+// identifies its component and pod, and its container if the pod has several.
+func logPrefix(pod corev1.Pod, container string) string {
+	prefix := fmt.Sprintf("%s/%s", podComponent(pod), pod.GetName())
+	if len(pod.Spec.Containers) > 1 {
+		prefix += "/" + container
+	}
+	return prefix
+}
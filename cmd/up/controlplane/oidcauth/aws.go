@@ -114,7 +114,7 @@ func (c *awsCmd) Run(ctx context.Context, cl client.Client, upCtx *upbound.Conte
 			if c.Sub != "" {
 				sub = c.Sub
 			}
-			trustPolicy, err := c.buildTrustPolicy(oidcProviderARN, upCtx.Profile.Organization, sub)
+			trustPolicy, err := c.buildTrustPolicy(oidcProviderARN, upCtx.Organization, sub)
 			if err != nil {
 				return errors.Wrap(err, "failed to build trust policy")
 			}
@@ -168,7 +168,7 @@ func (c *awsCmd) runDryRun(upCtx *upbound.Context, p upterm.Printer) error {
 		sub = c.Sub
 	}
 	oidcProviderARN := fmt.Sprintf("arn:aws:iam::ACCOUNT_ID:oidc-provider/%s", c.OIDCProviderName)
-	trustPolicy, err := c.buildTrustPolicy(oidcProviderARN, upCtx.Profile.Organization, sub)
+	trustPolicy, err := c.buildTrustPolicy(oidcProviderARN, upCtx.Organization, sub)
 	if err != nil {
 		return errors.Wrap(err, "failed to build trust policy")
 	}
@@ -56,6 +56,7 @@ func (c *installCmd) AfterApply(kongCtx *kong.Context, upCtx *upbound.Context) e
 		image.WithFetcher(
 			image.NewLocalFetcher(
 				image.WithKeychain(upCtx.RegistryKeychain()),
+				image.WithTransport(upCtx.Transport()),
 			),
 		),
 	)
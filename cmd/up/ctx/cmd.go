@@ -5,6 +5,7 @@ package ctx
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -12,8 +13,10 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	kruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes/scheme"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/clientcmd"
@@ -40,14 +43,23 @@ func init() {
 	kruntime.Must(spacesv1beta1.AddToScheme(scheme.Scheme))
 }
 
-// Cmd is the `up ctx` command.
+// Cmd is the `up ctx` command. Operations that don't navigate, such as
+// --validate and --inspect, are flags rather than subcommands: kong can't
+// combine subcommands with the optional path argument, and a subcommand would
+// shadow a group or control plane of the same name.
 type Cmd struct {
 	upbound.RequiresContext
 
-	Argument    string `arg:""                                                                                                                       help:".. to move to the parent, '-' for the previous context, '.' for the current context, or any relative path." optional:""`
-	Short       bool   `env:"UP_SHORT"                                                                                                               help:"Short output."                                                                                              name:"short"                             short:"s"`
-	KubeContext string `default:"upbound"                                                                                                            env:"UP_CONTEXT"                                                                                                  help:"Kubernetes context to operate on." name:"context"`
-	File        string `help:"Kubeconfig to modify when saving a new context. Overrides the --kubeconfig flag. Use '-' to write to standard output." short:"f"`
+	Argument    string `arg:""                                                                                                                                                                                            help:".. to move to the parent, '-' for the previous context, '.' for the current context, or any relative path."                                                                                                                                              optional:""`
+	Short       bool   `env:"UP_SHORT"                                                                                                                                                                                    help:"Short output."                                                                                                                                                                                                                                           name:"short"                             short:"s"`
+	KubeContext string `default:"upbound"                                                                                                                                                                                 env:"UP_CONTEXT"                                                                                                                                                                                                                                               help:"Kubernetes context to operate on." name:"context"`
+	File        string `help:"Kubeconfig to modify when saving a new context. Overrides the --kubeconfig flag. Use '-' to write to standard output."                                                                      short:"f"`
+	DryRun      bool   `help:"Print the context that would be switched to and the changes to the kubeconfig, without saving them. Requires a path argument."`
+	PrintServer bool   `help:"Print the API server URL of the context, such as a control plane's URL, without modifying the kubeconfig. Defaults to the current context."`
+	Validate    bool   `help:"Check that the profile's stored context, or the context at the path argument, still exists, without changing the kubeconfig; fails if not. A flag, since 'validate' may be a path."`
+	StaticToken string `env:"UP_CTX_STATIC_TOKEN"                                                                                                                                                                         help:"Embed this organization token in the kubeconfig for cloud spaces instead of running 'up organization token' to fetch one, so the kubeconfig works where up isn't installed. The token isn't refreshed, so the kubeconfig stops working when it expires."`
+	Refresh     bool   `help:"Look up space ingresses afresh whenever spaces are listed, rather than reusing ingresses already looked up by this command. Use this when a space was just created or its ingress changed."`
+	Inspect     bool   `help:"Print the current kubeconfig context's Upbound space extension as JSON, without changing the kubeconfig. Reports a missing or malformed extension. A flag, since 'inspect' may be a path."`
 }
 
 // Termination is a model state that indicates the command should be terminated,
@@ -62,6 +74,18 @@ type Termination struct {
 type navContext struct {
 	ingressReader spaces.IngressReader
 	contextWriter kube.ContextWriter
+
+	// refreshIngresses invalidates cached ingresses each time spaces are
+	// listed, if the ingress reader is a cache.
+	refreshIngresses bool
+
+	// staticToken is embedded in the kubeconfig for cloud spaces instead of
+	// an exec credential plugin, if set.
+	staticToken string
+
+	// ingressBackoff is how getting a space's ingress is retried after a
+	// transient error. A short default backoff is used if it's unset.
+	ingressBackoff *wait.Backoff
 }
 
 type model struct {
@@ -87,17 +111,34 @@ func (m model) WithTermination(msg string, err error) model {
 
 // Run runs the command.
 func (c *Cmd) Run(ctx context.Context, kongCtx *kong.Context, upCtx *upbound.Context, p upterm.Printer) error {
+	if c.Validate {
+		return c.RunValidate(ctx, upCtx, p)
+	}
+
 	// find profile and derive controlplane from kubeconfig
-	po := clientcmd.NewDefaultPathOptions()
+	po := kube.PathOptions(c.Flags.Kube.Kubeconfig)
 	conf, err := po.GetStartingConfig()
 	if err != nil {
 		return err
 	}
+	if c.Inspect {
+		return c.RunInspect(upCtx, conf, p)
+	}
+
 	initialState, err := DeriveState(ctx, upCtx, conf, kube.GetIngressHost)
 	if err != nil {
 		return err
 	}
 
+	if c.StaticToken != "" {
+		// A state derived from the current context reuses its auth info,
+		// which is an exec credential plugin unless it was created with a
+		// static token.
+		if s, ok := stateSpace(initialState).(*CloudSpace); ok {
+			s.AuthInfo = &clientcmdapi.AuthInfo{Token: c.StaticToken}
+		}
+	}
+
 	baseReader := spaces.NewConfigMapReader(upCtx.Profile.Session)
 
 	if c.Flags.CABundle != "" {
@@ -107,8 +148,18 @@ func (c *Cmd) Run(ctx context.Context, kongCtx *kong.Context, upCtx *upbound.Con
 	cachedReader := spaces.NewCachedReader(baseReader)
 
 	navCtx := &navContext{
-		ingressReader: cachedReader,
-		contextWriter: c.kubeContextWriter(upCtx, p),
+		ingressReader:    cachedReader,
+		contextWriter:    c.kubeContextWriter(upCtx, p),
+		staticToken:      c.StaticToken,
+		refreshIngresses: c.Refresh,
+	}
+
+	if c.PrintServer {
+		return c.RunPrintServer(ctx, upCtx, navCtx, initialState, p)
+	}
+
+	if c.DryRun && (c.Argument == "" || c.Argument == "-") {
+		return errors.New("--dry-run requires a path argument")
 	}
 
 	// non-interactive mode via positional argument
@@ -122,6 +173,21 @@ func (c *Cmd) Run(ctx context.Context, kongCtx *kong.Context, upCtx *upbound.Con
 	}
 }
 
+// stateSpace returns the space a navigation state is in, or nil if it isn't in
+// a space.
+func stateSpace(state NavigationState) Space {
+	switch s := state.(type) {
+	case Space:
+		return s
+	case *Group:
+		return s.Space
+	case *ControlPlane:
+		return s.Group.Space
+	default:
+		return nil
+	}
+}
+
 func updateProfile(upCtx *upbound.Context, breadcrumbs Breadcrumbs) error {
 	path := breadcrumbs.String()
 	upCtx.Profile.CurrentKubeContext = path
@@ -272,6 +338,18 @@ func (c *Cmd) RunNonInteractive(ctx context.Context, upCtx *upbound.Context, nav
 
 	// final step if we moved: accept the state
 	msg := fmt.Sprintf("Kubeconfig context %q: %s", c.KubeContext, withUpboundPrefix(breadcrumbs.styledString()))
+
+	// in a dry run, preview the changes without saving the kubeconfig or the
+	// profile. Printing the kubeconfig to stdout never saves anything.
+	if c.DryRun && c.File != "-" {
+		if c.Short {
+			p.Println(breadcrumbs)
+		} else {
+			p.Println("Dry run:", msg)
+		}
+		return navCtx.contextWriter.Write(config)
+	}
+
 	if breadcrumbs.String() != initialState.Breadcrumbs().String() || c.File == "-" {
 		if err := navCtx.contextWriter.Write(config); err != nil {
 			return err
@@ -292,6 +370,163 @@ func (c *Cmd) RunNonInteractive(ctx context.Context, upCtx *upbound.Context, nav
 	return updateProfile(upCtx, breadcrumbs)
 }
 
+// RunValidate checks that the context at the argument's path, or the context
+// stored in the profile if there's no argument, can still be navigated to. It
+// resolves the context the same way switching to it would, so a deleted space,
+// group, or control plane is reported as missing.
+func (c *Cmd) RunValidate(ctx context.Context, upCtx *upbound.Context, p upterm.Printer) error {
+	path := c.Argument
+	if path == "" {
+		path = upCtx.Profile.CurrentKubeContext
+	}
+	switch {
+	case path == "":
+		return errors.Errorf("profile %q has no current context to validate", upCtx.ProfileName)
+	case path == "-" || strings.HasPrefix(path, "."):
+		return errors.New("--validate requires an absolute path argument")
+	}
+
+	if _, err := GetKubeconfigForPath(ctx, upCtx, path); err != nil {
+		return errors.Wrapf(err, "context %q is not valid", path)
+	}
+	p.Printfln("Context %q is valid", path)
+	return nil
+}
+
+// contextInspection describes the Upbound space extension of a kubeconfig
+// context, which up ctx uses to derive the space, group, or control plane the
+// context is in.
+type contextInspection struct {
+	Context   string                  `json:"context"`
+	Extension *upbound.SpaceExtension `json:"extension,omitempty"`
+	// Raw is the extension as stored in the kubeconfig, included when it
+	// can't be parsed.
+	Raw string `json:"raw,omitempty"`
+	// MatchesProfile is true if the extension is for a space in the current
+	// profile. Otherwise up ctx starts from the profile's root.
+	MatchesProfile bool     `json:"matchesProfile"`
+	Problems       []string `json:"problems,omitempty"`
+}
+
+// RunInspect prints the space extension of the current context in the given
+// kubeconfig as JSON. It returns an error if the extension is missing or
+// malformed, after printing what's wrong with it.
+func (c *Cmd) RunInspect(upCtx *upbound.Context, conf *clientcmdapi.Config, p upterm.Printer) error {
+	in := inspectContext(conf, upCtx.Profile, upCtx.Organization)
+	b, err := json.MarshalIndent(in, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal context inspection")
+	}
+	p.PrintResult(string(b))
+
+	if len(in.Problems) > 0 {
+		return errors.Errorf("context %q doesn't have a valid Upbound space extension", in.Context)
+	}
+	return nil
+}
+
+// inspectContext inspects the space extension of the current context in the
+// given kubeconfig. The organization is the effective organization, which may
+// be overridden by a flag.
+func inspectContext(conf *clientcmdapi.Config, prof profile.Profile, org string) contextInspection {
+	in := contextInspection{Context: conf.CurrentContext}
+
+	kctx, ok := conf.Contexts[conf.CurrentContext]
+	if !ok {
+		in.Problems = append(in.Problems, fmt.Sprintf("context %q doesn't exist in the kubeconfig", conf.CurrentContext))
+		return in
+	}
+	raw, ok := kctx.Extensions[upbound.ContextExtensionKeySpace]
+	if !ok {
+		in.Problems = append(in.Problems, fmt.Sprintf("context has no %q extension, so it wasn't created by up ctx and isn't in a space", upbound.ContextExtensionKeySpace))
+		return in
+	}
+
+	ext, err := upbound.GetSpaceExtension(kctx)
+	switch {
+	case err != nil:
+		if u, ok := raw.(*runtime.Unknown); ok {
+			in.Raw = string(u.Raw)
+		}
+		in.Problems = append(in.Problems, fmt.Sprintf("cannot parse the %q extension: %v", upbound.ContextExtensionKeySpace, err))
+		return in
+	case ext == nil:
+		in.Problems = append(in.Problems, fmt.Sprintf("the %q extension isn't in the expected format", upbound.ContextExtensionKeySpace))
+		return in
+	}
+
+	in.Extension = ext
+	in.Problems = append(in.Problems, extensionProblems(ext)...)
+	in.MatchesProfile = len(in.Problems) == 0 && spaceInProfile(prof, org, ext)
+	return in
+}
+
+// extensionProblems returns what's wrong with a parsed space extension, such
+// as missing fields left by hand-editing the kubeconfig.
+func extensionProblems(ext *upbound.SpaceExtension) []string {
+	var problems []string
+	if ext.Kind != upbound.SpaceExtensionKind {
+		problems = append(problems, fmt.Sprintf("kind is %q, not %q", ext.Kind, upbound.SpaceExtensionKind))
+	}
+	if ext.Spec == nil {
+		return append(problems, "spec is missing")
+	}
+
+	switch cloud, disconnected := ext.Spec.Cloud, ext.Spec.Disconnected; {
+	case cloud == nil && disconnected == nil:
+		problems = append(problems, "spec has neither a cloud nor a disconnected space")
+	case cloud != nil && disconnected != nil:
+		problems = append(problems, "spec has both a cloud and a disconnected space")
+	case cloud != nil:
+		if cloud.Organization == "" {
+			problems = append(problems, "spec.cloud.organization is empty")
+		}
+		if cloud.SpaceName == "" {
+			problems = append(problems, "spec.cloud.space is empty")
+		}
+	case disconnected.HubContext == "":
+		problems = append(problems, "spec.disconnected.hubContext is empty")
+	}
+	return problems
+}
+
+// RunPrintServer prints the API server URL of the context at the argument's
+// path, or of the current context if there's no argument.
+func (c *Cmd) RunPrintServer(ctx context.Context, upCtx *upbound.Context, navCtx *navContext, initialState NavigationState, p upterm.Printer) error {
+	path := c.Argument
+	switch path {
+	case "-":
+		return errors.New("--print-server cannot be used with '-'")
+	case "":
+		path = "."
+	}
+
+	config, _, err := getKubeconfigNonInteractive(ctx, upCtx, navCtx, initialState, path)
+	if err != nil {
+		return err
+	}
+	server, err := ServerURL(config)
+	if err != nil {
+		return err
+	}
+	p.PrintResult(server)
+	return nil
+}
+
+// ServerURL returns the API server URL of the current context of the given
+// kubeconfig.
+func ServerURL(conf *clientcmdapi.Config) (string, error) {
+	kctx, ok := conf.Contexts[conf.CurrentContext]
+	if !ok {
+		return "", errors.Errorf("cannot find context %q in kubeconfig", conf.CurrentContext)
+	}
+	cluster, ok := conf.Clusters[kctx.Cluster]
+	if !ok || cluster.Server == "" {
+		return "", errors.Errorf("cannot find server for context %q in kubeconfig", conf.CurrentContext)
+	}
+	return cluster.Server, nil
+}
+
 // GetKubeconfigForPath returns a kubeconfig for the given path.
 func GetKubeconfigForPath(ctx context.Context, upCtx *upbound.Context, path string) (*clientcmdapi.Config, error) {
 	initialState, err := rootState(ctx, upCtx)
@@ -315,7 +550,7 @@ func GetKubeconfigForPath(ctx context.Context, upCtx *upbound.Context, path stri
 	return config, err
 }
 
-func getKubeconfigNonInteractive(ctx context.Context, upCtx *upbound.Context, navCtx *navContext, initialState NavigationState, path string) (*clientcmdapi.Config, Breadcrumbs, error) { //nolint:gocognit // TODO: refactor
+func getKubeconfigNonInteractive(ctx context.Context, upCtx *upbound.Context, navCtx *navContext, initialState NavigationState, path string) (*clientcmdapi.Config, Breadcrumbs, error) {
 	// begin from root unless we're starting from a relative . or ..
 	state := initialState
 	if !strings.HasPrefix(path, ".") {
@@ -336,11 +571,34 @@ func getKubeconfigNonInteractive(ctx context.Context, upCtx *upbound.Context, na
 		path = trimmedPath
 	}
 
-	m := model{
+	m, err := navigate(ctx, model{
 		state:      state,
 		upCtx:      upCtx,
 		navContext: navCtx,
+	}, path)
+	if err != nil {
+		return nil, nil, err
 	}
+
+	a, ok := m.state.(Accepting)
+	if !ok {
+		return nil, nil, fmt.Errorf("cannot move context to: %s", m.state.Breadcrumbs())
+	}
+
+	config, err := a.GetKubeconfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	raw, err := config.RawConfig()
+	return &raw, a.Breadcrumbs(), err
+}
+
+// navigate follows a slash-separated path from the model's state, and returns
+// the model at the end of the path. Each segment of the path is either "." for
+// the current state, ".." for its parent, or the name of an item to enter, so
+// relative paths such as ../../other-group/ctp work from any state.
+func navigate(ctx context.Context, m model, path string) (model, error) {
 	for s := range strings.SplitSeq(path, "/") {
 		switch s {
 		case "":
@@ -350,51 +608,66 @@ func getKubeconfigNonInteractive(ctx context.Context, upCtx *upbound.Context, na
 		case "..":
 			back, ok := m.state.(Back)
 			if !ok {
-				return nil, nil, fmt.Errorf("cannot move to parent context from: %s", m.state.Breadcrumbs())
+				return m, fmt.Errorf("cannot move to parent context from: %s", m.state.Breadcrumbs())
 			}
 			var err error
 			m, err = back.Back(m)
 			if err != nil {
-				return nil, nil, err
+				return m, err
 			}
 		default:
-			// find the string as item
-			items, err := m.state.Items(ctx, m.upCtx, m.navContext)
+			var err error
+			m, err = enter(ctx, m, s)
 			if err != nil {
-				return nil, nil, err
-			}
-			found := false
-			for _, i := range items {
-				if i, ok := i.(item); ok && i.Matches(s) {
-					if i.onEnter == nil {
-						return nil, nil, fmt.Errorf("cannot enter %q in: %s", s, m.state.Breadcrumbs())
-					}
-					m, err = i.onEnter(m)
-					if err != nil {
-						return nil, nil, err
-					}
-					found = true
-					break
-				}
-			}
-			if !found {
-				return nil, nil, fmt.Errorf("%q not found in: %s", s, m.state.Breadcrumbs())
+				return m, err
 			}
 		}
 	}
+	return m, nil
+}
 
-	a, ok := m.state.(Accepting)
-	if !ok {
-		return nil, nil, fmt.Errorf("cannot move context to: %s", m.state.Breadcrumbs())
-	}
-
-	config, err := a.GetKubeconfig()
+// enter moves the model to the item with the given name in its current state.
+func enter(ctx context.Context, m model, name string) (model, error) {
+	items, err := m.state.Items(ctx, m.upCtx, m.navContext)
 	if err != nil {
-		return nil, nil, err
+		return m, err
+	}
+	for _, i := range items {
+		i, ok := i.(item)
+		if !ok || i.back || !i.Matches(name) {
+			continue
+		}
+		if i.unavailable != nil {
+			return m, i.unavailable
+		}
+		if i.onEnter == nil {
+			return m, fmt.Errorf("cannot enter %q in: %s", name, m.state.Breadcrumbs())
+		}
+		return i.onEnter(m)
+	}
+	if summary := unavailableSummary(items); summary != "" {
+		return m, fmt.Errorf("%q not found in: %s; %s", name, m.state.Breadcrumbs(), summary)
 	}
+	return m, fmt.Errorf("%q not found in: %s", name, m.state.Breadcrumbs())
+}
 
-	raw, err := config.RawConfig()
-	return &raw, a.Breadcrumbs(), err
+// unavailableSummary summarizes the spaces that can't be selected and why, or
+// returns an empty string if there are none.
+func unavailableSummary(items []list.Item) string {
+	var reasons []string
+	for _, i := range items {
+		if i, ok := i.(item); ok && i.unavailable != nil {
+			reasons = append(reasons, i.unavailable.itemText())
+		}
+	}
+	switch len(reasons) {
+	case 0:
+		return ""
+	case 1:
+		return "1 space is unavailable: " + reasons[0]
+	default:
+		return fmt.Sprintf("%d spaces are unavailable: %s", len(reasons), strings.Join(reasons, ", "))
+	}
 }
 
 // RunInteractive runs the interactive version of `up ctx`.
@@ -445,7 +718,14 @@ func (c *Cmd) kubeContextWriter(upCtx *upbound.Context, p upterm.Printer) kube.C
 		}
 	}
 
-	return kube.NewFileWriter(upCtx, c.File, c.KubeContext)
+	w := kube.NewFileWriter(upCtx, c.File, c.KubeContext, kube.WithKubeconfig(c.Flags.Kube.Kubeconfig))
+	if c.DryRun {
+		return &dryRunWriter{
+			printer:   p,
+			previewer: w,
+		}
+	}
+	return w
 }
 
 type getIngressHostFn func(ctx context.Context, cl corev1client.ConfigMapsGetter) (host string, ca []byte, err error)
@@ -460,7 +740,7 @@ func DeriveState(ctx context.Context, upCtx *upbound.Context, conf *clientcmdapi
 		return nil, err
 	}
 
-	if spaceExt == nil || !spaceInProfile(upCtx.Profile, spaceExt) {
+	if spaceExt == nil || !spaceInProfile(upCtx.Profile, upCtx.Organization, spaceExt) {
 		return rootState(ctx, upCtx)
 	}
 
@@ -472,11 +752,14 @@ func DeriveState(ctx context.Context, upCtx *upbound.Context, conf *clientcmdapi
 	return nil, errors.New("unable to derive state using context extension")
 }
 
-func spaceInProfile(p profile.Profile, spaceExt *upbound.SpaceExtension) bool {
+// spaceInProfile returns true if the space extension is for a space in the
+// given profile. Cloud spaces are matched against the given organization rather
+// than the profile's, so that an --organization override is respected.
+func spaceInProfile(p profile.Profile, org string, spaceExt *upbound.SpaceExtension) bool {
 	switch p.Type {
 	case profile.TypeCloud:
 		return spaceExt.Spec.Cloud != nil &&
-			spaceExt.Spec.Cloud.Organization == p.Organization
+			spaceExt.Spec.Cloud.Organization == org
 
 	case profile.TypeDisconnected:
 		return spaceExt.Spec.Disconnected != nil &&
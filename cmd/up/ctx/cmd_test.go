@@ -9,6 +9,8 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
@@ -17,6 +19,7 @@ import (
 	"github.com/upbound/up/internal/profile"
 	"github.com/upbound/up/internal/spaces"
 	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/upterm"
 )
 
 const (
@@ -558,7 +561,7 @@ func TestDeriveExistingDisconnectedState(t *testing.T) {
 }
 
 func TestDeriveExistingCloudState(t *testing.T) {
-	authOrgExec, _ := getOrgScopedAuthInfo(&upbound.Context{ProfileName: "profile"}, "org")
+	authOrgExec, _ := getOrgScopedAuthInfo(&upbound.Context{ProfileName: "profile"}, "org", "")
 
 	buildCloudExtension := func(org, space string) upbound.CloudConfiguration {
 		return upbound.CloudConfiguration{
@@ -794,3 +797,219 @@ func TestUpdateProfile(t *testing.T) {
 		t.Errorf("updateProfile returned unexpected error %v", err)
 	}
 }
+
+func TestServerURL(t *testing.T) {
+	ctpServer := "https://ingress/apis/spaces.upbound.io/v1beta1/namespaces/my-group/controlplanes/my-ctp/k8s"
+
+	tcs := map[string]struct {
+		conf    *clientcmdapi.Config
+		want    string
+		wantErr bool
+	}{
+		"ControlPlane": {
+			conf: &clientcmdapi.Config{
+				CurrentContext: "upbound",
+				Contexts:       map[string]*clientcmdapi.Context{"upbound": {Cluster: "upbound"}},
+				Clusters:       map[string]*clientcmdapi.Cluster{"upbound": {Server: ctpServer}},
+			},
+			want: ctpServer,
+		},
+		"MissingContext": {
+			conf: &clientcmdapi.Config{
+				CurrentContext: "upbound",
+			},
+			wantErr: true,
+		},
+		"MissingServer": {
+			conf: &clientcmdapi.Config{
+				CurrentContext: "upbound",
+				Contexts:       map[string]*clientcmdapi.Context{"upbound": {Cluster: "upbound"}},
+				Clusters:       map[string]*clientcmdapi.Cluster{"upbound": {}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			got, err := ServerURL(tc.conf)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ServerURL(...): want error %t, got %v", tc.wantErr, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ServerURL(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRunValidateArguments(t *testing.T) {
+	tcs := map[string]struct {
+		argument string
+		stored   string
+		wantErr  string
+	}{
+		"NoStoredContext": {
+			wantErr: `profile "default" has no current context to validate`,
+		},
+		"RelativePath": {
+			argument: "../my-ctp",
+			stored:   "my-org/my-space/my-group/my-ctp",
+			wantErr:  "--validate requires an absolute path argument",
+		},
+		"PreviousContext": {
+			argument: "-",
+			wantErr:  "--validate requires an absolute path argument",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			c := &Cmd{Argument: tc.argument, Validate: true}
+			upCtx := &upbound.Context{
+				ProfileName: "default",
+				Profile:     profile.Profile{CurrentKubeContext: tc.stored},
+			}
+
+			err := c.RunValidate(context.Background(), upCtx, upterm.NewTestPrinter())
+			if diff := cmp.Diff(tc.wantErr, fmt.Sprint(err)); diff != "" {
+				t.Errorf("RunValidate(...): -want err, +got err:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInspectContext(t *testing.T) {
+	withExtension := func(raw string) *clientcmdapi.Config {
+		return &clientcmdapi.Config{
+			CurrentContext: "upbound",
+			Contexts: map[string]*clientcmdapi.Context{
+				"upbound": {
+					Cluster:    "upbound",
+					Extensions: map[string]runtime.Object{upbound.ContextExtensionKeySpace: &runtime.Unknown{Raw: []byte(raw)}},
+				},
+			},
+		}
+	}
+	cloudProfile := profile.Profile{Type: profile.TypeCloud, Organization: "my-org"}
+
+	tcs := map[string]struct {
+		conf *clientcmdapi.Config
+		org  string
+		want contextInspection
+	}{
+		"CloudSpaceInProfile": {
+			conf: withExtension(`{"kind":"SpaceExtension","apiVersion":"upbound.io/v1alpha1","spec":{"cloud":{"organization":"my-org","space":"my-space"}}}`),
+			want: contextInspection{
+				Context:        "upbound",
+				Extension:      upbound.NewCloudV1Alpha1SpaceExtension("my-org", "my-space"),
+				MatchesProfile: true,
+			},
+		},
+		"CloudSpaceInOtherOrganization": {
+			conf: withExtension(`{"kind":"SpaceExtension","apiVersion":"upbound.io/v1alpha1","spec":{"cloud":{"organization":"other-org","space":"my-space"}}}`),
+			want: contextInspection{
+				Context:   "upbound",
+				Extension: upbound.NewCloudV1Alpha1SpaceExtension("other-org", "my-space"),
+			},
+		},
+		"CloudSpaceInOverriddenOrganization": {
+			conf: withExtension(`{"kind":"SpaceExtension","apiVersion":"upbound.io/v1alpha1","spec":{"cloud":{"organization":"other-org","space":"my-space"}}}`),
+			org:  "other-org",
+			want: contextInspection{
+				Context:        "upbound",
+				Extension:      upbound.NewCloudV1Alpha1SpaceExtension("other-org", "my-space"),
+				MatchesProfile: true,
+			},
+		},
+		"MissingContext": {
+			conf: &clientcmdapi.Config{CurrentContext: "upbound"},
+			want: contextInspection{
+				Context:  "upbound",
+				Problems: []string{`context "upbound" doesn't exist in the kubeconfig`},
+			},
+		},
+		"MissingExtension": {
+			conf: &clientcmdapi.Config{
+				CurrentContext: "kind",
+				Contexts:       map[string]*clientcmdapi.Context{"kind": {Cluster: "kind"}},
+			},
+			want: contextInspection{
+				Context:  "kind",
+				Problems: []string{`context has no "spaces.upbound.io/space" extension, so it wasn't created by up ctx and isn't in a space`},
+			},
+		},
+		"UnparseableExtension": {
+			conf: withExtension(`{"spec":`),
+			want: contextInspection{
+				Context:  "upbound",
+				Raw:      `{"spec":`,
+				Problems: []string{`cannot parse the "spaces.upbound.io/space" extension: unable to parse space extension to go struct`},
+			},
+		},
+		"HandEditedExtension": {
+			conf: withExtension(`{"kind":"SpaceExtension","apiVersion":"upbound.io/v1alpha1","spec":{"cloud":{"organization":"my-org"}}}`),
+			want: contextInspection{
+				Context:   "upbound",
+				Extension: upbound.NewCloudV1Alpha1SpaceExtension("my-org", ""),
+				Problems:  []string{"spec.cloud.space is empty"},
+			},
+		},
+		"MissingSpec": {
+			conf: withExtension(`{"kind":"SpaceExtension","apiVersion":"upbound.io/v1alpha1"}`),
+			want: contextInspection{
+				Context: "upbound",
+				Extension: &upbound.SpaceExtension{
+					TypeMeta: metav1.TypeMeta{Kind: "SpaceExtension", APIVersion: "upbound.io/v1alpha1"},
+				},
+				Problems: []string{"spec is missing"},
+			},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			org := tc.org
+			if org == "" {
+				org = cloudProfile.Organization
+			}
+			got := inspectContext(tc.conf, cloudProfile, org)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("inspectContext(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeriveStateOrganizationOverride(t *testing.T) {
+	conf := &clientcmdapi.Config{
+		CurrentContext: "upbound",
+		Contexts: map[string]*clientcmdapi.Context{
+			"upbound": {
+				Cluster: "upbound",
+				Extensions: map[string]runtime.Object{
+					upbound.ContextExtensionKeySpace: &runtime.Unknown{
+						Raw: []byte(`{"kind":"SpaceExtension","apiVersion":"upbound.io/v1alpha1","spec":{"cloud":{"organization":"my-org","space":"my-space"}}}`),
+					},
+				},
+			},
+		},
+	}
+	upCtx := &upbound.Context{
+		Organization: "other-org",
+		Profile:      profile.Profile{Type: profile.TypeCloud, Organization: "my-org"},
+	}
+
+	// The context is for a space in the profile's organization, but the
+	// override takes precedence, so we start from the overridden organization.
+	got, err := DeriveState(context.Background(), upCtx, conf, nil)
+	if err != nil {
+		t.Fatalf("DeriveState(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(&Organization{Name: "other-org"}, got); diff != "" {
+		t.Errorf("DeriveState(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("my-org", upCtx.Profile.Organization); diff != "" {
+		t.Errorf("DeriveState(...): profile organization: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package ctx
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/list"
+)
+
+const (
+	// filterValueSep separates the terms in an item's filter value.
+	filterValueSep = "\x00"
+
+	fuzzyMatchScore       = 1
+	fuzzyStartBonus       = 8
+	fuzzyWordStartBonus   = 6
+	fuzzyConsecutiveBonus = 5
+)
+
+// fuzzyFilter is a list.FilterFunc that ranks targets by how well they fuzzy
+// match the term. Targets may hold several terms separated by filterValueSep,
+// in which case the best matching term ranks the target. Targets with the same
+// score keep their order.
+func fuzzyFilter(term string, targets []string) []list.Rank {
+	type scored struct {
+		rank  list.Rank
+		score int
+	}
+	matches := make([]scored, 0, len(targets))
+	for i, t := range targets {
+		best, found := 0, false
+		for _, s := range strings.Split(t, filterValueSep) {
+			if score, ok := fuzzyScore(term, s); ok && (!found || score > best) {
+				best, found = score, true
+			}
+		}
+		if found {
+			matches = append(matches, scored{rank: list.Rank{Index: i}, score: best})
+		}
+	}
+
+	slices.SortStableFunc(matches, func(a, b scored) int { return b.score - a.score })
+
+	ranks := make([]list.Rank, len(matches))
+	for i, m := range matches {
+		ranks[i] = m.rank
+	}
+	return ranks
+}
+
+// fuzzyScore returns how well query matches target, ignoring case. The query
+// matches if its characters appear in target in order. Matches at the start
+// of the target or of a word within it, and consecutive matches, score higher.
+// Every character of the target that isn't matched lowers the score, so
+// shorter targets rank first.
+func fuzzyScore(query, target string) (int, bool) {
+	q := []rune(strings.ToLower(query))
+	t := []rune(target)
+	lt := []rune(strings.ToLower(target))
+	if len(q) == 0 {
+		return 0, true
+	}
+	if len(q) > len(t) {
+		return 0, false
+	}
+
+	// best[j] is the best score of the query so far with its last character
+	// matched at target[j], or -1 if there's no such match.
+	best := make([]int, len(t))
+	for j := range t {
+		best[j] = -1
+		if lt[j] == q[0] {
+			best[j] = fuzzyMatchScore + bonus(t, j)
+		}
+	}
+	for i := 1; i < len(q); i++ {
+		next := make([]int, len(t))
+		prevMax := -1 // best score matching q[i-1] before target[j-1]
+		for j := range t {
+			next[j] = -1
+			if j >= 2 {
+				prevMax = max(prevMax, best[j-2])
+			}
+			if j == 0 || lt[j] != q[i] {
+				continue
+			}
+			if prevMax >= 0 {
+				next[j] = prevMax + fuzzyMatchScore + bonus(t, j)
+			}
+			if best[j-1] >= 0 {
+				next[j] = max(next[j], best[j-1]+fuzzyMatchScore+fuzzyConsecutiveBonus)
+			}
+		}
+		best = next
+	}
+
+	score := slices.Max(best)
+	if score < 0 {
+		return 0, false
+	}
+	return score - (len(t) - len(q)), true
+}
+
+// bonus returns the bonus for matching target[j] when it starts the target or
+// a word, e.g. after a separator or at a lower to upper case change.
+func bonus(t []rune, j int) int {
+	switch {
+	case j == 0:
+		return fuzzyStartBonus
+	case !unicode.IsLetter(t[j-1]) && !unicode.IsDigit(t[j-1]):
+		return fuzzyWordStartBonus
+	case unicode.IsLower(t[j-1]) && unicode.IsUpper(t[j]):
+		return fuzzyWordStartBonus
+	default:
+		return 0
+	}
+}
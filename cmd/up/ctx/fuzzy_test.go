@@ -0,0 +1,134 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package ctx
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestFuzzyScore(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		query   string
+		target  string
+		matches bool
+	}{
+		"empty query": {
+			query:   "",
+			target:  "anything",
+			matches: true,
+		},
+		"exact": {
+			query:   "prod",
+			target:  "prod",
+			matches: true,
+		},
+		"case insensitive": {
+			query:   "PrOd",
+			target:  "prod",
+			matches: true,
+		},
+		"subsequence": {
+			query:   "pde",
+			target:  "prod-eu",
+			matches: true,
+		},
+		"out of order": {
+			query:   "dp",
+			target:  "prod",
+			matches: false,
+		},
+		"query longer than target": {
+			query:   "production",
+			target:  "prod",
+			matches: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, ok := fuzzyScore(tc.query, tc.target)
+			assert.Equal(t, ok, tc.matches)
+		})
+	}
+}
+
+func TestFuzzyFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		term     string
+		targets  []string
+		expected []int
+	}{
+		"exact match ranks first": {
+			term:     "prod",
+			targets:  []string{"my-prod", "prod-eu", "prod"},
+			expected: []int{2, 1, 0},
+		},
+		"prefix before word start": {
+			term:     "dev",
+			targets:  []string{"team-dev", "dev-team"},
+			expected: []int{1, 0},
+		},
+		"word start before middle": {
+			term:     "eu",
+			targets:  []string{"reuse", "prod-eu-1"},
+			expected: []int{1, 0},
+		},
+		"consecutive before scattered": {
+			term:     "ctp",
+			targets:  []string{"cats-tops", "my-ctp-a"},
+			expected: []int{1, 0},
+		},
+		"camel case word start": {
+			term:     "cp",
+			targets:  []string{"Controlplane", "ControlPlane"},
+			expected: []int{1, 0},
+		},
+		"non-matching items are dropped": {
+			term:     "xyz",
+			targets:  []string{"prod", "dev"},
+			expected: []int{},
+		},
+		"ties keep their order": {
+			term:     "a",
+			targets:  []string{"ab", "ac"},
+			expected: []int{0, 1},
+		},
+		"matching terms": {
+			term:     "space",
+			targets:  []string{"group-a", "ctp-b" + filterValueSep + "my-space"},
+			expected: []int{1},
+		},
+		"best term ranks the item": {
+			term:     "prod",
+			targets:  []string{"my-prod", "other" + filterValueSep + "prod"},
+			expected: []int{1, 0},
+		},
+		"empty filter values never match": {
+			term:     "a",
+			targets:  []string{"", "a"},
+			expected: []int{1},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ranks := fuzzyFilter(tc.term, tc.targets)
+			got := make([]int, 0, len(ranks))
+			for _, r := range ranks {
+				got = append(got, r.Index)
+			}
+			assert.DeepEqual(t, got, tc.expected)
+		})
+	}
+}
@@ -69,9 +69,22 @@ type item struct {
 
 	// notSelectable marks an item as unselectable in the list and will be skipped in navigation
 	notSelectable bool
+
+	// unavailable explains why an unselectable item can't be selected, if
+	// known.
+	unavailable *spaceUnavailableError
+}
+
+// FilterValue returns the text and matching terms of the item for fuzzy
+// filtering, separated by filterValueSep. Back buttons and unselectable items
+// never match.
+func (i item) FilterValue() string {
+	if i.back || i.notSelectable {
+		return ""
+	}
+	return strings.Join(append([]string{i.text}, i.matchingTerms...), filterValueSep)
 }
 
-func (i item) FilterValue() string { return "" }
 func (i item) Matches(s string) bool {
 	if strings.EqualFold(s, i.text) {
 		return true
@@ -122,9 +135,10 @@ func newList(items []list.Item) list.Model {
 	l.SetSpinner(spinner.MiniDot)
 	l.SetShowHelp(true)
 	l.SetShowStatusBar(false)
-	l.SetFilteringEnabled(false)
+	l.SetFilteringEnabled(true)
 	l.SetShowPagination(false)
-	l.SetShowFilter(false)
+	l.SetShowFilter(true)
+	l.Filter = fuzzyFilter
 
 	l.AdditionalShortHelpKeys = func() []key.Binding {
 		return []key.Binding{
@@ -191,6 +205,12 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) { //nolint:gocyclo // TO
 		return m, nil
 
 	case tea.KeyMsg:
+		// While typing a filter, or clearing an applied one, keys go to the
+		// list rather than navigating.
+		if m.list.FilterState() == list.Filtering ||
+			(m.list.FilterState() == list.FilterApplied && key.Matches(msg, m.list.KeyMap.ClearFilter)) {
+			break
+		}
 		switch {
 		case key.Matches(msg, exitBinding):
 			m.termination = &Termination{}
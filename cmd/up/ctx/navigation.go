@@ -5,7 +5,10 @@ package ctx
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"net"
+	"net/http"
 	"os"
 	"os/exec"
 	"slices"
@@ -19,9 +22,11 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
@@ -162,7 +167,11 @@ func (o *Organization) Items(ctx context.Context, upCtx *upbound.Context, navCtx
 		return nil, err
 	}
 
-	authInfo, err := getOrgScopedAuthInfo(upCtx, o.Name)
+	if c, ok := navCtx.ingressReader.(*spaces.IngressCache); ok && navCtx.refreshIngresses {
+		c.Invalidate()
+	}
+
+	authInfo, err := getOrgScopedAuthInfo(upCtx, o.Name, navCtx.staticToken)
 	if err != nil {
 		return nil, err
 	}
@@ -186,52 +195,20 @@ func (o *Organization) Items(ctx context.Context, upCtx *upbound.Context, navCtx
 					}
 				}
 
-				if space.Labels[upboundv1alpha1.SpaceInaccessibleLabelKey] == "true" {
+				ingress, unavailable := spaceIngress(ctx, navCtx.ingressReader, navCtx.spaceIngressBackoff(), space)
+				if unavailable != nil {
 					mu.Lock()
 					unselectableItems = append(unselectableItems, item{
-						text:          space.GetObjectMeta().GetName() + " (requires tier upgrade)",
+						text:          unavailable.itemText(),
 						kind:          "space",
 						notSelectable: true,
 						matchingTerms: []string{space.GetObjectMeta().GetName()},
+						unavailable:   unavailable,
 					})
 					mu.Unlock()
 					continue
 				}
 
-				if space.Status.ConnectionDetails.Status == upboundv1alpha1.ConnectionStatusUnreachable {
-					mu.Lock()
-					unselectableItems = append(unselectableItems, item{
-						text:          space.GetObjectMeta().GetName() + " (unreachable)",
-						kind:          "space",
-						notSelectable: true,
-						matchingTerms: []string{space.GetObjectMeta().GetName()},
-					})
-					mu.Unlock()
-					continue
-				}
-
-				ingress, err := navCtx.ingressReader.Get(ctx, space)
-				if err != nil {
-					mu.Lock()
-					if errors.Is(err, spaces.ErrSpaceConnection) {
-						unselectableItems = append(unselectableItems, item{
-							text:          space.GetObjectMeta().GetName() + " (unreachable)",
-							kind:          "space",
-							notSelectable: true,
-							matchingTerms: []string{space.GetObjectMeta().GetName()},
-						})
-					} else {
-						unselectableItems = append(unselectableItems, item{
-							text:          fmt.Sprintf("%s (error: %v)", space.GetObjectMeta().GetName(), err),
-							kind:          "space",
-							notSelectable: true,
-							matchingTerms: []string{space.GetObjectMeta().GetName()},
-						})
-					}
-					mu.Unlock()
-					continue
-				}
-
 				mu.Lock()
 				items = append(items, item{text: space.GetObjectMeta().GetName(), kind: "space", onEnter: func(m model) (model, error) {
 					m.state = &CloudSpace{
@@ -258,6 +235,149 @@ func (o *Organization) Items(ctx context.Context, upCtx *upbound.Context, navCtx
 	return append(items, unselectableItems...), nil
 }
 
+// defaultIngressBackoff is how getting a space's ingress is retried after a
+// transient error, unless the nav context overrides it. It's short so that a
+// space that's really unreachable doesn't hold up listing spaces for long.
+var defaultIngressBackoff = wait.Backoff{ //nolint:gochecknoglobals // This is effectively a constant.
+	Duration: 200 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.1,
+	Steps:    3,
+}
+
+// spaceIngressBackoff returns how getting a space's ingress is retried.
+func (n *navContext) spaceIngressBackoff() wait.Backoff {
+	if n.ingressBackoff != nil {
+		return *n.ingressBackoff
+	}
+	return defaultIngressBackoff
+}
+
+// isTransient returns true if an error getting a space's ingress may succeed
+// if retried, such as a timeout or a server error.
+func isTransient(err error) bool {
+	var (
+		status kerrors.APIStatus
+		netErr net.Error
+	)
+	switch {
+	case kerrors.IsTooManyRequests(err) || kerrors.IsServerTimeout(err) || kerrors.IsTimeout(err):
+		return true
+	case errors.As(err, &status) && status.Status().Code >= http.StatusInternalServerError:
+		return true
+	case errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()):
+		return true
+	default:
+		return false
+	}
+}
+
+// spaceIngress returns the ingress of the given space, or an error explaining
+// why the space can't be selected. Transient errors getting the ingress are
+// retried with the given backoff before the space is considered unavailable.
+func spaceIngress(ctx context.Context, ir spaces.IngressReader, backoff wait.Backoff, space upboundv1alpha1.Space) (*spaces.SpaceIngress, *spaceUnavailableError) {
+	if space.Labels[upboundv1alpha1.SpaceInaccessibleLabelKey] == "true" {
+		return nil, &spaceUnavailableError{
+			space:  space.GetName(),
+			reason: "requires tier upgrade",
+			hint:   "upgrade your organization's plan to use this space",
+		}
+	}
+
+	if space.Status.ConnectionDetails.Status == upboundv1alpha1.ConnectionStatusUnreachable {
+		return nil, &spaceUnavailableError{
+			space:  space.GetName(),
+			reason: "unreachable",
+			hint:   "the space isn't connected to Upbound; check that it's running and can reach Upbound",
+		}
+	}
+
+	var ingress *spaces.SpaceIngress
+	err := retry.OnError(backoff, func(err error) bool {
+		return ctx.Err() == nil && isTransient(err)
+	}, func() error {
+		var err error
+		ingress, err = ir.Get(ctx, space)
+		return err
+	})
+	switch {
+	case errors.Is(err, spaces.ErrSpaceConnection):
+		return nil, &spaceUnavailableError{
+			space:  space.GetName(),
+			reason: "unreachable",
+			hint:   spaceConnectionHint(err),
+			err:    err,
+		}
+	case err != nil:
+		return nil, &spaceUnavailableError{
+			space:  space.GetName(),
+			reason: "error",
+			err:    err,
+		}
+	}
+	return ingress, nil
+}
+
+// spaceUnavailableError explains why a space can't be selected, with a hint
+// for resolving the problem when there is one.
+type spaceUnavailableError struct {
+	space  string
+	reason string
+	hint   string
+	err    error
+}
+
+func (e *spaceUnavailableError) Error() string {
+	msg := fmt.Sprintf("space %q is not available (%s)", e.space, e.reason)
+	if e.err != nil {
+		msg += ": " + e.err.Error()
+	}
+	if e.hint != "" {
+		msg += "; " + e.hint
+	}
+	return msg
+}
+
+func (e *spaceUnavailableError) Unwrap() error {
+	return e.err
+}
+
+// itemText returns the text of the list item for the unavailable space. The
+// hint is shown in place of the underlying error, which is often too long to
+// fit in the list.
+func (e *spaceUnavailableError) itemText() string {
+	detail := e.hint
+	if detail == "" && e.err != nil {
+		detail = e.err.Error()
+	}
+	if detail == "" {
+		return fmt.Sprintf("%s (%s)", e.space, e.reason)
+	}
+	return fmt.Sprintf("%s (%s: %s)", e.space, e.reason, detail)
+}
+
+// spaceConnectionHint returns a hint for resolving an error connecting to a
+// space. It only inspects the error, so it never slows down listing spaces.
+func spaceConnectionHint(err error) string {
+	var (
+		dnsErr  *net.DNSError
+		certErr *tls.CertificateVerificationError
+		netErr  net.Error
+	)
+	switch {
+	case errors.As(err, &dnsErr):
+		return "its address can't be resolved; check your DNS settings, or connect to the VPN the space requires"
+	case errors.As(err, &certErr):
+		return "its certificate isn't trusted; check whether your network intercepts TLS connections"
+	case kerrors.IsUnauthorized(err) || kerrors.IsForbidden(err):
+		return "your credentials were rejected; run `up login` to re-authenticate"
+	case errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()):
+		return "the connection timed out; the space may only be reachable from a private network or VPN"
+	default:
+		return "check your network connection; the space may only be reachable from a private network or VPN"
+	}
+}
+
 // Breadcrumbs returns breadcrumbs for an organization nav state.
 func (o *Organization) Breadcrumbs() Breadcrumbs {
 	return []string{o.Name}
@@ -672,7 +792,16 @@ func (ctp *ControlPlane) NamespacedName() types.NamespacedName {
 	return types.NamespacedName{Name: ctp.Name, Namespace: ctp.Group.Name}
 }
 
-func getOrgScopedAuthInfo(upCtx *upbound.Context, orgName string) (*clientcmdapi.AuthInfo, error) {
+// getOrgScopedAuthInfo returns auth info for an organization's spaces. By
+// default it runs `up organization token` as an exec credential plugin, which
+// keeps the token fresh but requires up at runtime. If staticToken is set it's
+// embedded instead, making the kubeconfig self-contained but leaving it unusable
+// once the token expires.
+func getOrgScopedAuthInfo(upCtx *upbound.Context, orgName, staticToken string) (*clientcmdapi.AuthInfo, error) {
+	if staticToken != "" {
+		return &clientcmdapi.AuthInfo{Token: staticToken}, nil
+	}
+
 	// find the current executable path
 	cmd, err := os.Executable()
 	if err != nil {
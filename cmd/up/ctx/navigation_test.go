@@ -0,0 +1,542 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package ctx
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+	upboundv1alpha1 "github.com/upbound/up-sdk-go/apis/upbound/v1alpha1"
+	"github.com/upbound/up/internal/spaces"
+	"github.com/upbound/up/internal/upbound"
+)
+
+type mockIngressReader struct {
+	ingress *spaces.SpaceIngress
+	err     error
+}
+
+func (m *mockIngressReader) Get(_ context.Context, _ upboundv1alpha1.Space) (*spaces.SpaceIngress, error) {
+	return m.ingress, m.err
+}
+
+// flakyIngressReader fails with an error the given number of times before
+// returning an ingress.
+type flakyIngressReader struct {
+	ingress  *spaces.SpaceIngress
+	err      error
+	failures int
+	calls    int
+}
+
+func (f *flakyIngressReader) Get(_ context.Context, _ upboundv1alpha1.Space) (*spaces.SpaceIngress, error) {
+	f.calls++
+	if f.calls <= f.failures {
+		return nil, f.err
+	}
+	return f.ingress, nil
+}
+
+func TestSpaceIngress(t *testing.T) {
+	errBoom := errors.New("boom")
+	ingress := &spaces.SpaceIngress{Host: "ingress.example.com"}
+
+	space := func(labels map[string]string, status upboundv1alpha1.ConnectionStatus) upboundv1alpha1.Space {
+		s := upboundv1alpha1.Space{}
+		s.SetName("my-space")
+		s.SetLabels(labels)
+		s.Status.ConnectionDetails.Status = status
+		return s
+	}
+
+	type args struct {
+		space upboundv1alpha1.Space
+		ir    spaces.IngressReader
+	}
+	type want struct {
+		ingress *spaces.SpaceIngress
+		text    string
+		err     string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Available": {
+			reason: "A reachable space should return its ingress.",
+			args: args{
+				space: space(nil, ""),
+				ir:    &mockIngressReader{ingress: ingress},
+			},
+			want: want{
+				ingress: ingress,
+			},
+		},
+		"RequiresTierUpgrade": {
+			reason: "An inaccessible space should explain that a tier upgrade is required.",
+			args: args{
+				space: space(map[string]string{upboundv1alpha1.SpaceInaccessibleLabelKey: "true"}, ""),
+				ir:    &mockIngressReader{ingress: ingress},
+			},
+			want: want{
+				text: "my-space (requires tier upgrade: upgrade your organization's plan to use this space)",
+				err:  `space "my-space" is not available (requires tier upgrade); upgrade your organization's plan to use this space`,
+			},
+		},
+		"Disconnected": {
+			reason: "A space that isn't connected to Upbound should explain that it's unreachable.",
+			args: args{
+				space: space(nil, upboundv1alpha1.ConnectionStatusUnreachable),
+				ir:    &mockIngressReader{ingress: ingress},
+			},
+			want: want{
+				text: "my-space (unreachable: the space isn't connected to Upbound; check that it's running and can reach Upbound)",
+				err:  `space "my-space" is not available (unreachable); the space isn't connected to Upbound; check that it's running and can reach Upbound`,
+			},
+		},
+		"ConnectionFailed": {
+			reason: "A space whose API can't be reached should show a hint rather than the underlying error.",
+			args: args{
+				space: space(nil, ""),
+				ir:    &mockIngressReader{err: errors.Errorf("%w: %w", spaces.ErrSpaceConnection, errBoom)},
+			},
+			want: want{
+				text: "my-space (unreachable: check your network connection; the space may only be reachable from a private network or VPN)",
+				err:  `space "my-space" is not available (unreachable): failed to connect to space through the API client: boom; check your network connection; the space may only be reachable from a private network or VPN`,
+			},
+		},
+		"OtherError": {
+			reason: "Other errors getting a space's ingress should be shown as is.",
+			args: args{
+				space: space(nil, ""),
+				ir:    &mockIngressReader{err: errBoom},
+			},
+			want: want{
+				text: "my-space (error: boom)",
+				err:  `space "my-space" is not available (error): boom`,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, unavailable := spaceIngress(t.Context(), tc.args.ir, wait.Backoff{Steps: 1}, tc.args.space)
+			if diff := cmp.Diff(tc.want.ingress, got); diff != "" {
+				t.Errorf("\n%s\nspaceIngress(...): -want ingress, +got ingress:\n%s", tc.reason, diff)
+			}
+
+			var text, err string
+			if unavailable != nil {
+				text, err = unavailable.itemText(), unavailable.Error()
+			}
+			if diff := cmp.Diff(tc.want.text, text); diff != "" {
+				t.Errorf("\n%s\nspaceIngress(...): -want item text, +got item text:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err); diff != "" {
+				t.Errorf("\n%s\nspaceIngress(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSpaceIngressRetry(t *testing.T) {
+	ingress := &spaces.SpaceIngress{Host: "ingress.example.com"}
+	backoff := wait.Backoff{Duration: time.Millisecond, Factor: 1, Steps: 3}
+
+	space := upboundv1alpha1.Space{}
+	space.SetName("my-space")
+
+	type want struct {
+		ingress *spaces.SpaceIngress
+		calls   int
+		text    string
+	}
+
+	cases := map[string]struct {
+		reason string
+		ir     *flakyIngressReader
+		want   want
+	}{
+		"TransientErrorRecovers": {
+			reason: "A space whose ingress fails transiently should be available once a retry succeeds.",
+			ir: &flakyIngressReader{
+				ingress:  ingress,
+				err:      errors.Errorf("%w: %w", spaces.ErrSpaceConnection, kerrors.NewServiceUnavailable("try again")),
+				failures: 2,
+			},
+			want: want{
+				ingress: ingress,
+				calls:   3,
+			},
+		},
+		"TimeoutRecovers": {
+			reason: "A timeout getting a space's ingress should be retried.",
+			ir: &flakyIngressReader{
+				ingress:  ingress,
+				err:      errors.Wrap(context.DeadlineExceeded, "get"),
+				failures: 1,
+			},
+			want: want{
+				ingress: ingress,
+				calls:   2,
+			},
+		},
+		"TransientErrorPersists": {
+			reason: "A space whose ingress keeps failing transiently should be unavailable once the retries are exhausted.",
+			ir: &flakyIngressReader{
+				ingress:  ingress,
+				err:      kerrors.NewInternalError(errors.New("boom")),
+				failures: 5,
+			},
+			want: want{
+				calls: 3,
+				text:  "my-space (error: Internal error occurred: boom)",
+			},
+		},
+		"PermanentErrorNotRetried": {
+			reason: "An error that won't succeed if retried should make the space unavailable immediately.",
+			ir: &flakyIngressReader{
+				ingress:  ingress,
+				err:      kerrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "ingress-public", errors.New("denied")),
+				failures: 1,
+			},
+			want: want{
+				calls: 1,
+				text:  `my-space (error: configmaps "ingress-public" is forbidden: denied)`,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, unavailable := spaceIngress(t.Context(), tc.ir, backoff, space)
+			if diff := cmp.Diff(tc.want.ingress, got); diff != "" {
+				t.Errorf("\n%s\nspaceIngress(...): -want ingress, +got ingress:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, tc.ir.calls); diff != "" {
+				t.Errorf("\n%s\nspaceIngress(...): -want calls, +got calls:\n%s", tc.reason, diff)
+			}
+
+			var text string
+			if unavailable != nil {
+				text = unavailable.itemText()
+			}
+			if diff := cmp.Diff(tc.want.text, text); diff != "" {
+				t.Errorf("\n%s\nspaceIngress(...): -want item text, +got item text:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUnavailableSummary(t *testing.T) {
+	unavailable := func(name, reason string) item {
+		return item{
+			text:          name,
+			notSelectable: true,
+			unavailable:   &spaceUnavailableError{space: name, reason: reason},
+		}
+	}
+
+	cases := map[string]struct {
+		reason string
+		items  []list.Item
+		want   string
+	}{
+		"AllAvailable": {
+			reason: "No summary should be returned if every space is available.",
+			items:  []list.Item{item{text: "space-a"}},
+			want:   "",
+		},
+		"OneUnavailable": {
+			reason: "A single unavailable space should be summarized with its reason.",
+			items:  []list.Item{item{text: "space-a"}, unavailable("space-b", "unreachable")},
+			want:   "1 space is unavailable: space-b (unreachable)",
+		},
+		"SeveralUnavailable": {
+			reason: "Several unavailable spaces should be counted and listed with their reasons.",
+			items:  []list.Item{unavailable("space-a", "unreachable"), item{text: "space-b"}, unavailable("space-c", "requires tier upgrade")},
+			want:   "2 spaces are unavailable: space-a (unreachable), space-c (requires tier upgrade)",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, unavailableSummary(tc.items)); diff != "" {
+				t.Errorf("\n%s\nunavailableSummary(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSpaceConnectionHint(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   string
+	}{
+		"DNS": {
+			reason: "DNS errors should suggest checking DNS or the VPN.",
+			err:    &net.DNSError{Err: "no such host", Name: "space.example.com", IsNotFound: true},
+			want:   "its address can't be resolved; check your DNS settings, or connect to the VPN the space requires",
+		},
+		"Unauthorized": {
+			reason: "Rejected credentials should suggest logging in again.",
+			err:    kerrors.NewUnauthorized("expired"),
+			want:   "your credentials were rejected; run `up login` to re-authenticate",
+		},
+		"Forbidden": {
+			reason: "Rejected credentials should suggest logging in again.",
+			err:    kerrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "ingress-public", errors.New("denied")),
+			want:   "your credentials were rejected; run `up login` to re-authenticate",
+		},
+		"Timeout": {
+			reason: "Timeouts should suggest the space may be on a private network.",
+			err:    errors.Wrap(context.DeadlineExceeded, "get"),
+			want:   "the connection timed out; the space may only be reachable from a private network or VPN",
+		},
+		"Other": {
+			reason: "Other errors should suggest checking the network.",
+			err:    errors.New("connection refused"),
+			want:   "check your network connection; the space may only be reachable from a private network or VPN",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := spaceConnectionHint(errors.Errorf("%w: %w", spaces.ErrSpaceConnection, tc.err))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nspaceConnectionHint(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// fakeSpace is a space whose groups and control planes are served by a fake
+// client.
+type fakeSpace struct {
+	name string
+	cl   client.Client
+}
+
+func (s *fakeSpace) Items(ctx context.Context, _ *upbound.Context, _ *navContext) ([]list.Item, error) {
+	groups, err := listGroupsInSpace(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]list.Item, 0, len(groups))
+	for _, g := range groups {
+		items = append(items, item{text: g.Name, kind: "group", onEnter: func(m model) (model, error) {
+			m.state = g
+			return m, nil
+		}})
+	}
+	return items, nil
+}
+
+func (s *fakeSpace) Breadcrumbs() Breadcrumbs { return Breadcrumbs{"disconnected", s.name} }
+
+func (s *fakeSpace) GetKubeconfig() (clientcmd.ClientConfig, error) { return nil, nil }
+
+func (s *fakeSpace) Name() string { return s.name }
+
+func (s *fakeSpace) BuildKubeconfig(_ types.NamespacedName) (clientcmd.ClientConfig, error) {
+	return nil, nil
+}
+
+func (s *fakeSpace) getClient() (client.Client, error) { return s.cl, nil }
+
+func TestNavigate(t *testing.T) {
+	group := func(name string) *corev1.Namespace {
+		ns := &corev1.Namespace{}
+		ns.SetName(name)
+		ns.SetLabels(map[string]string{spacesv1beta1.ControlPlaneGroupLabelKey: "true"})
+		return ns
+	}
+	ctp := func(group, name string) *spacesv1beta1.ControlPlane {
+		c := &spacesv1beta1.ControlPlane{}
+		c.SetNamespace(group)
+		c.SetName(name)
+		return c
+	}
+
+	space := &fakeSpace{
+		name: "my-space",
+		cl: fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(group("group-a"), group("group-b"), ctp("group-a", "ctp-1"), ctp("group-a", "ctp-2"), ctp("group-b", "ctp-3")).
+			Build(),
+	}
+	groupA := &Group{Space: space, Name: "group-a"}
+	ctp1 := &ControlPlane{Group: *groupA, Name: "ctp-1"}
+
+	type args struct {
+		state NavigationState
+		path  string
+	}
+	type want struct {
+		breadcrumbs string
+		err         string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"ControlPlaneToSibling": {
+			reason: "Moving up from a control plane and back down should reach another control plane in its group.",
+			args:   args{state: ctp1, path: "../ctp-2"},
+			want:   want{breadcrumbs: "disconnected/my-space/group-a/ctp-2"},
+		},
+		"ControlPlaneToOtherGroup": {
+			reason: "Moving up twice from a control plane should allow entering another group.",
+			args:   args{state: ctp1, path: "../../group-b/ctp-3"},
+			want:   want{breadcrumbs: "disconnected/my-space/group-b/ctp-3"},
+		},
+		"ControlPlaneToSpace": {
+			reason: "Moving up twice from a control plane should reach its space.",
+			args:   args{state: ctp1, path: "../.."},
+			want:   want{breadcrumbs: "disconnected/my-space"},
+		},
+		"ControlPlaneMixed": {
+			reason: "Current-state segments and extra slashes should be ignored among parent and forward segments.",
+			args:   args{state: ctp1, path: ".//../.././group-b/./ctp-3/"},
+			want:   want{breadcrumbs: "disconnected/my-space/group-b/ctp-3"},
+		},
+		"ControlPlaneBackAndForth": {
+			reason: "Parent segments should be allowed after forward segments.",
+			args:   args{state: ctp1, path: "../ctp-2/../../group-b/../group-a/ctp-1"},
+			want:   want{breadcrumbs: "disconnected/my-space/group-a/ctp-1"},
+		},
+		"ControlPlanePastRoot": {
+			reason: "Moving up past the space should fail at the space, which has no parent.",
+			args:   args{state: ctp1, path: "../../../group-a"},
+			want:   want{err: "cannot move to parent context from: disconnected/my-space"},
+		},
+		"GroupToControlPlane": {
+			reason: "A forward segment from a group should enter its control plane.",
+			args:   args{state: groupA, path: "./ctp-1"},
+			want:   want{breadcrumbs: "disconnected/my-space/group-a/ctp-1"},
+		},
+		"GroupToOtherGroup": {
+			reason: "Moving up from a group should allow entering another group's control plane.",
+			args:   args{state: groupA, path: "../group-b/ctp-3"},
+			want:   want{breadcrumbs: "disconnected/my-space/group-b/ctp-3"},
+		},
+		"GroupNotFound": {
+			reason: "A segment that doesn't name an item should fail at the state it was looked up in.",
+			args:   args{state: groupA, path: "../group-c/ctp-1"},
+			want:   want{err: `"group-c" not found in: disconnected/my-space`},
+		},
+		"GroupBackItem": {
+			reason: "The back item of a state can only be entered with a parent segment.",
+			args:   args{state: groupA, path: "./controlplanes"},
+			want:   want{err: `"controlplanes" not found in: disconnected/my-space/group-a`},
+		},
+		"SpaceToControlPlane": {
+			reason: "Forward segments from a space should enter a group and then a control plane.",
+			args:   args{state: space, path: "./group-a/../group-b/ctp-3"},
+			want:   want{breadcrumbs: "disconnected/my-space/group-b/ctp-3"},
+		},
+		"SpacePastRoot": {
+			reason: "A space has no parent.",
+			args:   args{state: space, path: ".."},
+			want:   want{err: "cannot move to parent context from: disconnected/my-space"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m, err := navigate(context.Background(), model{state: tc.args.state}, tc.args.path)
+
+			gotErr := ""
+			if err != nil {
+				gotErr = err.Error()
+			}
+			if diff := cmp.Diff(tc.want.err, gotErr); diff != "" {
+				t.Fatalf("\n%s\nnavigate(...): -want err, +got err:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.breadcrumbs, m.state.Breadcrumbs().String()); diff != "" {
+				t.Errorf("\n%s\nnavigate(...): -want breadcrumbs, +got breadcrumbs:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGetOrgScopedAuthInfo(t *testing.T) {
+	upCtx := &upbound.Context{ProfileName: "my-profile"}
+
+	t.Run("Exec", func(t *testing.T) {
+		got, err := getOrgScopedAuthInfo(upCtx, "my-org", "")
+		if diff := cmp.Diff(nil, err); diff != "" {
+			t.Fatalf("getOrgScopedAuthInfo(...): -want err, +got err:\n%s", diff)
+		}
+		if got.Exec == nil || got.Token != "" {
+			t.Fatalf("getOrgScopedAuthInfo(...): want exec credential and no token, got %+v", got)
+		}
+		want := []clientcmdapi.ExecEnvVar{{Name: "ORGANIZATION", Value: "my-org"}, {Name: "UP_PROFILE", Value: "my-profile"}}
+		if diff := cmp.Diff([]string{"organization", "token"}, got.Exec.Args); diff != "" {
+			t.Errorf("getOrgScopedAuthInfo(...): -want args, +got args:\n%s", diff)
+		}
+		if diff := cmp.Diff(want, got.Exec.Env); diff != "" {
+			t.Errorf("getOrgScopedAuthInfo(...): -want env, +got env:\n%s", diff)
+		}
+	})
+
+	t.Run("StaticToken", func(t *testing.T) {
+		got, err := getOrgScopedAuthInfo(upCtx, "my-org", "my-token")
+		if diff := cmp.Diff(nil, err); diff != "" {
+			t.Fatalf("getOrgScopedAuthInfo(...): -want err, +got err:\n%s", diff)
+		}
+		if diff := cmp.Diff(&clientcmdapi.AuthInfo{Token: "my-token"}, got); diff != "" {
+			t.Errorf("getOrgScopedAuthInfo(...): -want auth info, +got auth info:\n%s", diff)
+		}
+	})
+}
+
+func TestStateSpace(t *testing.T) {
+	space := &CloudSpace{name: "my-space"}
+	group := &Group{Space: space, Name: "my-group"}
+
+	cases := map[string]struct {
+		state NavigationState
+		want  Space
+	}{
+		"Space":        {state: space, want: space},
+		"Group":        {state: group, want: space},
+		"ControlPlane": {state: &ControlPlane{Group: *group, Name: "my-ctp"}, want: space},
+		"Organization": {state: &Organization{Name: "my-org"}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := stateSpace(tc.state); got != tc.want {
+				t.Errorf("stateSpace(...): want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -27,3 +27,35 @@ func (p *printWriter) Write(config *clientcmdapi.Config) error {
 	p.printer.PrintResult(string(b))
 	return nil
 }
+
+// previewer previews the changes that writing a kubeconfig would make.
+type previewer interface {
+	Preview(config *clientcmdapi.Config) ([]kube.ConfigChange, error)
+}
+
+// dryRunWriter prints the changes that writing a kubeconfig would make,
+// without writing it.
+type dryRunWriter struct {
+	printer   upterm.Printer
+	previewer previewer
+}
+
+var _ kube.ContextWriter = &dryRunWriter{}
+
+// Write implements kubeContextWriter.Write.
+func (d *dryRunWriter) Write(config *clientcmdapi.Config) error {
+	changes, err := d.previewer.Preview(config)
+	if err != nil {
+		return err
+	}
+
+	if len(changes) == 0 {
+		d.printer.Println("No changes to kubeconfig.")
+		return nil
+	}
+	d.printer.Println("Changes to kubeconfig:")
+	for _, c := range changes {
+		d.printer.Printfln("  %s", c)
+	}
+	return nil
+}
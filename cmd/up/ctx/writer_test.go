@@ -0,0 +1,78 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package ctx
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/upbound/up/internal/config"
+	"github.com/upbound/up/internal/kube"
+	"github.com/upbound/up/internal/upterm"
+)
+
+type fakePreviewer struct {
+	changes []kube.ConfigChange
+	err     error
+}
+
+func (f *fakePreviewer) Preview(_ *clientcmdapi.Config) ([]kube.ConfigChange, error) {
+	return f.changes, f.err
+}
+
+func TestDryRunWriterWrite(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	tests := map[string]struct {
+		reason    string
+		previewer previewer
+		want      string
+		err       error
+	}{
+		"PreviewError": {
+			reason:    "Errors previewing the changes should be returned.",
+			previewer: &fakePreviewer{err: errBoom},
+			err:       errBoom,
+		},
+		"NoChanges": {
+			reason:    "A kubeconfig that wouldn't change should be reported as such.",
+			previewer: &fakePreviewer{},
+			want:      "No changes to kubeconfig.\n",
+		},
+		"Changes": {
+			reason: "Each change that would be made should be printed.",
+			previewer: &fakePreviewer{changes: []kube.ConfigChange{
+				{Kind: "context", Name: "upbound-previous", Action: "added"},
+				{Kind: "cluster", Name: "upbound", Action: "modified"},
+			}},
+			want: "Changes to kubeconfig:\n" +
+				"  added context \"upbound-previous\"\n" +
+				"  modified cluster \"upbound\"\n",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var out bytes.Buffer
+			w := &dryRunWriter{
+				printer:   upterm.NewPrinter(&out, &out, config.FormatDefault, false),
+				previewer: tt.previewer,
+			}
+
+			err := w.Write(&clientcmdapi.Config{})
+			if diff := cmp.Diff(tt.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nWrite(...): -want err, +got err:\n%s", tt.reason, diff)
+			}
+			if diff := cmp.Diff(tt.want, out.String()); diff != "" {
+				t.Errorf("\n%s\nWrite(...): -want output, +got output:\n%s", tt.reason, diff)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/alecthomas/kong"
 	"github.com/spf13/afero"
@@ -44,7 +45,8 @@ type addCmd struct {
 	// TODO(@tnthornton) remove cacheDir flag. Having a user supplied flag
 	// can result in broken behavior between xpls and dep. CacheDir should
 	// only be supplied by the Config.
-	CacheDir string `default:"~/.up/cache/" env:"CACHE_DIR" help:"Directory used for caching package images." type:"path"`
+	CacheDir string        `default:"~/.up/cache/" env:"CACHE_DIR"                                                                                                                  help:"Directory used for caching package images." type:"path"`
+	CacheTTL time.Duration `env:"CACHE_TTL"        help:"Prune dependency cache entries that haven't been used within this duration. Entries used by the project are never pruned."`
 
 	m        *project.DependencyManager
 	modelsFS afero.Fs
@@ -88,6 +90,7 @@ func (c *addCmd) AfterApply(kongCtx *kong.Context, upCtx *upbound.Context) error
 
 	m, err := project.NewDependencyManager(upCtx, c.proj, c.projFS,
 		project.WithCacheFS(afero.NewBasePathFs(afero.NewOsFs(), c.CacheDir)),
+		project.WithCacheTTL(c.CacheTTL),
 	)
 	if err != nil {
 		return err
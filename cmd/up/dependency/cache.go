@@ -6,10 +6,14 @@ package dependency
 import (
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/alecthomas/kong"
 	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/duration"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
 
@@ -33,9 +37,10 @@ type updateCacheCmd struct {
 	// TODO(@tnthornton) remove cacheDir flag. Having a user supplied flag
 	// can result in broken behavior between xpls and dep. CacheDir should
 	// only be supplied by the Config.
-	CacheDir    string `default:"~/.up/cache/"   env:"CACHE_DIR"                                                             help:"Directory used for caching package images."                                                      type:"path"`
-	GitToken    string `env:"UP_GIT_TOKEN"       help:"Token for git HTTPS authentication (GitHub PAT, GitLab token, etc.)."`
-	GitUsername string `default:"x-access-token" env:"UP_GIT_USERNAME"                                                       help:"Username for git HTTPS authentication. Use your Bitbucket username for Bitbucket app passwords."`
+	CacheDir    string        `default:"~/.up/cache/"   env:"CACHE_DIR"                                                                                                                  help:"Directory used for caching package images."                                                      type:"path"`
+	CacheTTL    time.Duration `env:"CACHE_TTL"          help:"Prune dependency cache entries that haven't been used within this duration. Entries used by the project are never pruned."`
+	GitToken    string        `env:"UP_GIT_TOKEN"       help:"Token for git HTTPS authentication (GitHub PAT, GitLab token, etc.)."`
+	GitUsername string        `default:"x-access-token" env:"UP_GIT_USERNAME"                                                                                                            help:"Username for git HTTPS authentication. Use your Bitbucket username for Bitbucket app passwords."`
 }
 
 //go:embed help/update-cache.md
@@ -69,6 +74,7 @@ func (c *updateCacheCmd) AfterApply(kongCtx *kong.Context, upCtx *upbound.Contex
 
 	// Configure git auth provider based on environment/flags
 	managerOpts := common.BuildManagerOptions(cchFS, c.GitToken, c.GitUsername)
+	managerOpts = append(managerOpts, project.WithCacheTTL(c.CacheTTL))
 
 	m, err := project.NewDependencyManager(upCtx, c.proj, c.projFS, managerOpts...)
 	if err != nil {
@@ -167,3 +173,185 @@ func (c *cleanCacheCmd) Run(p upterm.Printer) error {
 	p.Println("xpkg cache cleaned")
 	return nil
 }
+
+// cacheCmd contains commands for managing the dependency cache.
+type cacheCmd struct {
+	Info  infoCacheCmd  `cmd:"" help:"Show the packages in the dependency cache."`
+	Prune pruneCacheCmd `cmd:"" help:"Remove stale entries from the dependency cache."`
+}
+
+// infoCacheCmd shows the contents of the cache.
+type infoCacheCmd struct {
+	c *cache.Local
+
+	// TODO(@tnthornton) remove cacheDir flag. Having a user supplied flag
+	// can result in broken behavior between xpls and dep. CacheDir should
+	// only be supplied by the Config.
+	CacheDir string `default:"~/.up/cache/" env:"CACHE_DIR" help:"Directory used for caching package images." type:"path"`
+}
+
+//go:embed help/info-cache.md
+var infoCacheHelp string
+
+// Help returns help.
+func (c *infoCacheCmd) Help() string {
+	return infoCacheHelp
+}
+
+func (c *infoCacheCmd) AfterApply() error {
+	cch, err := cache.NewLocal("/", cache.WithFS(afero.NewBasePathFs(afero.NewOsFs(), c.CacheDir)))
+	if err != nil {
+		return err
+	}
+	c.c = cch
+	return nil
+}
+
+func (c *infoCacheCmd) Run(p upterm.Printer) error {
+	entries, err := c.c.Entries()
+	if err != nil {
+		return err
+	}
+
+	items := make([]cacheInfoItem, 0, len(entries))
+	for _, e := range entries {
+		items = append(items, cacheInfoItem{
+			Package:  e.Package,
+			Version:  e.Version,
+			Digest:   e.Digest,
+			Size:     e.Size,
+			LastUsed: e.LastAccess,
+		})
+	}
+	return p.PrintObject(items, []string{"PACKAGE", "VERSION", "DIGEST", "SIZE", "LAST USED"}, extractCacheInfoFields)
+}
+
+// cacheInfoItem is a single package in the cache.
+type cacheInfoItem struct {
+	Package  string    `json:"package"          yaml:"package"`
+	Version  string    `json:"version"          yaml:"version"`
+	Digest   string    `json:"digest,omitempty" yaml:"digest,omitempty"`
+	Size     int64     `json:"size"             yaml:"size"`
+	LastUsed time.Time `json:"lastUsed"         yaml:"lastUsed"`
+}
+
+// extractCacheInfoFields extracts table columns from a cacheInfoItem.
+func extractCacheInfoFields(obj any) []string {
+	i, ok := obj.(cacheInfoItem)
+	if !ok {
+		return []string{"", "", "", "", ""}
+	}
+	return []string{
+		i.Package,
+		i.Version,
+		shortDigest(i.Digest),
+		formatBytes(i.Size),
+		duration.HumanDuration(time.Since(i.LastUsed)) + " ago",
+	}
+}
+
+// shortDigest abbreviates a digest for display.
+func shortDigest(d string) string {
+	const shortLen = len("sha256:") + 12
+	if len(d) <= shortLen {
+		return d
+	}
+	return d[:shortLen]
+}
+
+// pruneCacheCmd removes stale entries from the cache.
+type pruneCacheCmd struct {
+	c    *cache.Local
+	keep map[string]bool
+	size int64
+
+	ProjectFile string        `default:"upbound.yaml"                                                                                help:"Path to project definition file. Cache entries used by the project's dependencies are never pruned." short:"f"`
+	TTL         time.Duration `default:"720h"                                                                                        help:"Remove entries that haven't been used within this duration. Set to 0 to prune only by size."`
+	MaxSize     string        `help:"Remove the least recently used entries until the cache is no larger than this size (e.g. 5Gi)." placeholder:"SIZE"`
+
+	// TODO(@tnthornton) remove cacheDir flag. Having a user supplied flag
+	// can result in broken behavior between xpls and dep. CacheDir should
+	// only be supplied by the Config.
+	CacheDir string `default:"~/.up/cache/" env:"CACHE_DIR" help:"Directory used for caching package images." type:"path"`
+}
+
+//go:embed help/prune-cache.md
+var pruneCacheHelp string
+
+// Help returns help.
+func (c *pruneCacheCmd) Help() string {
+	return pruneCacheHelp
+}
+
+func (c *pruneCacheCmd) AfterApply(kongCtx *kong.Context) error {
+	ctx := context.Background()
+
+	if c.MaxSize != "" {
+		q, err := resource.ParseQuantity(c.MaxSize)
+		if err != nil {
+			return errors.Wrap(err, "invalid --max-size")
+		}
+		c.size = q.Value()
+	}
+
+	cch, err := cache.NewLocal("/", cache.WithFS(afero.NewBasePathFs(afero.NewOsFs(), c.CacheDir)))
+	if err != nil {
+		return err
+	}
+	c.c = cch
+
+	// Protect the entries used by the current project, if there is one.
+	projFilePath, err := filepath.Abs(c.ProjectFile)
+	if err != nil {
+		return err
+	}
+	projFS := afero.NewBasePathFs(afero.NewOsFs(), filepath.Dir(projFilePath))
+	prj, err := project.Parse(projFS, filepath.Base(projFilePath))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return errors.Wrap(err, "failed to parse project")
+	default:
+		keep, err := project.CacheReferences(cch, prj)
+		if err != nil {
+			return errors.Wrap(err, "failed to find cache entries used by the project")
+		}
+		c.keep = keep
+	}
+
+	// workaround interfaces not being bindable ref: https://github.com/alecthomas/kong/issues/48
+	kongCtx.BindTo(ctx, (*context.Context)(nil))
+	return nil
+}
+
+func (c *pruneCacheCmd) Run(p upterm.Printer) error {
+	removed, err := c.c.Prune(
+		cache.PruneWithTTL(c.TTL),
+		cache.PruneWithMaxSize(c.size),
+		cache.PruneWithKeep(c.keep),
+	)
+	var reclaimed int64
+	for _, e := range removed {
+		p.Printfln("Removed %s", e.Path)
+		reclaimed += e.Size
+	}
+	if err != nil {
+		return err
+	}
+	p.Printfln("Pruned %d cache entries, reclaiming %s", len(removed), formatBytes(reclaimed))
+	return nil
+}
+
+// formatBytes formats a number of bytes for display using binary units.
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
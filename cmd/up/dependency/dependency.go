@@ -19,6 +19,7 @@ type Cmd struct {
 	Tree        treeCmd        `cmd:"" help:"Display the dependency tree for the current project or a specific package."`
 	UpdateCache updateCacheCmd `cmd:"" help:"Update the dependency cache for the current project."`
 	CleanCache  cleanCacheCmd  `cmd:"" help:"Clean the dependency cache."`
+	Cache       cacheCmd       `cmd:"" help:"Manage the dependency cache."`
 }
 
 //go:embed help/dependency.md
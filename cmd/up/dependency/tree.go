@@ -89,7 +89,7 @@ func (c *treeCmd) AfterApply(kongCtx *kong.Context, upCtx *upbound.Context) erro
 
 	c.res = image.NewResolver(
 		image.WithImageConfig(imageConfigs),
-		image.WithFetcher(image.NewLocalFetcher(image.WithKeychain(upCtx.RegistryKeychain()))),
+		image.WithFetcher(image.NewLocalFetcher(image.WithKeychain(upCtx.RegistryKeychain()), image.WithTransport(upCtx.Transport()))),
 	)
 
 	kongCtx.BindTo(ctx, (*context.Context)(nil))
@@ -0,0 +1,207 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package example
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+	intctp "github.com/upbound/up/internal/ctp"
+	intctx "github.com/upbound/up/internal/ctx"
+	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/upterm"
+	"github.com/upbound/up/internal/yaml"
+
+	_ "embed"
+)
+
+const (
+	applyFieldOwner  = "up-cli"
+	waitPollInterval = 2 * time.Second
+)
+
+//go:embed help/apply.md
+var applyHelp string
+
+// Help returns help for the apply command.
+func (c *applyCmd) Help() string {
+	return applyHelp
+}
+
+// applyCmd applies an example to the control plane of the current context.
+type applyCmd struct {
+	File string `arg:"" help:"Path to the example Composite Resource (XR) or Composite Resource Claim (XRC) to apply." type:"existingfile"`
+
+	Wait      bool          `help:"Wait for the example to become Ready, printing its conditions as they change." short:"w"`
+	Timeout   time.Duration `default:"5m"                                                                         help:"How long to wait for the example to become Ready."`
+	SkipCheck bool          `aliases:"allow-production"                                                           help:"Allow applying to a non-development control plane." name:"skip-control-plane-check"`
+}
+
+// Run executes the apply command.
+func (c *applyCmd) Run(ctx context.Context, upCtx *upbound.Context, p upterm.Printer) error {
+	raw, err := os.ReadFile(c.File)
+	if err != nil {
+		return errors.Wrap(err, "cannot read example")
+	}
+	u, err := parseExample(raw)
+	if err != nil {
+		return err
+	}
+
+	if !c.SkipCheck {
+		if err := checkDevControlPlane(ctx, upCtx); err != nil {
+			return err
+		}
+	}
+
+	cl, err := upCtx.BuildCurrentContextClient()
+	if err != nil {
+		return err
+	}
+	if err := cl.Patch(ctx, u, client.Apply, client.FieldOwner(applyFieldOwner), client.ForceOwnership); err != nil {
+		return errors.Wrapf(err, "cannot apply %s", exampleRef(u))
+	}
+	p.Printfln("Applied %s", exampleRef(u))
+
+	if !c.Wait {
+		return nil
+	}
+	return c.waitReady(ctx, cl, u, p)
+}
+
+// waitReady waits for an applied example to become Ready, printing its
+// conditions each time they change.
+func (c *applyCmd) waitReady(ctx context.Context, cl client.Client, u *unstructured.Unstructured, p upterm.Printer) error {
+	nn := types.NamespacedName{Namespace: u.GetNamespace(), Name: u.GetName()}
+	var last string
+	err := wait.PollUntilContextTimeout(ctx, waitPollInterval, c.Timeout, true, func(ctx context.Context) (bool, error) {
+		cur := &unstructured.Unstructured{}
+		cur.SetGroupVersionKind(u.GroupVersionKind())
+		if err := cl.Get(ctx, nn, cur); err != nil {
+			return false, err
+		}
+
+		conds := exampleConditions(cur)
+		if s := formatConditions(conds); s != last {
+			p.Printfln("%s: %s", exampleRef(u), s)
+			last = s
+		}
+		return isReady(conds), nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "%s did not become Ready", exampleRef(u))
+	}
+	p.Printfln("%s is Ready", exampleRef(u))
+	return nil
+}
+
+// checkDevControlPlane returns an error if the current context is a Spaces
+// control plane that wasn't created as a development control plane. Contexts
+// outside a space, such as local development control planes, aren't checked.
+func checkDevControlPlane(ctx context.Context, upCtx *upbound.Context) error {
+	_, nn, inSpace := upCtx.GetCurrentSpaceContextScope()
+	if !inSpace {
+		return nil
+	}
+	if nn.Name == "" {
+		return errors.New("current kubeconfig context is not a control plane. Use 'up ctx' to set a control plane context")
+	}
+
+	kubeconfig, err := intctx.GetSpacesKubeconfig(ctx, upCtx)
+	if err != nil {
+		return errors.Wrap(err, "cannot get kubeconfig for current spaces context")
+	}
+	restConfig, err := kubeconfig.ClientConfig()
+	if err != nil {
+		return errors.Wrap(err, "cannot get rest config for spaces client")
+	}
+	cl, err := client.New(restConfig, client.Options{})
+	if err != nil {
+		return errors.Wrap(err, "cannot construct spaces client")
+	}
+
+	var ctp spacesv1beta1.ControlPlane
+	if err := cl.Get(ctx, nn, &ctp); err != nil {
+		return errors.Wrapf(err, "cannot get control plane %s", nn)
+	}
+	if !intctp.IsDevControlPlane(&ctp) {
+		return errors.Errorf("control plane %s is not a development control plane; use --skip-control-plane-check to apply to it anyway", nn)
+	}
+	return nil
+}
+
+// parseExample parses an example resource, which must have an apiVersion,
+// kind, and name.
+func parseExample(raw []byte) (*unstructured.Unstructured, error) {
+	u := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal(raw, &u.Object); err != nil {
+		return nil, errors.Wrap(err, "cannot parse example")
+	}
+	if u.GetAPIVersion() == "" || u.GetKind() == "" || u.GetName() == "" {
+		return nil, errors.New("example must have an apiVersion, kind, and metadata.name")
+	}
+	return u, nil
+}
+
+// exampleRef returns a human-readable reference to an example.
+func exampleRef(u *unstructured.Unstructured) string {
+	ref := fmt.Sprintf("%s/%s", u.GetKind(), u.GetName())
+	if ns := u.GetNamespace(); ns != "" {
+		ref = fmt.Sprintf("%s/%s/%s", u.GetKind(), ns, u.GetName())
+	}
+	return ref
+}
+
+// exampleConditions returns the status conditions of an example.
+func exampleConditions(u *unstructured.Unstructured) []xpv1.Condition {
+	var conds []xpv1.Condition
+	if err := fieldpath.Pave(u.Object).GetValueInto("status.conditions", &conds); err != nil {
+		return nil
+	}
+	return conds
+}
+
+// formatConditions formats conditions as a single line, such as
+// "Synced=True, Ready=False (Creating: waiting for resources)".
+func formatConditions(conds []xpv1.Condition) string {
+	if len(conds) == 0 {
+		return "no conditions yet"
+	}
+	parts := make([]string, 0, len(conds))
+	for _, c := range conds {
+		s := fmt.Sprintf("%s=%s", c.Type, c.Status)
+		switch {
+		case c.Reason != "" && c.Message != "":
+			s += fmt.Sprintf(" (%s: %s)", c.Reason, c.Message)
+		case c.Reason != "":
+			s += fmt.Sprintf(" (%s)", c.Reason)
+		}
+		parts = append(parts, s)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// isReady returns true if the conditions include a true Ready condition.
+func isReady(conds []xpv1.Condition) bool {
+	for _, c := range conds {
+		if c.Type == xpv1.TypeReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package example
+
+import (
+	"testing"
+
+	"github.com/alecthomas/kong"
+	"github.com/google/go-cmp/cmp"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+func TestParseExample(t *testing.T) {
+	type want struct {
+		ref string
+		err bool
+	}
+
+	cases := map[string]struct {
+		raw  string
+		want want
+	}{
+		"NamespacedXR": {
+			raw: `
+apiVersion: platform.example.com/v1alpha1
+kind: XNetwork
+metadata:
+  name: example
+  namespace: default
+spec:
+  region: us-west-2
+`,
+			want: want{ref: "XNetwork/default/example"},
+		},
+		"ClusterScopedXR": {
+			raw: `
+apiVersion: platform.example.com/v1alpha1
+kind: XNetwork
+metadata:
+  name: example
+`,
+			want: want{ref: "XNetwork/example"},
+		},
+		"MissingName": {
+			raw: `
+apiVersion: platform.example.com/v1alpha1
+kind: XNetwork
+`,
+			want: want{err: true},
+		},
+		"InvalidYAML": {
+			raw:  "apiVersion: [",
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			u, err := parseExample([]byte(tc.raw))
+			if tc.want.err {
+				assert.Assert(t, err != nil)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, exampleRef(u), tc.want.ref)
+		})
+	}
+}
+
+func TestExampleConditions(t *testing.T) {
+	cases := map[string]struct {
+		obj       map[string]any
+		wantLine  string
+		wantReady bool
+	}{
+		"NoStatus": {
+			obj:      map[string]any{},
+			wantLine: "no conditions yet",
+		},
+		"NotReady": {
+			obj: map[string]any{
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "Synced", "status": "True", "reason": "ReconcileSuccess"},
+						map[string]any{"type": "Ready", "status": "False", "reason": "Creating", "message": "waiting for resources"},
+					},
+				},
+			},
+			wantLine: "Synced=True (ReconcileSuccess), Ready=False (Creating: waiting for resources)",
+		},
+		"Ready": {
+			obj: map[string]any{
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "Ready", "status": "True", "reason": "Available"},
+					},
+				},
+			},
+			wantLine:  "Ready=True (Available)",
+			wantReady: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			conds := exampleConditions(&unstructured.Unstructured{Object: tc.obj})
+			if diff := cmp.Diff(tc.wantLine, formatConditions(conds)); diff != "" {
+				t.Errorf("formatConditions(...): -want, +got:\n%s", diff)
+			}
+			assert.Equal(t, isReady(conds), tc.wantReady)
+		})
+	}
+}
+
+func TestIsReady(t *testing.T) {
+	conds := []xpv1.Condition{
+		{Type: xpv1.TypeSynced, Status: corev1.ConditionTrue},
+		{Type: xpv1.TypeReady, Status: corev1.ConditionUnknown},
+	}
+	assert.Assert(t, !isReady(conds))
+
+	conds[1].Status = corev1.ConditionTrue
+	assert.Assert(t, isReady(conds))
+}
+
+func TestApplyCmdAllowProductionAlias(t *testing.T) {
+	cmd := applyCmd{}
+	parser, err := kong.New(&cmd)
+	if err != nil {
+		t.Fatalf("New(...): unexpected error: %v", err)
+	}
+	if _, err := parser.Parse([]string{"testdata/xeks-xrd-definition.yaml", "--allow-production"}); err != nil {
+		t.Fatalf("Parse(...): unexpected error: %v", err)
+	}
+	assert.Assert(t, cmd.SkipCheck)
+}
@@ -7,4 +7,5 @@ package example
 // Cmd contains commands for example cmd.
 type Cmd struct {
 	Generate generateCmd `cmd:"" help:"Generate an Example Composite Resource (XR) or Claim (XRC)"`
+	Apply    applyCmd    `cmd:"" help:"Apply an example Composite Resource (XR) or Claim (XRC) to the control plane of the current context."`
 }
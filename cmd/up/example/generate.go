@@ -4,6 +4,7 @@
 package example
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"path/filepath"
@@ -71,6 +72,8 @@ type generateCmd struct {
 	Name       string `help:"Specifies the Name of the resource."`
 	Namespace  string `help:"Specifies the Namespace of the resource."`
 
+	Placeholders bool `help:"When generating from an XRD, fill required string fields that have no default or enum with CHANGEME placeholders rather than sample values."`
+
 	XRDFilePath    string `arg:"" help:"Specifies the path to the Composite Resource Definition (XRD) file used to generate an example resource." optional:""`
 	relXrdFilePath string
 	ProjectFile    string `default:"upbound.yaml" help:"Path to project definition file." short:"f"`
@@ -249,7 +252,11 @@ func (c *generateCmd) createCRDFromXRD(xrd any) (*apiextensionsv1.CustomResource
 func (c *generateCmd) generateResourceFromCRD(crd *apiextensionsv1.CustomResourceDefinition) (resource, error) {
 	var res resource
 
-	yamlData, err := icrd.GenerateExample(*crd, true, false)
+	var opts []icrd.ExampleOption
+	if c.Placeholders {
+		opts = append(opts, icrd.WithPlaceholders())
+	}
+	yamlData, err := icrd.GenerateExample(*crd, true, false, opts...)
 	if err != nil {
 		return res, errors.Wrapf(err, "failed generating example")
 	}
@@ -526,6 +533,9 @@ func (c *generateCmd) outputResource(res resource, p upterm.Printer) error {
 		}
 
 		p.Printfln("Successfully created example and saved to %s", filesystem.FullPath(c.exampleFS, filePath))
+		if bytes.Contains(resourceYAML, []byte(icrd.Placeholder)) {
+			p.Printfln("Replace the %s placeholders with real values before using the example", icrd.Placeholder)
+		}
 
 	case outputYAML:
 		p.Println(string(resourceYAML))
@@ -344,3 +344,29 @@ func TestCreateCRDAndGenerateResource(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateResourceFromCRDPlaceholders(t *testing.T) {
+	var xrd v2.CompositeResourceDefinition
+	err := yaml.Unmarshal(v2XRDNamespacedYAML, &xrd)
+	assert.NilError(t, err, "Failed to unmarshal v2 sample XRD")
+
+	cmd := &generateCmd{Type: "xr", Placeholders: true}
+	crd, err := cmd.createCRDFromXRD(xrd)
+	assert.NilError(t, err, "Failed to create CRD from XRD")
+
+	got, err := cmd.generateResourceFromCRD(crd)
+	assert.NilError(t, err, "Failed to generate resource from CRD")
+
+	// Only string fields without a default or enum get a placeholder; other
+	// fields keep a value of their type.
+	want := map[string]interface{}{
+		"parameters": map[string]interface{}{
+			"region": "CHANGEME",
+			"nodes": map[string]interface{}{
+				"count":        float64(1),
+				"instanceType": "t3.small",
+			},
+		},
+	}
+	assert.DeepEqual(t, got.Spec, want)
+}
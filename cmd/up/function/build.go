@@ -0,0 +1,168 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/alecthomas/kong"
+	v1cache "github.com/google/go-containerregistry/pkg/v1/cache"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/spf13/afero"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up/internal/async"
+	"github.com/upbound/up/internal/oci/cache"
+	"github.com/upbound/up/internal/project"
+	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/upterm"
+	"github.com/upbound/up/internal/xpkg/functions"
+	"github.com/upbound/up/pkg/apis/project/v2alpha1"
+
+	_ "embed"
+)
+
+//go:embed help/build.md
+var buildHelp string
+
+func (c *buildCmd) Help() string {
+	return buildHelp
+}
+
+type buildCmd struct {
+	Name string `arg:"" help:"Name of the embedded function to build." required:""`
+
+	Platform      []string `help:"Platforms to build the function for, such as linux/amd64. Defaults to the architectures in the project file." short:"p"`
+	ProjectFile   string   `default:"upbound.yaml"                                                                                              help:"Path to project definition file."                                 short:"f"`
+	OutputDir     string   `default:"_output"                                                                                                   help:"Path to the output directory, where the package will be written." short:"o"`
+	NoBuildCache  bool     `default:"false"                                                                                                     help:"Don't cache image layers while building."`
+	BuildCacheDir string   `default:"~/.up/build-cache"                                                                                         help:"Path to the build cache directory."                               type:"path"`
+	CacheDir      string   `default:"~/.up/cache/"                                                                                              env:"CACHE_DIR"                                                         help:"Directory used for caching dependencies." type:"path"`
+
+	outputFS afero.Fs
+	projFS   afero.Fs
+	proj     *v2alpha1.Project
+
+	functionIdentifier functions.Identifier
+
+	m *project.DependencyManager
+}
+
+// AfterApply parses the project and sets up its dependencies.
+func (c *buildCmd) AfterApply(kongCtx *kong.Context, upCtx *upbound.Context) error {
+	projFilePath, err := filepath.Abs(c.ProjectFile)
+	if err != nil {
+		return err
+	}
+	// The location of the project file defines the root of the project.
+	c.projFS = afero.NewBasePathFs(afero.NewOsFs(), filepath.Dir(projFilePath))
+
+	proj, err := project.Parse(c.projFS, filepath.Base(projFilePath))
+	if err != nil {
+		return errors.New("this is not a project directory")
+	}
+	proj.Default()
+	if len(c.Platform) > 0 {
+		archs, err := parsePlatforms(c.Platform)
+		if err != nil {
+			return err
+		}
+		proj.Spec.Architectures = archs
+	}
+	c.proj = proj
+
+	// Output can be anywhere, doesn't have to be in the project directory.
+	c.outputFS = afero.NewOsFs()
+
+	cchFS := afero.NewBasePathFs(afero.NewOsFs(), c.CacheDir)
+	m, err := project.NewDependencyManager(upCtx, proj, c.projFS,
+		project.WithCacheFS(cchFS),
+	)
+	if err != nil {
+		return err
+	}
+	c.m = m
+
+	c.functionIdentifier = functions.DefaultIdentifier
+
+	// workaround interfaces not being bindable ref: https://github.com/alecthomas/kong/issues/48
+	kongCtx.BindTo(context.Background(), (*context.Context)(nil))
+
+	return nil
+}
+
+// Run builds the function and writes its images to a package file.
+func (c *buildCmd) Run(ctx context.Context, upCtx *upbound.Context, printer upterm.Printer) error {
+	bopts := []project.BuilderOption{
+		project.BuildWithFunctionIdentifier(c.functionIdentifier),
+	}
+	if !c.NoBuildCache {
+		bopts = append(bopts, project.BuildWithFunctionCache(project.SharedFunctionCache(c.BuildCacheDir)))
+	}
+	b := project.NewBuilder(bopts...)
+
+	var imgMap project.ImageTagMap
+	err := printer.WrapAsyncWithSuccessSpinners(func(ch async.EventChannel) error {
+		var err error
+		imgMap, err = b.BuildFunction(ctx, upCtx, c.proj, c.projFS, c.Name,
+			project.BuildWithEventChannel(ch),
+			project.BuildWithDependencyManager(c.m),
+		)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := c.outputFS.MkdirAll(c.OutputDir, 0o755); err != nil {
+		return errors.Wrapf(err, "failed to create output directory %q", c.OutputDir)
+	}
+
+	if !c.NoBuildCache {
+		// Pull base image layers through the layer cache, as in project builds.
+		cch := cache.NewValidatingCache(v1cache.NewFilesystemCache(c.BuildCacheDir))
+		for tag, img := range imgMap {
+			imgMap[tag] = v1cache.Image(img, cch)
+		}
+	}
+
+	outFile := filepath.Join(c.OutputDir, fmt.Sprintf("%s_%s.uppkg", c.proj.Name, c.Name))
+	return printer.WrapWithSuccessSpinner(
+		fmt.Sprintf("Writing function package to %s", outFile),
+		func() error {
+			f, err := c.outputFS.Create(outFile)
+			if err != nil {
+				return errors.Wrapf(err, "failed to create output file %q", outFile)
+			}
+			defer f.Close() //nolint:errcheck // Can't do anything useful with this error.
+
+			return errors.Wrap(tarball.MultiWrite(imgMap, f), "failed to write package to file")
+		},
+	)
+}
+
+// parsePlatforms returns the architectures for the given platforms. Functions
+// only run on Linux, so a platform may be given as an OS and architecture, such
+// as linux/amd64, or as just an architecture.
+func parsePlatforms(platforms []string) ([]string, error) {
+	archs := make([]string, 0, len(platforms))
+	for _, p := range platforms {
+		arch := p
+		if os, a, ok := strings.Cut(p, "/"); ok {
+			if os != "linux" {
+				return nil, errors.Errorf("invalid platform %q: functions can only be built for linux", p)
+			}
+			arch = a
+		}
+		if arch == "" || strings.Contains(arch, "/") {
+			return nil, errors.Errorf("invalid platform %q", p)
+		}
+		archs = append(archs, arch)
+	}
+	return archs, nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package function
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestParsePlatforms(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		platforms []string
+		want      []string
+		err       bool
+	}{
+		"Architectures": {
+			platforms: []string{"amd64", "arm64"},
+			want:      []string{"amd64", "arm64"},
+		},
+		"LinuxPlatforms": {
+			platforms: []string{"linux/amd64", "linux/arm64"},
+			want:      []string{"amd64", "arm64"},
+		},
+		"OtherOS": {
+			platforms: []string{"darwin/arm64"},
+			err:       true,
+		},
+		"Variant": {
+			platforms: []string{"linux/arm/v7"},
+			err:       true,
+		},
+		"Empty": {
+			platforms: []string{"linux/"},
+			err:       true,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parsePlatforms(tc.platforms)
+			if tc.err {
+				assert.Assert(t, err != nil)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, tc.want, got)
+		})
+	}
+}
@@ -11,4 +11,6 @@ type Cmd struct {
 	upbound.RequiresContext
 
 	Generate generateCmd `cmd:"" help:"Generate an Function for a Composition."`
+	Build    buildCmd    `cmd:"" help:"Build a single embedded function of a project."`
+	Test     testCmd     `cmd:"" help:"Run an embedded function locally with a request."`
 }
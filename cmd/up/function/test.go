@@ -0,0 +1,187 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package function
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/alecthomas/kong"
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/encoding/protojson"
+	"sigs.k8s.io/yaml"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	pkgv1 "github.com/crossplane/crossplane/v2/apis/pkg/v1"
+	xprender "github.com/crossplane/crossplane/v2/cmd/crank/render"
+	fnv1 "github.com/crossplane/crossplane/v2/proto/fn/v1"
+
+	"github.com/upbound/up/internal/async"
+	"github.com/upbound/up/internal/project"
+	intrender "github.com/upbound/up/internal/render"
+	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/upterm"
+	"github.com/upbound/up/internal/xpkg/functions"
+	"github.com/upbound/up/pkg/apis/project/v2alpha1"
+
+	_ "embed"
+)
+
+//go:embed help/test.md
+var testHelp string
+
+func (c *testCmd) Help() string {
+	return testHelp
+}
+
+type testCmd struct {
+	Name    string `arg:"" help:"Name of the embedded function to test."                         required:""`
+	Request string `arg:"" help:"A YAML or JSON file containing the RunFunctionRequest to send." type:"existingfile"`
+
+	Output        string        `default:"yaml"              enum:"yaml,json"                                help:"Format of the printed RunFunctionResponse."    short:"o"`
+	Timeout       time.Duration `default:"1m"                help:"How long to run before timing out."`
+	ProjectFile   string        `default:"upbound.yaml"      help:"Path to project definition file."         short:"f"`
+	CacheDir      string        `default:"~/.up/cache/"      env:"CACHE_DIR"                                 help:"Directory used for caching dependency images." type:"path"`
+	NoBuildCache  bool          `default:"false"             help:"Don't cache image layers while building."`
+	BuildCacheDir string        `default:"~/.up/build-cache" help:"Path to the build cache directory."       type:"path"`
+
+	projFS afero.Fs
+	proj   *v2alpha1.Project
+
+	functionIdentifier functions.Identifier
+
+	m *project.DependencyManager
+}
+
+// AfterApply parses the project and sets up its dependencies.
+func (c *testCmd) AfterApply(kongCtx *kong.Context, upCtx *upbound.Context) error {
+	projFilePath, err := filepath.Abs(c.ProjectFile)
+	if err != nil {
+		return err
+	}
+	// The location of the project file defines the root of the project.
+	c.projFS = afero.NewBasePathFs(afero.NewOsFs(), filepath.Dir(projFilePath))
+
+	proj, err := project.Parse(c.projFS, filepath.Base(projFilePath))
+	if err != nil {
+		return errors.New("this is not a project directory")
+	}
+	proj.Default()
+	c.proj = proj
+
+	cchFS := afero.NewBasePathFs(afero.NewOsFs(), c.CacheDir)
+	m, err := project.NewDependencyManager(upCtx, proj, c.projFS,
+		project.WithCacheFS(cchFS),
+	)
+	if err != nil {
+		return err
+	}
+	c.m = m
+
+	c.functionIdentifier = functions.DefaultIdentifier
+
+	// workaround interfaces not being bindable ref: https://github.com/alecthomas/kong/issues/48
+	kongCtx.BindTo(context.Background(), (*context.Context)(nil))
+	kongCtx.BindTo(logging.NewNopLogger(), (*logging.Logger)(nil))
+
+	return nil
+}
+
+// Run builds the function, runs it with the request and prints its response.
+func (c *testCmd) Run(ctx context.Context, upCtx *upbound.Context, log logging.Logger, printer upterm.Printer) error {
+	bs, err := os.ReadFile(c.Request)
+	if err != nil {
+		return errors.Wrap(err, "cannot read request")
+	}
+	req, err := parseRequest(bs)
+	if err != nil {
+		return err
+	}
+
+	var efns []pkgv1.Function
+	err = printer.WrapAsyncWithSuccessSpinners(func(ch async.EventChannel) error {
+		fns, err := intrender.BuildEmbeddedFunctionsLocalDaemon(ctx, upCtx, intrender.FunctionOptions{
+			Project:            c.proj,
+			ProjFS:             c.projFS,
+			Concurrency:        1,
+			NoBuildCache:       c.NoBuildCache,
+			BuildCacheDir:      c.BuildCacheDir,
+			DependencyManager:  c.m,
+			FunctionIdentifier: c.functionIdentifier,
+			EventChannel:       ch,
+			FunctionName:       c.Name,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "unable to build function %q", c.Name)
+		}
+		efns = fns
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(efns) == 0 {
+		return errors.Errorf("function %q wasn't built for the local daemon's architecture", c.Name)
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	var rsp *fnv1.RunFunctionResponse
+	if err := printer.WrapWithSuccessSpinner("Running function", func() error {
+		runner, err := xprender.NewRuntimeFunctionRunner(runCtx, log, efns[:1])
+		if err != nil {
+			return errors.Wrap(err, "cannot start function")
+		}
+		defer func() {
+			if err := runner.Stop(runCtx); err != nil {
+				log.Debug("Cannot stop function", "error", err)
+			}
+		}()
+
+		rsp, err = runner.RunFunction(runCtx, efns[0].GetName(), req)
+		return errors.Wrap(err, "cannot run function")
+	}); err != nil {
+		return err
+	}
+
+	out, err := formatResponse(rsp, c.Output)
+	if err != nil {
+		return err
+	}
+	printer.PrintResult(out)
+	return nil
+}
+
+// parseRequest parses a RunFunctionRequest from YAML or JSON.
+func parseRequest(bs []byte) (*fnv1.RunFunctionRequest, error) {
+	js, err := yaml.YAMLToJSON(bs)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse request")
+	}
+	req := &fnv1.RunFunctionRequest{}
+	if err := protojson.Unmarshal(js, req); err != nil {
+		return nil, errors.Wrap(err, "cannot parse request")
+	}
+	return req, nil
+}
+
+// formatResponse formats a RunFunctionResponse as YAML or JSON.
+func formatResponse(rsp *fnv1.RunFunctionResponse, format string) (string, error) {
+	js, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(rsp)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot marshal response")
+	}
+	if format == "json" {
+		return string(js), nil
+	}
+	bs, err := yaml.JSONToYAML(js)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot marshal response")
+	}
+	return string(bs), nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package function
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"gotest.tools/v3/assert"
+
+	fnv1 "github.com/crossplane/crossplane/v2/proto/fn/v1"
+)
+
+func TestParseRequest(t *testing.T) {
+	t.Parallel()
+
+	req, err := parseRequest([]byte(`
+meta:
+  tag: test
+input:
+  apiVersion: example.org/v1
+  kind: Input
+observed:
+  composite:
+    resource:
+      apiVersion: example.org/v1alpha1
+      kind: XNetwork
+`))
+	assert.NilError(t, err)
+	assert.Equal(t, "test", req.GetMeta().GetTag())
+	assert.Equal(t, "Input", req.GetInput().AsMap()["kind"])
+	assert.Equal(t, "XNetwork", req.GetObserved().GetComposite().GetResource().AsMap()["kind"])
+
+	_, err = parseRequest([]byte("notAField: true"))
+	assert.Assert(t, err != nil, "unknown fields should be rejected")
+}
+
+func TestFormatResponse(t *testing.T) {
+	t.Parallel()
+
+	rsp := &fnv1.RunFunctionResponse{Meta: &fnv1.ResponseMeta{Tag: "test"}}
+
+	got, err := formatResponse(rsp, "yaml")
+	assert.NilError(t, err)
+	assert.Equal(t, "meta:\n  tag: test\n", got)
+
+	got, err = formatResponse(rsp, "json")
+	assert.NilError(t, err)
+	parsed := &fnv1.RunFunctionResponse{}
+	assert.NilError(t, protojson.Unmarshal([]byte(got), parsed))
+	assert.Equal(t, "test", parsed.GetMeta().GetTag())
+}
@@ -5,9 +5,12 @@
 package group
 
 import (
+	"context"
 	"strconv"
+	"time"
 
 	"github.com/alecthomas/kong"
+	"github.com/posener/complete"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -51,6 +54,51 @@ func (c *Cmd) AfterApply(kongCtx *kong.Context, upCtx *upbound.Context) error {
 	return nil
 }
 
+// predictTimeout bounds how long completion waits for the space, so that
+// completing while offline doesn't hang the shell.
+const predictTimeout = 5 * time.Second
+
+// PredictGroups provides a predictor for groups in the space of the current
+// context. No groups are predicted if the current context isn't a space or
+// group context, or the space can't be reached.
+// This function is used by the kongplete.Complete package for shell autocompletion.
+func PredictGroups() complete.Predictor {
+	return complete.PredictFunc(func(_ complete.Args) (prediction []string) {
+		upCtx, err := upbound.NewFromFlags(upbound.Flags{}, upbound.HideLogging())
+		if err != nil {
+			return nil
+		}
+		upCtx.SetupLogging()
+
+		if _, ctp, inSpace := upCtx.GetCurrentSpaceContextScope(); !inSpace || ctp.Name != "" {
+			return nil
+		}
+
+		cl, err := upCtx.BuildCurrentContextClient()
+		if err != nil {
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), predictTimeout)
+		defer cancel()
+
+		var nss corev1.NamespaceList
+		if err := cl.List(ctx, &nss, client.MatchingLabels{spacesv1beta1.ControlPlaneGroupLabelKey: "true"}); err != nil {
+			return nil
+		}
+
+		if len(nss.Items) == 0 {
+			return nil
+		}
+
+		data := make([]string, len(nss.Items))
+		for i, ns := range nss.Items {
+			data[i] = ns.Name
+		}
+		return data
+	})
+}
+
 // Cmd contains commands for interacting with groups.
 type Cmd struct {
 	upbound.RequiresContext
@@ -20,8 +20,8 @@ import (
 
 // deleteCmd creates a group in a space.
 type deleteCmd struct {
-	Name  string `arg:""          help:"Name of group."                   required:""`
-	Force bool   `default:"false" help:"Force the deletion of the group." name:"force" optional:""`
+	Name  string `arg:""          help:"Name of group."                   predictor:"groups" required:""`
+	Force bool   `default:"false" help:"Force the deletion of the group." name:"force"       optional:""`
 }
 
 // Run executes the create command.
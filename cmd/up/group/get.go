@@ -17,7 +17,7 @@ import (
 
 // getCmd gets a specific group in a space.
 type getCmd struct {
-	Name string `arg:"" help:"Name of group." required:""`
+	Name string `arg:"" help:"Name of group." predictor:"groups" required:""`
 }
 
 // Run executes the list command.
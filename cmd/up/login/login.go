@@ -51,6 +51,12 @@ const (
 	errNoIDInToken    = "token is missing ID"
 	errUpdateConfig   = "unable to update config file"
 	errNoSubInToken   = "no sub claim in token"
+	errNotLoggedIn    = "not logged in; run `up login` to authenticate"
+	errSessionExpired = "session expired at %s; run `up login` to re-authenticate"
+	errCheckFailed    = "unable to validate credentials"
+
+	checkTemplate = `{{.ID}} ({{.Type}}) logged in to organization {{.Organization}}
+{{- if .Expiration}}, session expires at {{.Expiration.Format "2006-01-02T15:04:05Z07:00"}}{{end}}`
 )
 
 // BeforeApply sets default values in login before assignment and validation.
@@ -118,6 +124,8 @@ type LoginCmd struct { //nolint:revive // Can't just call this `Cmd` because `Lo
 
 	UseDeviceCode bool `help:"Use authentication flow based on device code. We will also use this if it can't launch a browser in your behalf, e.g. in remote SSH"`
 	QRCode        bool `help:"Display a QR code for the login URL when using the device code login flow."`
+
+	Check bool `help:"Check that the current profile's credentials are valid without re-authenticating. Exits non-zero if they aren't." xor:"identifier"`
 }
 
 //go:embed help/login.md
@@ -130,6 +138,10 @@ func (c *LoginCmd) Help() string {
 
 // Run executes the login command.
 func (c *LoginCmd) Run(ctx context.Context, p upterm.Printer, upCtx *upbound.Context) error {
+	if c.Check {
+		return c.check(ctx, p, upCtx)
+	}
+
 	// simple auth using explicit flags
 	if c.Username != "" || c.Token != "" {
 		return c.simpleAuth(ctx, upCtx)
@@ -205,6 +217,46 @@ func (c *LoginCmd) Run(ctx context.Context, p upterm.Printer, upCtx *upbound.Con
 	return nil
 }
 
+// loginStatus describes the credentials of the current profile.
+type loginStatus struct {
+	ID           string            `json:"id"                   yaml:"id"`
+	Type         profile.TokenType `json:"type"                 yaml:"type"`
+	Organization string            `json:"organization"         yaml:"organization"`
+	Expiration   *time.Time        `json:"expiration,omitempty" yaml:"expiration,omitempty"`
+}
+
+// check validates the current profile's credentials by making an
+// authenticated request with them, and prints the identity they belong to.
+func (c *LoginCmd) check(ctx context.Context, p upterm.Printer, upCtx *upbound.Context) error {
+	if upCtx.Profile.Session == "" {
+		return errors.New(errNotLoggedIn)
+	}
+
+	status := loginStatus{
+		ID:           upCtx.Profile.ID,
+		Type:         upCtx.Profile.TokenType,
+		Organization: upCtx.Organization,
+	}
+	exp, err := upCtx.SessionExpiration()
+	if err != nil {
+		return errors.Wrap(err, errCheckFailed)
+	}
+	if !exp.IsZero() {
+		if time.Now().After(exp) {
+			return errors.Errorf(errSessionExpired, exp.Format(time.RFC3339))
+		}
+		status.Expiration = &exp
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+	if err := c.validateOrganization(ctx, upCtx); err != nil {
+		return errors.Wrap(err, errCheckFailed)
+	}
+
+	return p.PrintObjectTemplate(status, checkTemplate)
+}
+
 func (c *LoginCmd) validateOrganization(ctx context.Context, upCtx *upbound.Context) error {
 	cfg, err := upCtx.BuildSDKConfig()
 	if err != nil {
@@ -17,7 +17,6 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
-	"golang.org/x/term"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
 
@@ -74,12 +73,7 @@ var (
 
 // AfterApply configures global settings before executing commands.
 func (c *cli) AfterApply(kongCtx *kong.Context) error {
-	var pretty bool
-	if c.Pretty != nil {
-		pretty = *c.Pretty
-	} else {
-		pretty = term.IsTerminal(int(os.Stdout.Fd()))
-	}
+	pretty := upterm.ResolvePretty(c.Pretty, c.Quiet || c.Silent)
 
 	stdout := kongCtx.Stdout
 	resultOut := kongCtx.Stdout
@@ -117,6 +111,12 @@ func (c *cli) AfterApply(kongCtx *kong.Context) error {
 	// 2. Adding events: span.AddEvent("event-name", trace.WithAttributes(...)). See version.go for example.
 	kongCtx.BindTo(globalCommandSpan, (*trace.Span)(nil))
 
+	// Configure logging before any up context, which owns a logger, is
+	// constructed.
+	if err := upbound.SetLogOptions(upbound.LogOptions{Level: c.LogLevel, Format: c.LogFormat}); err != nil {
+		return err
+	}
+
 	// If the command (or any of its parents) requires an up context, construct
 	// it and bind it to the kongCtx.
 	for current := kongCtx.Selected(); current != nil; current = current.Parent {
@@ -127,6 +127,13 @@ func (c *cli) AfterApply(kongCtx *kong.Context) error {
 			}
 			kongCtx.Bind(upCtx)
 
+			// Warn about version skew after the command, unless output is
+			// suppressed or consumed by a script, which shouldn't pay for
+			// the wait.
+			if !c.Quiet && !c.Silent && c.Format == config.FormatDefault && interactive() {
+				c.skewCheck = startSkewCheck(upCtx)
+			}
+
 			// Set a span attribute indicating whether the user is logged in.
 			globalCommandSpan.AddEvent("user", trace.WithAttributes(
 				attribute.Bool("authenticated", upCtx.Organization != ""),
@@ -176,6 +183,13 @@ type cli struct {
 	// need to know whether the user set it explicitly.
 	Pretty *bool `env:"PRETTY" help:"Pretty print output." name:"pretty"`
 
+	LogLevel  string `default:"info" enum:"debug,info,warn,error" env:"UP_LOG_LEVEL"  help:"Minimum level of log messages. Can be: debug, info, warn, error. Debug also logs requests to Kubernetes APIs." name:"log-level"`
+	LogFormat string `default:"text" enum:"text,json"             env:"UP_LOG_FORMAT" help:"Format of log messages. Can be: text, json."                                                                   name:"log-format"`
+
+	// skewCheck is the version skew check for the Space of the current
+	// context, if any.
+	skewCheck *skewCheck
+
 	// Manage Upbound Resources
 	Organization  organization.Cmd  `aliases:"org"  cmd:""                           group:"Manage Upbound Resources"                                         help:"Interact with Upbound organizations." name:"organization"`
 	Token         token.Cmd         `cmd:""         group:"Manage Upbound Resources" help:"Interact with personal access tokens."                             name:"token"`
@@ -288,6 +302,7 @@ func main() {
 	kongplete.Complete(parser,
 		kongplete.WithPredictor("orgs", organization.PredictOrgs()),
 		kongplete.WithPredictor("ctps", controlplane.PredictControlPlanes()),
+		kongplete.WithPredictor("groups", group.PredictGroups()),
 		kongplete.WithPredictor("repos", repository.PredictRepos()),
 		kongplete.WithPredictor("robots", robot.PredictRobots()),
 		kongplete.WithPredictor("teams", team.PredictTeams()),
@@ -318,6 +333,7 @@ func main() {
 
 	// Execute the command
 	err = kongCtx.Run()
+	c.skewCheck.warn(os.Stderr)
 
 	if err != nil && globalCommandSpan != nil {
 		globalCommandSpan.SetStatus(codes.Error, fmt.Sprintf("%T", unwrap(err)))
@@ -5,18 +5,25 @@ package migration
 
 import (
 	"context"
+	"maps"
+	"slices"
 
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
 	appsv1 "k8s.io/client-go/kubernetes/typed/apps/v1"
 	"k8s.io/client-go/restmapper"
 
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up/internal/async"
 	"github.com/upbound/up/internal/input"
 	"github.com/upbound/up/internal/upterm"
 	"github.com/upbound/up/pkg/migration"
 	"github.com/upbound/up/pkg/migration/exporter"
+	"github.com/upbound/up/pkg/migration/importer"
 
 	_ "embed"
 )
@@ -42,6 +49,12 @@ type exportCmd struct {
 	IncludeNamespaces     []string `help:"A list of specific namespaces to include in the export. If not specified, all namespaces are included by default."`
 	ExcludeNamespaces     []string `default:"kube-system,kube-public,kube-node-lease,local-path-storage"                                                           help:"A list of specific namespaces to exclude from the export. Defaults to 'kube-system', 'kube-public', 'kube-node-lease', and 'local-path-storage'."`
 
+	IncludeTypes []string `help:"Only export resources of these types. Types have the form Kind.group, or Kind for the core API group, and may contain wildcards, e.g. '*.aws.upbound.io'." placeholder:"TYPE"`
+	ExcludeTypes []string `help:"Do not export resources of these types. Takes precedence over --include-types. Uses the same form as --include-types, e.g. 'Event'."                       placeholder:"TYPE"`
+	Selector     string   `help:"Only export resources whose labels match this label selector, e.g. 'team=a'. Namespaces are exported regardless of their labels."                          short:"l"`
+
+	Concurrency int `default:"1" help:"Maximum number of resource types to export from the source control plane at once."`
+
 	PauseBeforeExport bool `default:"false" help:"When set to true, pauses all claim,composite and managed resources before starting the export process. This can help ensure a consistent state for the export. Defaults to false."`
 }
 
@@ -59,6 +72,20 @@ func (c *exportCmd) BeforeApply() error {
 }
 
 func (c *exportCmd) Run(ctx context.Context, migCtx *migration.Context, printer upterm.Printer) error {
+	filter := exporter.Filter{
+		Types: importer.GVKFilter{Include: c.IncludeTypes, Exclude: c.ExcludeTypes},
+	}
+	if err := filter.Types.Validate(); err != nil {
+		return err
+	}
+	if c.Selector != "" {
+		sel, err := labels.Parse(c.Selector)
+		if err != nil {
+			return errors.Wrapf(err, "invalid label selector %q", c.Selector)
+		}
+		filter.Selector = sel
+	}
+
 	cfg := migCtx.Kubeconfig
 
 	crdClient, err := apiextensionsclientset.NewForConfig(cfg)
@@ -80,6 +107,9 @@ func (c *exportCmd) Run(ctx context.Context, migCtx *migration.Context, printer
 
 	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
 
+	// events receives the export's progress once it starts.
+	var events async.EventChannel
+
 	e := exporter.NewControlPlaneStateExporter(crdClient, dynamicClient, discoveryClient, appsClient, mapper, exporter.Options{
 		OutputArchive: c.Output,
 
@@ -87,6 +117,10 @@ func (c *exportCmd) Run(ctx context.Context, migCtx *migration.Context, printer
 		ExcludeNamespaces:     c.ExcludeNamespaces,
 		IncludeExtraResources: c.IncludeExtraResources,
 		ExcludeResources:      c.ExcludeResources,
+		Filter:                filter,
+
+		Concurrency: c.Concurrency,
+		Progress:    func(p exporter.ExportProgress) { sendExportProgress(events, p) },
 
 		PauseBeforeExport: c.PauseBeforeExport,
 	})
@@ -99,11 +133,54 @@ func (c *exportCmd) Run(ctx context.Context, migCtx *migration.Context, printer
 	}
 
 	printer.Println("Exporting control plane state...")
-	migration.DefaultSpinner = func(msg string) migration.Spinner { return printer.NewSuccessSpinner(msg) }
 
-	if err = e.Export(ctx); err != nil {
+	// Report the export's steps and the progress of each resource type
+	// together, since types may be exported concurrently.
+	err = printer.WrapAsyncWithSuccessSpinners(func(ch async.EventChannel) error {
+		events = ch
+		migration.DefaultSpinner = func(msg string) migration.Spinner { return &eventSpinner{ch: ch, text: msg} }
+		return e.Export(ctx)
+	})
+	if err != nil {
 		return err
 	}
+	printExportCounts(printer, e.Counts())
 	printer.Println("\nSuccessfully exported control plane state!")
 	return nil
 }
+
+func sendExportProgress(ch async.EventChannel, p exporter.ExportProgress) {
+	text := "Exporting " + p.Resource
+	switch p.State {
+	case exporter.ExportStateStarted:
+		ch.SendEvent(text, async.EventStatusStarted)
+	case exporter.ExportStateDone, exporter.ExportStateSkipped:
+		ch.SendEvent(text, async.EventStatusSuccess)
+	case exporter.ExportStateFailed:
+		ch.SendEvent(text, async.EventStatusFailure)
+	}
+}
+
+// eventSpinner reports a migration step as async events. Its text is fixed
+// when it's created, since events are identified by their text.
+type eventSpinner struct {
+	ch   async.EventChannel
+	text string
+}
+
+func (s *eventSpinner) Start() { s.ch.SendEvent(s.text, async.EventStatusStarted) }
+
+func (s *eventSpinner) Success() { s.ch.SendEvent(s.text, async.EventStatusSuccess) }
+
+func (s *eventSpinner) Fail() { s.ch.SendEvent(s.text, async.EventStatusFailure) }
+
+func (s *eventSpinner) UpdateText(string) {}
+
+func (s *eventSpinner) Logf(string, ...any) {}
+
+func printExportCounts(printer upterm.Printer, counts map[string]int) {
+	printer.Println()
+	for _, r := range slices.Sorted(maps.Keys(counts)) {
+		printer.Printfln("%s: %d", r, counts[r])
+	}
+}
@@ -6,7 +6,11 @@ package migration
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
 
+	"github.com/spf13/afero"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
@@ -40,6 +44,23 @@ type importCmd struct {
 	// https://github.com/upbound/mcp-connector/blob/b8a55b698d5d0c1343faf53110738f9bb1865705/cluster/charts/mcp-connector/values.yaml.tmpl#L49
 	MCPConnectorClaimNamespace string `help:"MCP Connector claim namespace. Required for importing claims supported by MCP Connector."`
 
+	FieldManager   string `default:"up-controlplane-migrator" help:"Field manager used to server-side apply imported resources."`
+	ForceConflicts bool   `default:"true"                     help:"Take ownership of fields owned by other field managers, such as controllers already running in the target control plane. Use --no-force-conflicts to fail on conflicts instead." negatable:""`
+
+	Concurrency int `default:"1" help:"Maximum number of resources to apply to the target control plane at once. CustomResourceDefinitions and namespaces are always applied before the resources that depend on them."`
+
+	IncludeTypes []string          `help:"Only import resources of these types. Types have the form Kind.group, or Kind for the core API group, and may contain wildcards, e.g. '*.aws.upbound.io'." placeholder:"TYPE"`
+	ExcludeTypes []string          `help:"Do not import resources of these types. Takes precedence over --include-types. Uses the same form as --include-types, e.g. 'Event'."                       placeholder:"TYPE"`
+	RemapGroup   map[string]string `help:"Import resources of one API group as another, e.g. 'old.example.org=new.example.org'. May be repeated."                                                    placeholder:"OLD=NEW"`
+
+	Transform      []string          `help:"Transform resources before they're imported. Can be 'drop-status', to let the target control plane populate their status, or 'strip-managed-fields'. May be repeated." placeholder:"TRANSFORM"`
+	RemapNamespace map[string]string `help:"Import resources in one namespace into another, renaming the namespace itself, e.g. 'team-a=team-b'. May be repeated."                                                 placeholder:"OLD=NEW"`
+
+	Checkpoint string `help:"Path of the file recording which resources were successfully imported. Defaults to the input path with a '.checkpoint' suffix. The file is removed once the import completes." placeholder:"PATH" type:"path"`
+	Resume     bool   `help:"Resume an interrupted import, skipping resources the checkpoint file records as already imported."`
+
+	DryRun bool `help:"Report what the import would create or update in the target control plane, using server-side dry-run, without persisting any changes."`
+
 	SkipTargetCheck bool `default:"false" help:"When set to true, skips the check for a local or managed control plane during import." hidden:""`
 }
 
@@ -62,6 +83,32 @@ func (c *importCmd) BeforeApply() error {
 }
 
 func (c *importCmd) Run(ctx context.Context, migCtx *migration.Context, printer upterm.Printer) error {
+	filter := importer.GVKFilter{Include: c.IncludeTypes, Exclude: c.ExcludeTypes}
+	if err := filter.Validate(); err != nil {
+		return err
+	}
+
+	var remap func(string) string
+	if len(c.RemapGroup) > 0 {
+		remap = importer.GroupRemapper(c.RemapGroup)
+	}
+
+	transforms := make([]importer.Transform, 0, len(c.Transform)+1)
+	for _, name := range c.Transform {
+		t, err := importer.BuiltinTransform(name)
+		if err != nil {
+			return err
+		}
+		transforms = append(transforms, t)
+	}
+	if len(c.RemapNamespace) > 0 {
+		transforms = append(transforms, importer.NamespaceRemapper(c.RemapNamespace))
+	}
+
+	if c.Resume && c.DryRun {
+		return errors.New("--resume cannot be used with --dry-run")
+	}
+
 	cfg := migCtx.Kubeconfig
 
 	if !c.SkipTargetCheck && !isAllowedImportTarget(cfg.Host) {
@@ -83,6 +130,18 @@ func (c *importCmd) Run(ctx context.Context, migCtx *migration.Context, printer
 		return err
 	}
 
+	var cp *importer.Checkpoint
+	if !c.DryRun {
+		if c.Checkpoint == "" {
+			c.Checkpoint = filepath.Clean(c.Input) + ".checkpoint"
+		}
+		cp, err = importer.NewCheckpoint(afero.NewOsFs(), c.Checkpoint, c.Resume)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = cp.Close() }()
+	}
+
 	i := importer.NewControlPlaneStateImporter(dynamicClient, discoveryClient, appsClient, mapper, importer.Options{
 		InputArchive: c.Input,
 
@@ -90,6 +149,18 @@ func (c *importCmd) Run(ctx context.Context, migCtx *migration.Context, printer
 
 		MCPConnectorClusterID:      c.MCPConnectorClusterID,
 		MCPConnectorClaimNamespace: c.MCPConnectorClaimNamespace,
+
+		ApplierOptions: importer.ApplierOptions{
+			Concurrency: c.Concurrency,
+			DryRun:      c.DryRun,
+			Filter:      &filter,
+			RemapGroup:  remap,
+			Transforms:  transforms,
+			Checkpoint:  cp,
+
+			FieldManager:   c.FieldManager,
+			ForceConflicts: &c.ForceConflicts,
+		},
 	})
 
 	errs := i.PreflightChecks(ctx)
@@ -111,13 +182,50 @@ func (c *importCmd) Run(ctx context.Context, migCtx *migration.Context, printer
 	migration.DefaultSpinner = func(msg string) migration.Spinner { return printer.NewSuccessSpinner(msg) }
 
 	if err = i.Import(ctx); err != nil {
+		if cp != nil {
+			printer.Println("Run the import again with --resume to skip resources that were already imported.")
+		}
 		return err
 	}
+	if c.DryRun {
+		printDryRunResults(printer, i.DryRunResults())
+		return nil
+	}
+	// The import completed, so there's nothing left to resume.
+	_ = cp.Close()
+	if err := os.Remove(c.Checkpoint); err != nil && !os.IsNotExist(err) {
+		printer.PrintWarning(fmt.Sprintf("cannot remove checkpoint file %q: %v", c.Checkpoint, err))
+	}
 	printer.Println("\nfully imported control plane state!")
 
 	return nil
 }
 
+func printDryRunResults(printer upterm.Printer, results []importer.DryRunResult) {
+	printer.Println()
+	for _, r := range results {
+		if r.Action == importer.DryRunActionUnchanged {
+			continue
+		}
+		id := r.Name
+		if r.Namespace != "" {
+			id = r.Namespace + "/" + r.Name
+		}
+		printer.Printfln("%s %s %s", r.Action, r.GroupVersionKind.Kind, id)
+		if r.Diff != "" {
+			printer.Println(r.Diff)
+		}
+	}
+
+	sum := importer.Summarize(results)
+	printer.Printfln("\ndry run: %d to create, %d to update, %d unchanged, %d unknown",
+		sum[importer.DryRunActionCreate],
+		sum[importer.DryRunActionUpdate],
+		sum[importer.DryRunActionUnchanged],
+		sum[importer.DryRunActionUnknown],
+	)
+}
+
 func isAllowedImportTarget(host string) bool {
 	_, matches := profile.ParseMCPK8sURL(host)
 	if !matches {
@@ -118,6 +118,7 @@ func (c *renderCmd) AfterApply(kongCtx *kong.Context, upCtx *upbound.Context) er
 		image.WithFetcher(
 			image.NewLocalFetcher(
 				image.WithKeychain(upCtx.RegistryKeychain()),
+				image.WithTransport(upCtx.Transport()),
 			),
 		),
 	)
@@ -27,7 +27,6 @@ func (c *getCmd) Run(printer upterm.ResultPrinter, oc *organizations.Client) err
 	}
 	for _, o := range orgs {
 		if o.Name == c.Name {
-			fieldNames := []string{"ID", "NAME", "ROLE"}
 			return printer.PrintObject(o, fieldNames, extractFields)
 		}
 	}
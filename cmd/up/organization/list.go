@@ -5,30 +5,64 @@ package organization
 
 import (
 	"context"
+	"path"
 	"strconv"
 
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
 	"github.com/upbound/up-sdk-go/service/organizations"
 	"github.com/upbound/up/internal/upterm"
 )
 
 // listCmd lists organizations on Upbound.
-type listCmd struct{}
+type listCmd struct {
+	Name string `help:"Only list organizations whose name matches this glob pattern, e.g. 'acme-*'." short:"n"`
+}
+
+// AfterApply validates the name pattern.
+func (c *listCmd) AfterApply() error {
+	if _, err := path.Match(c.Name, ""); err != nil {
+		return errors.Wrapf(err, "invalid name pattern %q", c.Name)
+	}
+	return nil
+}
 
-// Run executes the list command.
+// Run executes the list command. Organizations are listed for the identity of
+// the current profile, so this works with both user and robot tokens.
 func (c *listCmd) Run(ctx context.Context, printer upterm.Printer, oc *organizations.Client) error {
 	orgs, err := oc.List(ctx)
 	if err != nil {
 		return err
 	}
+	orgs = c.filter(orgs)
 	if len(orgs) == 0 {
 		printer.Printfln("No organizations found.")
 		return nil
 	}
-	fieldNames := []string{"ID", "NAME", "ROLE"}
 	return printer.PrintObject(orgs, fieldNames, extractFields)
 }
 
+// filter returns the organizations whose name matches the name pattern, if
+// one is set.
+func (c *listCmd) filter(orgs []organizations.Organization) []organizations.Organization {
+	if c.Name == "" {
+		return orgs
+	}
+	out := make([]organizations.Organization, 0, len(orgs))
+	for _, o := range orgs {
+		// The pattern was validated in AfterApply.
+		if ok, _ := path.Match(c.Name, o.Name); ok {
+			out = append(out, o)
+		}
+	}
+	return out
+}
+
+// fieldNames are the columns of organizations. The API doesn't return when an
+// organization was created, so there is no column for it.
+var fieldNames = []string{"ID", "NAME", "DISPLAY NAME", "ROLE"}
+
 func extractFields(obj any) []string {
 	o, _ := obj.(organizations.Organization)
-	return []string{strconv.FormatUint(uint64(o.ID), 10), o.Name, string(o.Role)}
+	return []string{strconv.FormatUint(uint64(o.ID), 10), o.Name, o.DisplayName, string(o.Role)}
 }
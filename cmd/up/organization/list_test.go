@@ -0,0 +1,58 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package organization
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/upbound/up-sdk-go/service/organizations"
+)
+
+func TestListFilter(t *testing.T) {
+	orgs := []organizations.Organization{
+		{ID: 1, Name: "acme-dev"},
+		{ID: 2, Name: "acme-prod"},
+		{ID: 3, Name: "other"},
+	}
+
+	cases := map[string]struct {
+		reason string
+		name   string
+		want   []organizations.Organization
+	}{
+		"NoPattern": {
+			reason: "All organizations should be listed without a name pattern.",
+			want:   orgs,
+		},
+		"Glob": {
+			reason: "Only organizations matching the pattern should be listed.",
+			name:   "acme-*",
+			want:   orgs[:2],
+		},
+		"NoMatch": {
+			reason: "No organizations should be listed if none match the pattern.",
+			name:   "missing",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &listCmd{Name: tc.name}
+			got := c.filter(orgs)
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nfilter(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestListAfterApply(t *testing.T) {
+	c := &listCmd{Name: "acme-["}
+	if err := c.AfterApply(); err == nil {
+		t.Error("AfterApply(...): expected an error for an invalid pattern")
+	}
+}
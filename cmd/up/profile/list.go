@@ -4,16 +4,28 @@
 package profile
 
 import (
-	"sort"
+	"maps"
+	"slices"
 
-	"github.com/upbound/up/internal/profile"
 	"github.com/upbound/up/internal/upbound"
 	"github.com/upbound/up/internal/upterm"
 
 	_ "embed"
 )
 
-type listCmd struct{}
+type listCmd struct {
+	ShowSecrets bool `help:"Include session tokens in JSON and YAML output instead of redacting them."`
+}
+
+// profileListItem is a profile as printed by the list command.
+type profileListItem struct {
+	Current      bool   `json:"current"`
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	Organization string `json:"organization,omitempty"`
+	Domain       string `json:"domain,omitempty"`
+	Session      string `json:"session,omitempty"`
+}
 
 //go:embed help/list.md
 var listHelp string
@@ -25,49 +37,43 @@ func (c *listCmd) Help() string {
 // Run executes the list command.
 func (c *listCmd) Run(p upterm.Printer, upCtx *upbound.Context) error {
 	profiles, err := upCtx.Cfg.GetUpboundProfiles()
-	if err != nil {
+	if err != nil || len(profiles) == 0 {
 		p.Println("No profiles found")
 		return nil //nolint:nilerr // Successfully list nothing if there are no profiles.
 	}
 
-	redacted := make(map[string]profile.Redacted)
-	for k, v := range profiles {
-		redacted[k] = profile.Redacted{Profile: v}
-	}
-	if len(redacted) == 0 {
-		p.Println("No profiles found")
-		return nil
-	}
-
-	// sort the redacted profiles by name so that we have a consistent listing
-	profileNames := make([]string, 0, len(redacted))
-	for k := range redacted {
-		profileNames = append(profileNames, k)
-	}
-	sort.Strings(profileNames)
-
 	dprofile, _, err := upCtx.Cfg.GetDefaultUpboundProfile()
 	if err != nil {
 		return err
 	}
 
-	data := make([][]string, len(redacted))
-	cursor := ""
-
-	fieldNames := []string{"CURRENT", "NAME", "TYPE", "ORGANIZATION"}
-	for i, name := range profileNames {
-		if name == dprofile {
-			cursor = "*"
+	// sort the profiles by name so that we have a consistent listing
+	items := make([]profileListItem, 0, len(profiles))
+	for _, name := range slices.Sorted(maps.Keys(profiles)) {
+		prof := profiles[name]
+		item := profileListItem{
+			Current:      name == dprofile,
+			Name:         name,
+			Type:         string(prof.Type),
+			Organization: prof.Organization,
+			Domain:       prof.Domain,
+			Session:      prof.Session,
 		}
-		prof := redacted[name]
-		data[i] = []string{cursor, name, string(prof.Type), prof.Organization}
-
-		cursor = "" // reset cursor
+		if item.Session != "" && !c.ShowSecrets {
+			item.Session = "REDACTED"
+		}
+		items = append(items, item)
 	}
 
-	return p.PrintObject(data, fieldNames, extractFields)
+	fieldNames := []string{"CURRENT", "NAME", "TYPE", "ORGANIZATION", "DOMAIN"}
+	return p.PrintObject(items, fieldNames, extractFields)
 }
 
-func extractFields(p any) []string {
-	return p.([]string) //nolint:forcetypeassert // Constructed above.
+func extractFields(obj any) []string {
+	item := obj.(profileListItem) //nolint:forcetypeassert // Constructed above.
+	cursor := ""
+	if item.Current {
+		cursor = "*"
+	}
+	return []string{cursor, item.Name, item.Type, item.Organization, item.Domain}
 }
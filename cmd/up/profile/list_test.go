@@ -0,0 +1,81 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package profile
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/upbound/up/internal/config"
+	"github.com/upbound/up/internal/profile"
+	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/upterm"
+)
+
+func TestListRun(t *testing.T) {
+	t.Parallel()
+
+	upCtx := &upbound.Context{
+		Cfg: &config.Config{
+			Upbound: config.Upbound{
+				Default: "prod",
+				Profiles: map[string]profile.Profile{
+					"prod": {
+						Type:         profile.TypeCloud,
+						Organization: "my-org",
+						Domain:       "https://upbound.io",
+						Session:      "secret-session",
+					},
+					"local": {
+						Type: profile.TypeDisconnected,
+					},
+				},
+			},
+		},
+	}
+
+	tcs := map[string]struct {
+		cmd    *listCmd
+		format config.Format
+		want   string
+	}{
+		"Table": {
+			cmd:    &listCmd{},
+			format: config.FormatDefault,
+			want: "+---------+-------+--------------+--------------+--------------------+\n" +
+				"| CURRENT | NAME  | TYPE         | ORGANIZATION | DOMAIN             |\n" +
+				"+---------+-------+--------------+--------------+--------------------+\n" +
+				"|         | local | disconnected |              |                    |\n" +
+				"| *       | prod  | cloud        | my-org       | https://upbound.io |\n" +
+				"+---------+-------+--------------+--------------+--------------------+\n",
+		},
+		"JSONRedacted": {
+			cmd:    &listCmd{},
+			format: config.FormatJSON,
+			want: `[{"current":false,"name":"local","type":"disconnected"},` +
+				`{"current":true,"name":"prod","type":"cloud","organization":"my-org","domain":"https://upbound.io","session":"REDACTED"}]` + "\n",
+		},
+		"JSONShowSecrets": {
+			cmd:    &listCmd{ShowSecrets: true},
+			format: config.FormatJSON,
+			want: `[{"current":false,"name":"local","type":"disconnected"},` +
+				`{"current":true,"name":"prod","type":"cloud","organization":"my-org","domain":"https://upbound.io","session":"secret-session"}]` + "\n",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var out bytes.Buffer
+			p := upterm.NewPrinter(&out, &out, tc.format, false)
+
+			err := tc.cmd.Run(p, upCtx)
+			assert.NilError(t, err)
+			assert.Equal(t, out.String(), tc.want)
+		})
+	}
+}
@@ -5,6 +5,9 @@
 package profile
 
 import (
+	"maps"
+	"slices"
+
 	"github.com/alecthomas/kong"
 	"github.com/posener/complete"
 
@@ -48,11 +51,6 @@ func PredictProfiles() complete.Predictor {
 			return nil
 		}
 
-		data := make([]string, 0)
-
-		for name := range profiles {
-			data = append(data, name)
-		}
-		return data
+		return slices.Sorted(maps.Keys(profiles))
 	})
 }
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"time"
 
 	"github.com/alecthomas/kong"
 	"github.com/google/go-containerregistry/pkg/name"
@@ -28,17 +29,25 @@ import (
 	"github.com/upbound/up/pkg/apis/project/v2alpha1"
 )
 
+// outputFormatOCILayout writes packages as OCI image layouts rather than a
+// .uppkg file.
+const outputFormatOCILayout = "oci-layout"
+
 // Cmd is the `up project build` command.
 type Cmd struct {
-	ProjectFile    string `default:"upbound.yaml"                                                                           help:"Path to project definition file."                                     short:"f"`
-	Repository     string `help:"Repository for the built package. Overrides the repository specified in the project file." optional:""`
-	OutputDir      string `default:"_output"                                                                                help:"Path to the output directory, where packages will be written."        short:"o"`
-	NoBuildCache   bool   `default:"false"                                                                                  help:"Don't cache image layers while building."`
-	BuildCacheDir  string `default:"~/.up/build-cache"                                                                      help:"Path to the build cache directory."                                   type:"path"`
-	MaxConcurrency uint   `default:"8"                                                                                      env:"UP_MAX_CONCURRENCY"                                                    help:"Maximum number of functions to build at once."`
-	CacheDir       string `default:"~/.up/cache/"                                                                           env:"CACHE_DIR"                                                             help:"Directory used for caching dependencies."                                                        type:"path"`
-	GitToken       string `env:"UP_GIT_TOKEN"                                                                               help:"Token for git HTTPS authentication (GitHub PAT, GitLab token, etc.)."`
-	GitUsername    string `default:"x-access-token"                                                                         env:"UP_GIT_USERNAME"                                                       help:"Username for git HTTPS authentication. Use your Bitbucket username for Bitbucket app passwords."`
+	ProjectFile    string        `default:"upbound.yaml"                                                                           help:"Path to project definition file."                                                                                          short:"f"`
+	Repository     string        `help:"Repository for the built package. Overrides the repository specified in the project file." optional:""`
+	OutputDir      string        `default:"_output"                                                                                help:"Path to the output directory, where packages will be written."                                                             short:"o"`
+	OutputFormat   string        `default:"uppkg"                                                                                  enum:"uppkg,oci-layout"                                                                                                          help:"Format to write packages in: a single .uppkg file, or an OCI image layout per package at <output-dir>/<repository>, named 'configuration'."`
+	NoBuildCache   bool          `default:"false"                                                                                  help:"Don't cache image layers while building."`
+	BuildCacheDir  string        `default:"~/.up/build-cache"                                                                      help:"Path to the build cache directory."                                                                                        type:"path"`
+	MaxConcurrency uint          `default:"8"                                                                                      env:"UP_MAX_CONCURRENCY"                                                                                                         help:"Maximum number of functions to build at once."`
+	CacheDir       string        `default:"~/.up/cache/"                                                                           env:"CACHE_DIR"                                                                                                                  help:"Directory used for caching dependencies."                                                                                                   type:"path"`
+	CacheTTL       time.Duration `env:"CACHE_TTL"                                                                                  help:"Prune dependency cache entries that haven't been used within this duration. Entries used by the project are never pruned."`
+	GitToken       string        `env:"UP_GIT_TOKEN"                                                                               help:"Token for git HTTPS authentication (GitHub PAT, GitLab token, etc.)."`
+	GitUsername    string        `default:"x-access-token"                                                                         env:"UP_GIT_USERNAME"                                                                                                            help:"Username for git HTTPS authentication. Use your Bitbucket username for Bitbucket app passwords."`
+
+	ImageMetadata common.ImageMetadataFlags `embed:""`
 
 	outputFS afero.Fs
 	projFS   afero.Fs
@@ -81,6 +90,7 @@ func (c *Cmd) AfterApply(kongCtx *kong.Context, upCtx *upbound.Context) error {
 
 	// Configure git auth provider based on environment/flags
 	managerOpts := common.BuildManagerOptions(cchFS, c.GitToken, c.GitUsername)
+	managerOpts = append(managerOpts, project.WithCacheTTL(c.CacheTTL))
 
 	m, err := project.NewDependencyManager(upCtx, c.proj, c.projFS, managerOpts...)
 	if err != nil {
@@ -118,27 +128,31 @@ func (c *Cmd) Run(ctx context.Context, upCtx *upbound.Context, printer upterm.Pr
 		}
 	}
 
-	b := project.NewBuilder(
+	bopts := []project.BuilderOption{
 		project.BuildWithMaxConcurrency(c.concurrency),
 		project.BuildWithFunctionIdentifier(c.functionIdentifier),
-	)
+	}
+	if !c.NoBuildCache {
+		bopts = append(bopts, project.BuildWithFunctionCache(project.SharedFunctionCache(c.BuildCacheDir)))
+	}
+	b := project.NewBuilder(bopts...)
 
 	var imgMap project.ImageTagMap
 	err := printer.WrapAsyncWithSuccessSpinners(func(ch async.EventChannel) error {
 		var err error
-		imgMap, err = b.Build(ctx, upCtx, c.proj, c.projFS,
+		opts := c.ImageMetadata.BuildOptions(c)
+		opts = append(opts,
 			project.BuildWithEventChannel(ch),
-			project.BuildWithImageLabels(common.ImageLabels(c)),
 			project.BuildWithDependencyManager(c.m),
 			project.BuildWithProjectBasePath(basePath),
 		)
+		imgMap, err = b.Build(ctx, upCtx, c.proj, c.projFS, opts...)
 		return err
 	})
 	if err != nil {
 		return err
 	}
 
-	outFile := filepath.Join(c.OutputDir, fmt.Sprintf("%s.uppkg", c.proj.Name))
 	err = c.outputFS.MkdirAll(c.OutputDir, 0o755)
 	if err != nil {
 		return errors.Wrapf(err, "failed to create output directory %q", c.OutputDir)
@@ -155,22 +169,32 @@ func (c *Cmd) Run(ctx context.Context, upCtx *upbound.Context, printer upterm.Pr
 		}
 	}
 
-	err = printer.WrapWithSuccessSpinner(
-		fmt.Sprintf("Writing packages to %s", outFile),
-		func() error {
-			f, err := c.outputFS.Create(outFile)
-			if err != nil {
-				return errors.Wrapf(err, "failed to create output file %q", outFile)
-			}
-			defer f.Close() //nolint:errcheck // Can't do anything useful with this error.
-
-			err = tarball.MultiWrite(imgMap, f)
-			if err != nil {
-				return errors.Wrap(err, "failed to write package to file")
-			}
-			return nil
-		},
-	)
+	if c.OutputFormat == outputFormatOCILayout {
+		err = printer.WrapWithSuccessSpinner(
+			fmt.Sprintf("Writing packages to OCI image layouts in %s", c.OutputDir),
+			func() error {
+				return project.WriteLayout(c.OutputDir, imgMap, c.proj.Spec.Repository, project.ConfigurationTag)
+			},
+		)
+	} else {
+		outFile := filepath.Join(c.OutputDir, fmt.Sprintf("%s.uppkg", c.proj.Name))
+		err = printer.WrapWithSuccessSpinner(
+			fmt.Sprintf("Writing packages to %s", outFile),
+			func() error {
+				f, err := c.outputFS.Create(outFile)
+				if err != nil {
+					return errors.Wrapf(err, "failed to create output file %q", outFile)
+				}
+				defer f.Close() //nolint:errcheck // Can't do anything useful with this error.
+
+				err = tarball.MultiWrite(imgMap, f)
+				if err != nil {
+					return errors.Wrap(err, "failed to write package to file")
+				}
+				return nil
+			},
+		)
+	}
 	if err != nil {
 		return err
 	}
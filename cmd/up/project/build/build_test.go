@@ -68,6 +68,8 @@ func TestBuild(t *testing.T) {
 		expectedAnnotatedLayers map[string]bool
 		expectedObjectCount     int
 		expectedLabels          func(c *Cmd) map[string]string
+		imageMetadata           common.ImageMetadataFlags
+		expectedAnnotations     map[string]string
 	}{
 		"XRDV2": {
 			projFS: afero.NewBasePathFs(
@@ -105,6 +107,39 @@ func TestBuild(t *testing.T) {
 				return common.ImageLabels(c)
 			},
 		},
+		"ConfigurationOnlyWithImageMetadata": {
+			projFS: afero.NewBasePathFs(
+				afero.FromIOFS{FS: configurationGettingStarted},
+				"testdata/configuration-getting-started",
+			),
+			outputFile:        "_output/configuration-getting-started.uppkg",
+			expectedFunctions: nil,
+			// 8 APIs = 8 XRDs + 8 compositions.
+			expectedObjectCount: 16,
+			expectedAnnotatedLayers: map[string]bool{
+				xpkg.PackageAnnotation:  true,
+				xpkg.ExamplesAnnotation: true,
+				"schema.mock":           true,
+			},
+			imageMetadata: common.ImageMetadataFlags{
+				Label: map[string]string{
+					"org.opencontainers.image.revision": "abc123",
+					// The labels up sets can't be overridden.
+					"io.upbound.up.userAgent": "custom",
+				},
+				Annotation: map[string]string{
+					"org.opencontainers.image.source": "https://ci.example.com/builds/1",
+				},
+			},
+			expectedLabels: func(c *Cmd) map[string]string {
+				labels := common.ImageLabels(c)
+				labels["org.opencontainers.image.revision"] = "abc123"
+				return labels
+			},
+			expectedAnnotations: map[string]string{
+				"org.opencontainers.image.source": "https://ci.example.com/builds/1",
+			},
+		},
 		"EmbeddedFunctionsWithProjectV1alpha1": {
 			projFS: afero.NewBasePathFs(
 				afero.FromIOFS{FS: projectv1alpha1EmbeddedFunctions},
@@ -320,9 +355,10 @@ func TestBuild(t *testing.T) {
 			assert.NilError(t, err)
 
 			c := &Cmd{
-				ProjectFile:  "upbound.yaml",
-				OutputDir:    "_output",
-				NoBuildCache: true,
+				ProjectFile:   "upbound.yaml",
+				OutputDir:     "_output",
+				NoBuildCache:  true,
+				ImageMetadata: tc.imageMetadata,
 
 				projFS:             projFS,
 				outputFS:           outFS,
@@ -390,6 +426,10 @@ func TestBuild(t *testing.T) {
 
 					assert.DeepEqual(t, tc.expectedAnnotatedLayers, foundLayers)
 
+					for key, expectedValue := range tc.expectedAnnotations {
+						assert.Equal(t, expectedValue, manifest.Annotations[key], "Annotation %s value mismatch", key)
+					}
+
 					cfgFile, err := cfgImage.ConfigFile()
 					assert.NilError(t, err)
 
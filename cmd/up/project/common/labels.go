@@ -5,11 +5,35 @@ package common
 
 import (
 	"fmt"
+	"maps"
 	"reflect"
 
+	"github.com/upbound/up/internal/project"
 	"github.com/upbound/up/internal/version"
 )
 
+// ImageMetadataFlags are flags that add labels and annotations to built
+// packages.
+type ImageMetadataFlags struct {
+	Label      map[string]string `help:"Add a label to the configuration package's image config, such as the git commit it was built from. Can be repeated." placeholder:"KEY=VALUE"`
+	Annotation map[string]string `help:"Add an annotation to the configuration package's manifest, such as the URL of the build. Can be repeated."           placeholder:"KEY=VALUE"`
+}
+
+// BuildOptions returns build options that add the flags' labels and
+// annotations, along with the ImageLabels for the given command struct, to
+// built packages. The ImageLabels can't be overridden.
+func (f ImageMetadataFlags) BuildOptions(cmd any) []project.BuildOption {
+	labels := maps.Clone(f.Label)
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	maps.Copy(labels, ImageLabels(cmd))
+	return []project.BuildOption{
+		project.BuildWithImageLabels(labels),
+		project.BuildWithImageAnnotations(f.Annotation),
+	}
+}
+
 // ImageLabels returns the image labels that should be applied to images
 // generated by the given command struct.
 func ImageLabels(cmd any) map[string]string {
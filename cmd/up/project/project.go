@@ -12,10 +12,12 @@ import (
 	"github.com/upbound/up/cmd/up/project/initialize"
 	"github.com/upbound/up/cmd/up/project/move"
 	"github.com/upbound/up/cmd/up/project/push"
+	"github.com/upbound/up/cmd/up/project/render"
 	"github.com/upbound/up/cmd/up/project/run"
 	"github.com/upbound/up/cmd/up/project/simulate"
 	"github.com/upbound/up/cmd/up/project/stop"
 	"github.com/upbound/up/cmd/up/project/upgrade"
+	"github.com/upbound/up/cmd/up/project/validate"
 	"github.com/upbound/up/internal/upbound"
 )
 
@@ -23,13 +25,15 @@ import (
 type Cmd struct {
 	upbound.RequiresContext
 
-	Init    initialize.Cmd `cmd:"" help:"Initialize a new project."`
-	Build   build.Cmd      `cmd:"" help:"Build a project into a Crossplane package."`
-	Push    push.Cmd       `cmd:"" help:"Push a project's packages to the Upbound Marketplace."`
-	Run     run.Cmd        `cmd:"" help:"Run a project on a development control plane for testing."`
-	Stop    stop.Cmd       `cmd:"" help:"Tear down a development control plane started by the run command."`
-	Move    move.Cmd       `cmd:"" help:"Update the repository for a project"`
-	Upgrade upgrade.Cmd    `cmd:"" help:"Upgrade a project to a newer API version."`
+	Init     initialize.Cmd `cmd:"" help:"Initialize a new project."`
+	Build    build.Cmd      `cmd:"" help:"Build a project into a Crossplane package."`
+	Validate validate.Cmd   `cmd:"" help:"Check a project for problems without building it."`
+	Push     push.Cmd       `cmd:"" help:"Push a project's packages to the Upbound Marketplace."`
+	Render   render.Cmd     `cmd:"" help:"Render the composed resources of an XR with the project's Composition."`
+	Run      run.Cmd        `cmd:"" help:"Run a project on a development control plane for testing."`
+	Stop     stop.Cmd       `cmd:"" help:"Tear down a development control plane started by the run command."`
+	Move     move.Cmd       `cmd:"" help:"Update the repository for a project"`
+	Upgrade  upgrade.Cmd    `cmd:"" help:"Upgrade a project to a newer API version."`
 
 	Simulate   simulate.CreateCmd `cmd:"" help:"Run a project as a simulation against an existing control plane."`
 	Simulation simulate.Cmd       `cmd:"" help:"Manage project simulations."`
@@ -64,7 +64,7 @@ func (c *Cmd) AfterApply(upCtx *upbound.Context) error {
 		c.packageFS = afero.NewOsFs()
 	}
 
-	c.transport = http.DefaultTransport
+	c.transport = upCtx.Transport()
 	c.keychain = upCtx.RegistryKeychain()
 
 	return nil
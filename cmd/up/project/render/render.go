@@ -0,0 +1,306 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+// Package render provides the `up project render` command.
+package render
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/alecthomas/kong"
+	"github.com/spf13/afero"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	xpv1 "github.com/crossplane/crossplane/v2/apis/apiextensions/v1"
+	xpv2 "github.com/crossplane/crossplane/v2/apis/apiextensions/v2"
+	pkgv1 "github.com/crossplane/crossplane/v2/apis/pkg/v1"
+
+	"github.com/upbound/up/internal/async"
+	"github.com/upbound/up/internal/filesystem"
+	"github.com/upbound/up/internal/project"
+	intrender "github.com/upbound/up/internal/render"
+	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/upterm"
+	"github.com/upbound/up/internal/xpkg/dep/manager"
+	"github.com/upbound/up/internal/xpkg/dep/resolver/image"
+	"github.com/upbound/up/internal/xpkg/functions"
+	"github.com/upbound/up/pkg/apis/project/v2alpha1"
+
+	_ "embed"
+)
+
+// xrPath is where the XR is written in the project filesystem for rendering,
+// so that XRs from outside the project can be rendered.
+const xrPath = "/.up/render/xr.yaml"
+
+//go:embed help/render.md
+var renderHelp string
+
+// Help returns help for the command.
+func (c *Cmd) Help() string {
+	return renderHelp
+}
+
+// Cmd is the `up project render` command.
+type Cmd struct {
+	CompositeResource string `arg:"" help:"A YAML file specifying the Composite Resource (XR) to render." type:"existingfile"`
+
+	Composition            string `help:"Name of the Composition to render the XR with. Required if more than one of the project's Compositions matches the XR."`
+	IncludeFunctionResults bool   `help:"Include informational and warning messages from Functions in the rendered output as resources of kind: Result."         short:"r"`
+	IncludeFullXR          bool   `help:"Include a direct copy of the input XR's spec and metadata fields in the rendered output."                               short:"x"`
+	IncludeContext         bool   `help:"Include the context in the rendered output as a resource of kind: Context."                                             short:"c"`
+
+	Timeout        time.Duration `default:"1m" help:"How long to run before timing out."`
+	MaxConcurrency uint          `default:"8"  env:"UP_MAX_CONCURRENCY"                  help:"Maximum number of functions to build at once."`
+
+	ProjectFile   string `default:"upbound.yaml"      help:"Path to project definition file."         short:"f"`
+	CacheDir      string `default:"~/.up/cache/"      env:"CACHE_DIR"                                 help:"Directory used for caching dependency images." type:"path"`
+	NoBuildCache  bool   `default:"false"             help:"Don't cache image layers while building."`
+	BuildCacheDir string `default:"~/.up/build-cache" help:"Path to the build cache directory."       type:"path"`
+
+	projFS afero.Fs
+	proj   *v2alpha1.Project
+
+	functionIdentifier functions.Identifier
+	concurrency        uint
+
+	m *project.DependencyManager
+	r manager.ImageResolver
+}
+
+// AfterApply parses the project and sets up its dependencies.
+func (c *Cmd) AfterApply(kongCtx *kong.Context, upCtx *upbound.Context) error {
+	c.concurrency = max(1, c.MaxConcurrency)
+
+	projFilePath, err := filepath.Abs(c.ProjectFile)
+	if err != nil {
+		return err
+	}
+	// The location of the project file defines the root of the project.
+	projDirPath := filepath.Dir(projFilePath)
+	c.projFS = afero.NewBasePathFs(afero.NewOsFs(), projDirPath)
+
+	proj, err := project.Parse(c.projFS, filepath.Base(c.ProjectFile))
+	if err != nil {
+		return errors.New("this is not a project directory")
+	}
+	proj.Default()
+	c.proj = proj
+
+	c.r = image.NewResolver(
+		image.WithImageConfig(proj.Spec.ImageConfig),
+		image.WithFetcher(
+			image.NewLocalFetcher(
+				image.WithKeychain(upCtx.RegistryKeychain()),
+				image.WithTransport(upCtx.Transport()),
+			),
+		),
+	)
+
+	cchFS := afero.NewBasePathFs(afero.NewOsFs(), c.CacheDir)
+	m, err := project.NewDependencyManager(upCtx, proj, c.projFS,
+		project.WithCacheFS(cchFS),
+	)
+	if err != nil {
+		return err
+	}
+	c.m = m
+
+	c.functionIdentifier = functions.DefaultIdentifier
+
+	// workaround interfaces not being bindable ref: https://github.com/alecthomas/kong/issues/48
+	kongCtx.BindTo(context.Background(), (*context.Context)(nil))
+	kongCtx.BindTo(logging.NewNopLogger(), (*logging.Logger)(nil))
+
+	return nil
+}
+
+// Run executes the render command.
+func (c *Cmd) Run(ctx context.Context, upCtx *upbound.Context, log logging.Logger, printer upterm.Printer) error {
+	xr, err := os.ReadFile(c.CompositeResource)
+	if err != nil {
+		return errors.Wrap(err, "cannot read composite resource")
+	}
+	var tm metav1.TypeMeta
+	if err := yaml.Unmarshal(xr, &tm); err != nil {
+		return errors.Wrap(err, "cannot parse composite resource")
+	}
+
+	apis, err := findAPIs(c.projFS, c.proj.Spec.Paths, tm.GroupVersionKind(), c.Composition)
+	if err != nil {
+		return err
+	}
+
+	// Render from an overlay so that the XR can be read from the project
+	// filesystem wherever it's located.
+	renderFS := filesystem.MemOverlay(c.projFS)
+	if err := renderFS.MkdirAll(path.Dir(xrPath), 0o755); err != nil {
+		return errors.Wrap(err, "cannot prepare composite resource")
+	}
+	if err := afero.WriteFile(renderFS, xrPath, xr, 0o644); err != nil {
+		return errors.Wrap(err, "cannot prepare composite resource")
+	}
+
+	var efns []pkgv1.Function
+	err = printer.WrapAsyncWithSuccessSpinners(func(ch async.EventChannel) error {
+		fns, err := intrender.BuildEmbeddedFunctionsLocalDaemon(ctx, upCtx, intrender.FunctionOptions{
+			Project:            c.proj,
+			ProjFS:             c.projFS,
+			Concurrency:        c.concurrency,
+			NoBuildCache:       c.NoBuildCache,
+			BuildCacheDir:      c.BuildCacheDir,
+			DependencyManager:  c.m,
+			FunctionIdentifier: c.functionIdentifier,
+			EventChannel:       ch,
+		})
+		if err != nil {
+			return errors.Wrap(err, "unable to build embedded functions")
+		}
+		efns = fns
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	renderCtx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	var output string
+	if err := printer.WrapWithSuccessSpinner("Rendering", func() error {
+		var err error
+		output, err = intrender.Render(renderCtx, log, efns, intrender.Options{
+			Project:                c.proj,
+			ProjFS:                 renderFS,
+			IncludeFullXR:          c.IncludeFullXR,
+			IncludeFunctionResults: c.IncludeFunctionResults,
+			IncludeContext:         c.IncludeContext,
+			CompositeResource:      xrPath,
+			Composition:            apis.composition,
+			XRD:                    apis.xrd,
+			Concurrency:            c.concurrency,
+			ImageResolver:          c.r,
+			DependencyManager:      c.m,
+		})
+		return errors.Wrap(err, "unable to render composite resource")
+	}); err != nil {
+		return err
+	}
+
+	printer.PrintResult(output)
+	return nil
+}
+
+// projectAPIs are the paths of the project's Composition and XRD for an XR.
+type projectAPIs struct {
+	composition string
+	xrd         string
+}
+
+// apiResource holds the fields of Compositions and XRDs needed to match them
+// to an XR.
+type apiResource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec struct {
+		// CompositeTypeRef is set for Compositions.
+		CompositeTypeRef struct {
+			APIVersion string `json:"apiVersion"`
+			Kind       string `json:"kind"`
+		} `json:"compositeTypeRef"`
+
+		// Group and Names are set for XRDs.
+		Group string `json:"group"`
+		Names struct {
+			Kind string `json:"kind"`
+		} `json:"names"`
+	} `json:"spec"`
+}
+
+// findAPIs finds the Composition and XRD for an XR of the given kind among the
+// project's APIs. If name is set, only the Composition with that name is
+// considered. The XRD is optional.
+func findAPIs(projFS afero.Fs, paths *v2alpha1.ProjectPaths, gvk schema.GroupVersionKind, name string) (projectAPIs, error) {
+	// APIs default to the whole project when their path is the root, except
+	// for the other parts of the project.
+	root := path.Join("/", paths.APIs)
+	var exclude []string
+	if root == "/" {
+		for _, p := range []string{paths.Examples, paths.Functions, paths.Operations, paths.Tests} {
+			exclude = append(exclude, path.Join("/", p))
+		}
+	}
+
+	var (
+		apis  projectAPIs
+		comps []string
+	)
+	err := afero.Walk(projFS, root, func(p string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			for _, e := range exclude {
+				if path.Join("/", p) == e {
+					return filepath.SkipDir
+				}
+			}
+			return nil
+		}
+		if ext := path.Ext(p); ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+
+		bs, err := afero.ReadFile(projFS, p)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read file %q", p)
+		}
+		var res apiResource
+		if err := yaml.Unmarshal(bs, &res); err != nil {
+			// Files that aren't Kubernetes resources can't be APIs.
+			return nil //nolint:nilerr // Skipping files we can't parse is intended.
+		}
+
+		switch res.GroupVersionKind() {
+		case xpv1.CompositionGroupVersionKind:
+			ref := res.Spec.CompositeTypeRef
+			if ref.APIVersion != gvk.GroupVersion().String() || ref.Kind != gvk.Kind {
+				return nil
+			}
+			if name != "" && res.GetName() != name {
+				return nil
+			}
+			comps = append(comps, p)
+		case xpv1.CompositeResourceDefinitionGroupVersionKind, xpv2.CompositeResourceDefinitionGroupVersionKind:
+			if res.Spec.Group == gvk.Group && res.Spec.Names.Kind == gvk.Kind {
+				apis.xrd = p
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return projectAPIs{}, errors.Wrap(err, "cannot find project APIs")
+	}
+
+	switch {
+	case len(comps) == 0 && name != "":
+		return projectAPIs{}, errors.Errorf("project has no Composition named %q for %s", name, gvk.GroupKind())
+	case len(comps) == 0:
+		return projectAPIs{}, errors.Errorf("project has no Composition for %s", gvk.GroupKind())
+	case len(comps) > 1:
+		return projectAPIs{}, errors.Errorf("project has multiple Compositions for %s (%s); select one with --composition", gvk.GroupKind(), strings.Join(comps, ", "))
+	}
+	apis.composition = comps[0]
+	return apis, nil
+}
@@ -0,0 +1,174 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package render
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/upbound/up/pkg/apis/project/v2alpha1"
+)
+
+const (
+	testXRD = `apiVersion: apiextensions.crossplane.io/v2
+kind: CompositeResourceDefinition
+metadata:
+  name: xnetworks.example.org
+spec:
+  group: example.org
+  names:
+    kind: XNetwork
+`
+	testComposition = `apiVersion: apiextensions.crossplane.io/v1
+kind: Composition
+metadata:
+  name: %s
+spec:
+  compositeTypeRef:
+    apiVersion: example.org/v1alpha1
+    kind: XNetwork
+`
+	otherComposition = `apiVersion: apiextensions.crossplane.io/v1
+kind: Composition
+metadata:
+  name: xclusters
+spec:
+  compositeTypeRef:
+    apiVersion: example.org/v1alpha1
+    kind: XCluster
+`
+)
+
+func TestFindAPIs(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "example.org", Version: "v1alpha1", Kind: "XNetwork"}
+
+	type args struct {
+		files map[string]string
+		apis  string
+		name  string
+	}
+	type want struct {
+		apis projectAPIs
+		err  bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Found": {
+			reason: "The Composition and XRD for the XR should be found.",
+			args: args{
+				files: map[string]string{
+					"/apis/xnetwork/definition.yaml":  testXRD,
+					"/apis/xnetwork/composition.yaml": composition("xnetworks"),
+					"/apis/xcluster/composition.yaml": otherComposition,
+					"/apis/README.md":                 "# APIs",
+				},
+			},
+			want: want{
+				apis: projectAPIs{
+					composition: "/apis/xnetwork/composition.yaml",
+					xrd:         "/apis/xnetwork/definition.yaml",
+				},
+			},
+		},
+		"NoXRD": {
+			reason: "The XRD is optional.",
+			args: args{
+				files: map[string]string{
+					"/apis/xnetwork/composition.yaml": composition("xnetworks"),
+				},
+			},
+			want: want{
+				apis: projectAPIs{
+					composition: "/apis/xnetwork/composition.yaml",
+				},
+			},
+		},
+		"NoComposition": {
+			reason: "An XR without a Composition in the project should return an error.",
+			args: args{
+				files: map[string]string{
+					"/apis/xcluster/composition.yaml": otherComposition,
+				},
+			},
+			want: want{
+				err: true,
+			},
+		},
+		"MultipleCompositions": {
+			reason: "An XR with several matching Compositions should return an error.",
+			args: args{
+				files: map[string]string{
+					"/apis/xnetwork/aws.yaml": composition("xnetworks-aws"),
+					"/apis/xnetwork/gcp.yaml": composition("xnetworks-gcp"),
+				},
+			},
+			want: want{
+				err: true,
+			},
+		},
+		"SelectedComposition": {
+			reason: "A Composition selected by name should be used when several match.",
+			args: args{
+				files: map[string]string{
+					"/apis/xnetwork/aws.yaml": composition("xnetworks-aws"),
+					"/apis/xnetwork/gcp.yaml": composition("xnetworks-gcp"),
+				},
+				name: "xnetworks-gcp",
+			},
+			want: want{
+				apis: projectAPIs{
+					composition: "/apis/xnetwork/gcp.yaml",
+				},
+			},
+		},
+		"RootAPIs": {
+			reason: "Other parts of the project should be skipped when APIs are at the root.",
+			args: args{
+				files: map[string]string{
+					"/xnetwork/composition.yaml": composition("xnetworks"),
+					"/examples/composition.yaml": composition("example"),
+				},
+				apis: "/",
+			},
+			want: want{
+				apis: projectAPIs{
+					composition: "/xnetwork/composition.yaml",
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			projFS := afero.NewMemMapFs()
+			for p, content := range tc.args.files {
+				if err := afero.WriteFile(projFS, p, []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			paths := &v2alpha1.ProjectPaths{APIs: tc.args.apis}
+			paths.Default()
+
+			got, err := findAPIs(projFS, paths, gvk, tc.args.name)
+			if (err != nil) != tc.want.err {
+				t.Fatalf("\n%s\nfindAPIs(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.apis, got, cmp.AllowUnexported(projectAPIs{})); diff != "" {
+				t.Errorf("\n%s\nfindAPIs(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func composition(name string) string {
+	return fmt.Sprintf(testComposition, name)
+}
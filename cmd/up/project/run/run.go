@@ -28,6 +28,7 @@ import (
 
 	upboundpkgv1alpha1 "github.com/upbound/up-sdk-go/apis/pkg/v1alpha1"
 	upboundpkgv1beta1 "github.com/upbound/up-sdk-go/apis/pkg/v1beta1"
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
 	"github.com/upbound/up/cmd/up/project/common"
 	"github.com/upbound/up/internal/async"
 	"github.com/upbound/up/internal/ctp"
@@ -63,21 +64,26 @@ type Cmd struct {
 	ControlPlaneGroup   string            `help:"The control plane group that the control plane to use is contained in. This defaults to the group specified in the current context."`
 	ControlPlaneName    string            `help:"Name of the control plane to use. It will be created if not found. Defaults to the project name."`
 	ControlPlaneVersion string            `help:"Version of Crossplane to use for the control plane. By default, the latest compatible version will be used."`
-	Force               bool              `alias:"allow-production"                                                                                                                                   help:"Allow running on a non-development control plane."                                                       name:"skip-control-plane-check"`
+	CrossplaneChannel   string            `help:"Auto-upgrade channel of Crossplane to use for a dev control plane in Spaces: None, Patch, Stable or Rapid. Defaults to Rapid. Cannot be combined with --control-plane-version."`
+	ControlPlaneClass   string            `help:"Class of the dev control plane to create in Spaces, which determines its size. Defaults to 'small'."`
+	ReadyTimeout        time.Duration     `help:"How long to wait for a dev control plane created in Spaces to become ready. By default there is no timeout."`
+	Force               bool              `alias:"allow-production"                                                                                                                                                                                                                                                                                                            help:"Allow running on a non-development control plane."                                                       name:"skip-control-plane-check"`
 	Local               bool              `help:"Use a local dev control plane, even if Spaces is available."`
 	LocalRegistryPath   string            `help:"Directory to use for local registry images. The default is system-dependent."`
+	KindImage           string            `help:"Node image to use when creating a local dev control plane, e.g. 'kindest/node:v1.31.0'. Determines the control plane's Kubernetes version. Defaults to kind's default node image."`
+	ReuseCluster        string            `help:"Name of a local kind cluster to use for the dev control plane. If the cluster exists it is reused, and Crossplane is only installed if missing or at a different version. Otherwise it is created. By default the cluster is named after the control plane, truncated and suffixed with a hash if longer than 49 characters." placeholder:"NAME"`
 	NoUpdateKubeconfig  bool              `help:"Do not update kubeconfig to use the dev control plane as its current context."`
 	UseCurrentContext   bool              `help:"Run the project with the current kubeconfig context rather than creating a new dev control plane."`
-	CacheDir            string            `default:"~/.up/cache/"                                                                                                                                     env:"CACHE_DIR"                                                                                                help:"Directory used for caching dependencies." type:"path"`
+	CacheDir            string            `default:"~/.up/cache/"                                                                                                                                                                                                                                                                                                              env:"CACHE_DIR"                                                                                                help:"Directory used for caching dependencies." type:"path"`
 	Public              bool              `help:"Create new repositories with public visibility."`
-	Timeout             time.Duration     `default:"5m"                                                                                                                                               help:"Maximum time to wait for the project to become ready in the control plane. Set to zero to wait forever."`
-	Ingress             bool              `default:"false"                                                                                                                                            help:"Enable ingress controller for the local dev control plane."`
+	Timeout             time.Duration     `default:"5m"                                                                                                                                                                                                                                                                                                                        help:"Maximum time to wait for the project to become ready in the control plane. Set to zero to wait forever."`
+	Ingress             bool              `default:"false"                                                                                                                                                                                                                                                                                                                     help:"Enable ingress controller for the local dev control plane."`
 	IngressPort         string            `help:"Port mapping for the local dev control plane (e.g., '8080:80'). If not specified, a random available port will be selected when ingress is enabled."`
-	ClusterAdmin        bool              `default:"true"                                                                                                                                             help:"Allow Crossplane cluster admin privileges in the local dev control plane. Defaults to true."             negatable:""`
-	InitResources       []string          `help:"Paths to additional resource manifests that should be applied before installing the project."                                                        type:"path"`
-	ExtraResources      []string          `help:"Paths to additional resource manifests that should be applied after installing the project."                                                         type:"path"`
+	ClusterAdmin        bool              `default:"true"                                                                                                                                                                                                                                                                                                                      help:"Allow Crossplane cluster admin privileges in the local dev control plane. Defaults to true."             negatable:""`
+	InitResources       []string          `help:"Paths to additional resource manifests that should be applied before installing the project."                                                                                                                                                                                                                                 type:"path"`
+	ExtraResources      []string          `help:"Paths to additional resource manifests that should be applied after installing the project."                                                                                                                                                                                                                                  type:"path"`
 	SetHelmValues       map[string]string `help:"Set custom Crossplane helm chart values for the local dev control plane, specified as key=value pairs."`
-	HelmValues          string            `help:"Path to a YAML file containing custom Crossplane helm chart values for the local dev control plane."                                                 type:"existingfile"`
+	HelmValues          string            `help:"Path to a YAML file containing custom Crossplane helm chart values for the local dev control plane."                                                                                                                                                                                                                          type:"existingfile"`
 
 	projFS             afero.Fs
 	functionIdentifier functions.Identifier
@@ -116,6 +122,16 @@ func (c *Cmd) AfterApply(upCtx *upbound.Context, flags upbound.Flags) error {
 	c.kubeconfigPath = flags.Kube.Kubeconfig
 	c.concurrency = max(1, c.MaxConcurrency)
 
+	if err := ctp.ValidateKindImage(c.KindImage); err != nil {
+		return err
+	}
+	if err := ctp.ValidateCrossplaneChannel(c.CrossplaneChannel); err != nil {
+		return err
+	}
+	if c.CrossplaneChannel != "" && c.ControlPlaneVersion != "" {
+		return errors.New("--crossplane-channel cannot be combined with --control-plane-version")
+	}
+
 	// Read the project file.
 	projFilePath, err := filepath.Abs(c.ProjectFile)
 	if err != nil {
@@ -169,7 +185,7 @@ func (c *Cmd) AfterApply(upCtx *upbound.Context, flags upbound.Flags) error {
 	}
 
 	c.functionIdentifier = functions.DefaultIdentifier
-	c.transport = http.DefaultTransport
+	c.transport = upCtx.Transport()
 	c.keychain = upCtx.RegistryKeychain()
 	c.pusher = project.NewPusher(
 		project.PushWithUpboundContext(upCtx),
@@ -247,10 +263,14 @@ func (c *Cmd) Run(ctx context.Context, upCtx *upbound.Context, printer upterm.Pr
 		c.ControlPlaneName = "up-" + c.proj.Name
 	}
 
-	b := project.NewBuilder(
+	bopts := []project.BuilderOption{
 		project.BuildWithMaxConcurrency(c.concurrency),
 		project.BuildWithFunctionIdentifier(c.functionIdentifier),
-	)
+	}
+	if !c.NoBuildCache {
+		bopts = append(bopts, project.BuildWithFunctionCache(project.SharedFunctionCache(c.BuildCacheDir)))
+	}
+	b := project.NewBuilder(bopts...)
 
 	var (
 		imgMap project.ImageTagMap
@@ -262,10 +282,14 @@ func (c *Cmd) Run(ctx context.Context, upCtx *upbound.Context, printer upterm.Pr
 		opts := []ctp.EnsureDevControlPlaneOption{
 			ctp.WithEventChannel(ch),
 			ctp.WithSpacesGroup(c.ControlPlaneGroup),
+			ctp.WithSpacesClass(c.ControlPlaneClass),
+			ctp.WithSpacesReadyTimeout(c.ReadyTimeout),
 			ctp.WithControlPlaneName(c.ControlPlaneName),
 			ctp.SkipDevCheck(c.Force),
 			ctp.ForceLocal(c.Local),
 			ctp.WithLocalRegistryDirectory(c.LocalRegistryPath),
+			ctp.WithLocalKindImage(c.KindImage),
+			ctp.WithLocalClusterName(c.ReuseCluster),
 			ctp.WithIngress(c.Ingress, c.IngressPort),
 			ctp.WithClusterAdmin(c.ClusterAdmin),
 			ctp.WithLocalHelmValues(c.chartValues),
@@ -279,6 +303,8 @@ func (c *Cmd) Run(ctx context.Context, upCtx *upbound.Context, printer upterm.Pr
 				ctp.WithLocalCrossplaneVersion(c.ControlPlaneVersion),
 				ctp.WithSpacesCrossplaneVersionConstraint(c.ControlPlaneVersion),
 			)
+		case c.CrossplaneChannel != "":
+			opts = append(opts, ctp.WithSpacesCrossplaneChannel(spacesv1beta1.CrossplaneUpgradeChannel(c.CrossplaneChannel)))
 		case c.proj.IsV1():
 			opts = append(opts, ctp.WithSpacesCrossplaneVersionConstraint("^v1.18.0-up.0"))
 		default:
@@ -97,7 +97,7 @@ func (c *CreateCmd) AfterApply(upCtx *upbound.Context) error {
 	c.proj = prj
 
 	c.functionIdentifier = functions.DefaultIdentifier
-	c.transport = http.DefaultTransport
+	c.transport = upCtx.Transport()
 	c.keychain = upCtx.RegistryKeychain()
 
 	cchFS := afero.NewBasePathFs(afero.NewOsFs(), c.CacheDir)
@@ -169,10 +169,14 @@ func (c *CreateCmd) Run(ctx context.Context, upCtx *upbound.Context, printer upt
 		return errors.Wrap(err, "failed to start simulation")
 	}
 
-	b := project.NewBuilder(
+	bopts := []project.BuilderOption{
 		project.BuildWithMaxConcurrency(c.concurrency),
 		project.BuildWithFunctionIdentifier(c.functionIdentifier),
-	)
+	}
+	if !c.NoBuildCache {
+		bopts = append(bopts, project.BuildWithFunctionCache(project.SharedFunctionCache(c.BuildCacheDir)))
+	}
+	b := project.NewBuilder(bopts...)
 
 	var imgMap project.ImageTagMap
 	err = printer.WrapAsyncWithSuccessSpinners(func(ch async.EventChannel) error {
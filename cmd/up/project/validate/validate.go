@@ -0,0 +1,287 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+// Package validate provides the `up project validate` command.
+package validate
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/alecthomas/kong"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/spf13/afero"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	xpv1 "github.com/crossplane/crossplane/v2/apis/apiextensions/v1"
+	xpv2 "github.com/crossplane/crossplane/v2/apis/apiextensions/v2"
+	pkgmetav1 "github.com/crossplane/crossplane/v2/apis/pkg/meta/v1"
+
+	"github.com/upbound/up/internal/filesystem"
+	"github.com/upbound/up/internal/project"
+	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/upterm"
+	"github.com/upbound/up/pkg/apis/project/v2alpha1"
+
+	_ "embed"
+)
+
+//go:embed help/validate.md
+var validateHelp string
+
+// Help returns help for the command.
+func (c *Cmd) Help() string {
+	return validateHelp
+}
+
+// Cmd is the `up project validate` command.
+type Cmd struct {
+	ProjectFile string `default:"upbound.yaml"                                                                                help:"Path to project definition file." short:"f"`
+	Repository  string `help:"Repository for the project's packages. Overrides the repository specified in the project file." optional:""`
+	CacheDir    string `default:"~/.up/cache/"                                                                                env:"CACHE_DIR"                         help:"Directory used for caching dependencies." type:"path"`
+
+	projFS afero.Fs
+}
+
+// AfterApply sets up the project filesystem.
+func (c *Cmd) AfterApply(kongCtx *kong.Context) error {
+	projFilePath, err := filepath.Abs(c.ProjectFile)
+	if err != nil {
+		return err
+	}
+	// The location of the project file defines the root of the project.
+	c.projFS = afero.NewBasePathFs(afero.NewOsFs(), filepath.Dir(projFilePath))
+	c.ProjectFile = filepath.Base(projFilePath)
+
+	// workaround interfaces not being bindable ref: https://github.com/alecthomas/kong/issues/48
+	kongCtx.BindTo(context.Background(), (*context.Context)(nil))
+
+	return nil
+}
+
+// Run executes the validate command.
+func (c *Cmd) Run(ctx context.Context, upCtx *upbound.Context, printer upterm.Printer) error {
+	proj, err := parseProject(c.projFS, c.ProjectFile, c.Repository)
+	if err != nil {
+		// Nothing else can be checked without a project.
+		printer.PrintError(problem{file: c.ProjectFile, err: err})
+		return errors.New("project is not valid")
+	}
+
+	ps := checkPaths(c.projFS, c.ProjectFile, proj.Spec.Paths)
+	proj.Default()
+	ps = append(ps, checkRepository(c.ProjectFile, proj.Spec.Repository)...)
+	ps = append(ps, checkAPIs(c.projFS, proj.Spec.Paths)...)
+
+	cchFS := afero.NewBasePathFs(afero.NewOsFs(), c.CacheDir)
+	m, err := project.NewDependencyManager(upCtx, proj, c.projFS,
+		project.WithProjectFile(c.ProjectFile),
+		project.WithCacheFS(cchFS),
+	)
+	if err != nil {
+		return err
+	}
+	ps = append(ps, checkDependencies(ctx, c.ProjectFile, proj.Spec.DependsOn, m.CheckDependency)...)
+
+	if len(ps) == 0 {
+		printer.PrintSuccess("Project is valid")
+		return nil
+	}
+	for _, p := range ps {
+		printer.PrintError(p)
+	}
+	return errors.Errorf("found %d problems in project", len(ps))
+}
+
+// problem is a problem found in a project. The file is relative to the
+// project root, and is empty if the problem isn't specific to a file.
+type problem struct {
+	file string
+	err  error
+}
+
+func (p problem) String() string {
+	if p.file == "" {
+		return p.err.Error()
+	}
+	return fmt.Sprintf("%s: %s", p.file, p.err)
+}
+
+// parseProject parses the project file, optionally overriding its repository
+// so that a project without one can be validated.
+func parseProject(projFS afero.Fs, projFile, repository string) (*v2alpha1.Project, error) {
+	if repository != "" {
+		bs, err := afero.ReadFile(projFS, projFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read project file")
+		}
+		var u unstructured.Unstructured
+		if err := yaml.Unmarshal(bs, &u.Object); err != nil {
+			return nil, errors.Wrap(err, "failed to parse project file")
+		}
+		if err := unstructured.SetNestedField(u.Object, repository, "spec", "repository"); err != nil {
+			return nil, errors.Wrap(err, "failed to override repository")
+		}
+		bs, err = yaml.Marshal(u.Object)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to override repository")
+		}
+		projFS = filesystem.MemOverlay(projFS)
+		if err := afero.WriteFile(projFS, projFile, bs, 0o644); err != nil {
+			return nil, errors.Wrap(err, "failed to override repository")
+		}
+	}
+
+	return project.Parse(projFS, projFile)
+}
+
+// checkPaths checks that the paths set in the project file exist. Paths that
+// aren't set default to optional directories, so they aren't checked.
+func checkPaths(projFS afero.Fs, projFile string, paths *v2alpha1.ProjectPaths) []problem {
+	if paths == nil {
+		return nil
+	}
+
+	var ps []problem
+	for _, p := range []struct {
+		field string
+		dir   string
+	}{
+		{field: "apis", dir: paths.APIs},
+		{field: "functions", dir: paths.Functions},
+		{field: "examples", dir: paths.Examples},
+		{field: "tests", dir: paths.Tests},
+		{field: "operations", dir: paths.Operations},
+	} {
+		if p.dir == "" {
+			continue
+		}
+		exists, err := afero.DirExists(projFS, p.dir)
+		switch {
+		case err != nil:
+			ps = append(ps, problem{file: projFile, err: errors.Wrapf(err, "spec.paths.%s", p.field)})
+		case !exists:
+			ps = append(ps, problem{file: projFile, err: errors.Errorf("spec.paths.%s: directory %q does not exist", p.field, p.dir)})
+		}
+	}
+	return ps
+}
+
+// checkRepository checks that the project's repository is a valid OCI
+// repository.
+func checkRepository(projFile, repository string) []problem {
+	if _, err := name.NewRepository(repository); err != nil {
+		return []problem{{file: projFile, err: errors.Wrap(err, "spec.repository")}}
+	}
+	return nil
+}
+
+// checkAPIs checks that the project's Compositions and XRDs parse. Like a
+// build, it fails on any YAML file among the APIs that isn't valid YAML.
+func checkAPIs(projFS afero.Fs, paths *v2alpha1.ProjectPaths) []problem {
+	// APIs default to the whole project when their path is the root, except
+	// for the other parts of the project.
+	root := path.Join("/", paths.APIs)
+	var exclude []string
+	if root == "/" {
+		for _, p := range []string{paths.Examples, paths.Functions, paths.Operations} {
+			exclude = append(exclude, path.Join("/", p))
+		}
+	}
+
+	var ps []problem
+	err := afero.Walk(projFS, root, func(p string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			for _, e := range exclude {
+				if path.Join("/", p) == e {
+					return filepath.SkipDir
+				}
+			}
+			return nil
+		}
+		if ext := path.Ext(p); ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+
+		file := strings.TrimPrefix(path.Clean(p), "/")
+		bs, err := afero.ReadFile(projFS, p)
+		if err != nil {
+			ps = append(ps, problem{file: file, err: err})
+			return nil
+		}
+		if err := checkAPI(bs); err != nil {
+			ps = append(ps, problem{file: file, err: err})
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		ps = append(ps, problem{err: errors.Wrap(err, "cannot read project APIs")})
+	}
+	return ps
+}
+
+// checkAPI checks that a Composition or XRD parses. Other resources are only
+// checked for valid YAML.
+func checkAPI(bs []byte) error {
+	var tm metav1.TypeMeta
+	if err := yaml.Unmarshal(bs, &tm); err != nil {
+		return err
+	}
+
+	var obj any
+	switch tm.GroupVersionKind() {
+	case xpv1.CompositionGroupVersionKind:
+		obj = &xpv1.Composition{}
+	case xpv1.CompositeResourceDefinitionGroupVersionKind:
+		obj = &xpv1.CompositeResourceDefinition{}
+	case xpv2.CompositeResourceDefinitionGroupVersionKind:
+		obj = &xpv2.CompositeResourceDefinition{}
+	default:
+		return nil
+	}
+	return errors.Wrapf(yaml.UnmarshalStrict(bs, obj), "invalid %s", tm.Kind)
+}
+
+// checkDependencies checks that the project's dependencies are valid, and
+// that they're consistent with the dependency cache.
+func checkDependencies(ctx context.Context, projFile string, deps []pkgmetav1.Dependency, check func(context.Context, pkgmetav1.Dependency) error) []problem {
+	var ps []problem
+	for i, d := range deps {
+		field := fmt.Sprintf("spec.dependsOn[%d]", i)
+		d, err := project.NormalizeDependency(d)
+		if err != nil {
+			ps = append(ps, problem{file: projFile, err: errors.Wrap(err, field)})
+			continue
+		}
+		pkg := ptr.Deref(d.Package, "")
+		if _, err := name.NewRepository(pkg); err != nil {
+			ps = append(ps, problem{file: projFile, err: errors.Wrapf(err, "%s: invalid package %q", field, pkg)})
+			continue
+		}
+		if _, err := name.NewDigest(pkg + "@" + d.Version); err == nil {
+			// The cache can't resolve digests without fetching them.
+			continue
+		}
+		if _, err := semver.NewConstraint(d.Version); err != nil {
+			ps = append(ps, problem{file: projFile, err: errors.Wrapf(err, "%s: invalid version %q for %s", field, d.Version, pkg)})
+			continue
+		}
+
+		if err := check(ctx, d); err != nil {
+			ps = append(ps, problem{file: projFile, err: errors.Wrapf(err, "%s: %s is not up to date in the dependency cache; run `up dependency update-cache`", field, pkg)})
+		}
+	}
+	return ps
+}
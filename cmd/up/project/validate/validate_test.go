@@ -0,0 +1,265 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	pkgmetav1 "github.com/crossplane/crossplane/v2/apis/pkg/meta/v1"
+
+	"github.com/upbound/up/pkg/apis/project/v2alpha1"
+)
+
+const (
+	validComposition = `apiVersion: apiextensions.crossplane.io/v1
+kind: Composition
+metadata:
+  name: xnetworks
+spec:
+  compositeTypeRef:
+    apiVersion: example.org/v1alpha1
+    kind: XNetwork
+  mode: Pipeline
+`
+	invalidComposition = `apiVersion: apiextensions.crossplane.io/v1
+kind: Composition
+metadata:
+  name: xnetworks
+spec:
+  compositeTypeRef: example.org/v1alpha1
+`
+	validXRD = `apiVersion: apiextensions.crossplane.io/v2
+kind: CompositeResourceDefinition
+metadata:
+  name: xnetworks.example.org
+spec:
+  group: example.org
+  names:
+    kind: XNetwork
+    plural: xnetworks
+`
+	invalidYAML = `apiVersion: apiextensions.crossplane.io/v2
+kind: CompositeResourceDefinition
+metadata:
+  name: [xnetworks.example.org
+`
+)
+
+func TestParseProject(t *testing.T) {
+	type args struct {
+		project    string
+		repository string
+	}
+
+	type want struct {
+		repository string
+		err        bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Valid": {
+			reason: "A valid project should parse.",
+			args: args{
+				project: "apiVersion: meta.dev.upbound.io/v2alpha1\nkind: Project\nmetadata:\n  name: example\nspec:\n  repository: xpkg.upbound.io/example/example\n",
+			},
+			want: want{
+				repository: "xpkg.upbound.io/example/example",
+			},
+		},
+		"MissingRepository": {
+			reason: "A project without a repository should be invalid.",
+			args: args{
+				project: "apiVersion: meta.dev.upbound.io/v2alpha1\nkind: Project\nmetadata:\n  name: example\nspec: {}\n",
+			},
+			want: want{
+				err: true,
+			},
+		},
+		"OverriddenRepository": {
+			reason: "A project without a repository should be valid when the repository is overridden.",
+			args: args{
+				project:    "apiVersion: meta.dev.upbound.io/v2alpha1\nkind: Project\nmetadata:\n  name: example\nspec: {}\n",
+				repository: "xpkg.upbound.io/example/override",
+			},
+			want: want{
+				repository: "xpkg.upbound.io/example/override",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			projFS := afero.NewMemMapFs()
+			if err := afero.WriteFile(projFS, "upbound.yaml", []byte(tc.args.project), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			proj, err := parseProject(projFS, "upbound.yaml", tc.args.repository)
+			if (err != nil) != tc.want.err {
+				t.Fatalf("\n%s\nparseProject(...): unexpected error: %v", tc.reason, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.repository, proj.Spec.Repository); diff != "" {
+				t.Errorf("\n%s\nparseProject(...): -want, +got:\n%s", tc.reason, diff)
+			}
+
+			// The project file itself should never be modified.
+			bs, _ := afero.ReadFile(projFS, "upbound.yaml")
+			if diff := cmp.Diff(tc.args.project, string(bs)); diff != "" {
+				t.Errorf("\n%s\nparseProject(...): project file modified: -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCheckPaths(t *testing.T) {
+	projFS := afero.NewMemMapFs()
+	if err := projFS.MkdirAll("apis", 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	got := problemStrings(checkPaths(projFS, "upbound.yaml", &v2alpha1.ProjectPaths{
+		APIs:      "apis",
+		Functions: "fns",
+	}))
+	want := []string{`upbound.yaml: spec.paths.functions: directory "fns" does not exist`}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("checkPaths(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestCheckAPIs(t *testing.T) {
+	type want struct {
+		files []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		files  map[string]string
+		apis   string
+		want   want
+	}{
+		"Valid": {
+			reason: "Valid Compositions and XRDs should have no problems.",
+			files: map[string]string{
+				"/apis/xnetwork/composition.yaml": validComposition,
+				"/apis/xnetwork/definition.yaml":  validXRD,
+				"/apis/README.md":                 "# Not YAML: {",
+			},
+		},
+		"AllProblems": {
+			reason: "Every invalid file should be reported.",
+			files: map[string]string{
+				"/apis/xnetwork/composition.yaml": invalidComposition,
+				"/apis/xnetwork/definition.yaml":  invalidYAML,
+				"/apis/xcluster/composition.yaml": validComposition,
+			},
+			want: want{
+				files: []string{"apis/xnetwork/composition.yaml", "apis/xnetwork/definition.yaml"},
+			},
+		},
+		"RootAPIs": {
+			reason: "Other parts of the project should be skipped when APIs are at the root.",
+			files: map[string]string{
+				"/xnetwork/composition.yaml": validComposition,
+				"/examples/broken.yaml":      invalidYAML,
+			},
+			apis: "/",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			projFS := afero.NewMemMapFs()
+			for p, content := range tc.files {
+				if err := afero.WriteFile(projFS, p, []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			paths := &v2alpha1.ProjectPaths{APIs: tc.apis}
+			paths.Default()
+
+			var got []string
+			for _, p := range checkAPIs(projFS, paths) {
+				got = append(got, p.file)
+			}
+			if diff := cmp.Diff(tc.want.files, got); diff != "" {
+				t.Errorf("\n%s\ncheckAPIs(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCheckDependencies(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	deps := []pkgmetav1.Dependency{
+		{
+			APIVersion: ptr.To("pkg.crossplane.io/v1"),
+			Kind:       ptr.To("Provider"),
+			Package:    ptr.To("xpkg.upbound.io/upbound/provider-aws-s3"),
+			Version:    ">=v1.0.0",
+		},
+		{
+			APIVersion: ptr.To("pkg.crossplane.io/v1"),
+			Kind:       ptr.To("Function"),
+			Package:    ptr.To("xpkg.upbound.io/crossplane-contrib/function-auto-ready"),
+			Version:    "not a version",
+		},
+		{
+			APIVersion: ptr.To("pkg.crossplane.io/v1"),
+			Kind:       ptr.To("Function"),
+			Package:    ptr.To("xpkg.upbound.io/crossplane-contrib/function-patch-and-transform"),
+			Version:    "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		},
+		{
+			APIVersion: ptr.To("pkg.crossplane.io/v1"),
+			Kind:       ptr.To("Provider"),
+			Package:    ptr.To("xpkg.upbound.io/upbound/provider-aws-ec2"),
+			Version:    "v1.0.0",
+		},
+		{},
+	}
+
+	// Only provider-aws-ec2 is up to date in the cache.
+	var checked []string
+	check := func(_ context.Context, d pkgmetav1.Dependency) error {
+		checked = append(checked, *d.Package)
+		if *d.Package == "xpkg.upbound.io/upbound/provider-aws-ec2" {
+			return nil
+		}
+		return errBoom
+	}
+
+	ps := checkDependencies(t.Context(), "upbound.yaml", deps, check)
+	if len(ps) != 3 {
+		t.Errorf("checkDependencies(...): want 3 problems, got %d: %v", len(ps), problemStrings(ps))
+	}
+
+	// Invalid dependencies and digests shouldn't be checked against the cache.
+	want := []string{"xpkg.upbound.io/upbound/provider-aws-s3", "xpkg.upbound.io/upbound/provider-aws-ec2"}
+	if diff := cmp.Diff(want, checked); diff != "" {
+		t.Errorf("checkDependencies(...): checked -want, +got:\n%s", diff)
+	}
+}
+
+func problemStrings(ps []problem) []string {
+	out := make([]string, 0, len(ps))
+	for _, p := range ps {
+		out = append(out, p.String())
+	}
+	return out
+}
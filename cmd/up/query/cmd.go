@@ -27,6 +27,14 @@ type cmd struct {
 	Template         string `help:"Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]." name:"template"                    short:"t"`
 	AllowMissingKeys bool   `help:"If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."                                        name:"allow-missing-template-keys"`
 
+	// selector flags
+	Selector      string `help:"Selector (label query) to filter on, supports '=', '==', '!=', 'in', 'notin' and 'exists' (e.g. -l key1=value1,key2=value2). Equality requirements are filtered by the server."                        short:"l"`
+	FieldSelector string `help:"Selector (field query) to filter on, supports '=', '==' and '!=' (e.g. --field-selector metadata.name=foo). Equality requirements on metadata.name and metadata.namespace are filtered by the server."`
+
+	// paging flags
+	Limit    int    `help:"Maximum number of objects to return per control plane, for each type queried. Tables, whose rows don't record their control plane, are limited as a whole. 0 means no limit."`
+	Continue string `help:"Continue a query of a single type in a single control plane that was truncated by --limit, from the given cursor."`
+
 	// json/yaml flags
 	ShowManagedFields bool `help:"If true, keep the managedFields when printing objects in JSON or YAML format." name:"show-managed-fields"`
 
@@ -37,6 +45,7 @@ type cmd struct {
 
 	printFlags *get.PrintFlags
 	namespace  string // inside the control plane
+	selectors  *querySelectors
 }
 
 // NotFound print Message NotFound.
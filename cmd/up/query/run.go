@@ -10,6 +10,7 @@ package query
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/alecthomas/kong"
@@ -65,6 +66,16 @@ func (c *cmd) afterApply() error {
 
 	c.printFlags.JSONYamlPrintFlags.ShowManagedFields = c.ShowManagedFields
 
+	if c.Limit < 0 {
+		return errors.New("--limit must not be negative")
+	}
+
+	sel, err := parseSelectors(c.Selector, c.FieldSelector)
+	if err != nil {
+		return err
+	}
+	c.selectors = sel
+
 	return nil
 }
 
@@ -114,38 +125,153 @@ func (c *cmd) Run(ctx context.Context, kongCtx *kong.Context, queryTemplate reso
 		}
 	}
 
-	// send queries and collect objects
+	// push selectors to the server where the Query API supports them
+	for _, spec := range querySpecs {
+		for i := range spec.Filter.Objects {
+			c.selectors.apply(&spec.Filter.Objects[i])
+		}
+	}
+	if w := c.selectors.warning(); w != "" {
+		p.PrintWarning(w)
+	}
+
+	if c.Continue != "" {
+		if len(querySpecs) != 1 {
+			return errors.New("--continue can only be used when querying a single type")
+		}
+		querySpecs[0].Page.Cursor = c.Continue
+	}
+
+	// Tables are printed as they arrive, unless they have to be sorted. Other
+	// output formats print all objects at once, e.g. as a single List.
+	humanReadableOutput := (c.OutputFormat == "" && c.Template == "") || c.OutputFormat == "wide"
+	stream := humanReadableOutput && len(c.SortBy) == 0
+
 	var infos []*cliresource.Info
-	gks := sets.New[runtimeschema.GroupKind]()
+	emit := func(page []*cliresource.Info) error {
+		infos = append(infos, page...)
+		return nil
+	}
+	var finish func() error
+	if stream {
+		// We can't know whether more than one kind will be returned before
+		// printing the first table, so show kinds whenever that's possible.
+		showKind := c.ShowKind || len(categoryNames) > 0 || len(gkNames) > 1
+		emit, finish = c.newHumanReadablePrinter(kongCtx.Stdout, showKind, notFound)
+	}
+
+	// send queries and collect objects
+	res, err := c.collect(ctx, kongCtx.Stderr, kc, queryTemplate, querySpecs, p, emit)
+	if err != nil {
+		return err
+	}
+
+	// print objects
+	switch {
+	case stream:
+		err = finish()
+	case humanReadableOutput:
+		showKind := c.ShowKind || res.gks.Len() > 1 || len(categoryNames)+len(gkNames) > 1
+		err = c.humanReadablePrintObjects(kongCtx.Stdout, infos, showKind, notFound)
+	default:
+		err = c.printGeneric(kongCtx, infos)
+	}
+	if err != nil {
+		return err
+	}
+
+	printTruncation(kongCtx.Stderr, res, c.Limit)
+	return nil
+}
+
+// printTruncation tells the user if --limit truncated the results, and how to
+// continue them if possible.
+func printTruncation(w io.Writer, res *collectResult, limit int) {
+	switch {
+	case res.next != "":
+		fmt.Fprintf(w, "Results truncated to %d objects. To continue, run again with --continue=%s\n", limit, res.next) //nolint:errcheck // just informational output
+	case res.truncated.Has(""):
+		fmt.Fprintf(w, "Results truncated to %d objects.\n", limit) //nolint:errcheck // just informational output
+	case res.truncated.Len() > 0:
+		fmt.Fprintf(w, "Results truncated to %d objects per control plane in %s.\n", limit, strings.Join(sets.List(res.truncated), ", ")) //nolint:errcheck // just informational output
+	}
+}
+
+// collectResult is the result of collecting the objects of a query.
+type collectResult struct {
+	gks sets.Set[runtimeschema.GroupKind]
+
+	// truncated holds the control planes whose objects were truncated by
+	// --limit. An empty string stands for objects whose control plane isn't
+	// known, like table rows.
+	truncated sets.Set[string]
+	// next is the cursor to continue a truncated query of a single type in a
+	// single control plane from.
+	next string
+}
+
+// collect sends the queries and passes the objects in the responses that match
+// the selectors to emit, one page at a time. At most --limit objects per
+// control plane are emitted for each query.
+func (c *cmd) collect(ctx context.Context, stderr io.Writer, kc client.Client, queryTemplate resource.QueryObject, querySpecs []*queryv1alpha2.QuerySpec, p upterm.Printer, emit func(infos []*cliresource.Info) error) (*collectResult, error) { //nolint:gocognit,gocyclo // mostly taken from kubectl get. We don't want to divert.
+	res := &collectResult{
+		gks:       sets.New[runtimeschema.GroupKind](),
+		truncated: sets.New[string](),
+	}
+
+	// In a single control plane, the server's page size bounds the objects we
+	// fetch, and the cursor can continue where the limit truncated them. Across
+	// control planes, we have to page through all objects to apply the limit
+	// per control plane.
+	_, singleControlPlane := queryTemplate.(*resource.Query)
+
 	for qi, spec := range querySpecs {
-		var cursor string
+		cursor := spec.Page.Cursor
 		var page int
+		var total int
+		counts := map[string]int{}
+		allow := func(source string) bool {
+			if c.Limit <= 0 {
+				return true
+			}
+			if counts[source] >= c.Limit {
+				res.truncated.Insert(source)
+				return false
+			}
+			counts[source]++
+			total++
+			return true
+		}
+
 		for {
 			spec := spec.DeepCopy()
 			spec.Page.Cursor = cursor
+			if singleControlPlane && c.Limit > 0 {
+				spec.Limit = min(spec.Limit, c.Limit-total)
+			}
 			query := queryTemplate.DeepCopyQueryObject().SetSpec(spec)
 
 			// print query for debugging
 			if c.Flags.Debug > 0 {
 				kinds, _, err := queryScheme.ObjectKinds(query)
 				if err != nil {
-					return errors.Wrap(err, "failed to get object kinds")
+					return nil, errors.Wrap(err, "failed to get object kinds")
 				}
 				if len(kinds) != 1 {
-					return errors.Errorf("expected exactly one kind, got %d", len(kinds))
+					return nil, errors.Errorf("expected exactly one kind, got %d", len(kinds))
 				}
 				query := query.DeepCopyQueryObject()
 				query.GetObjectKind().SetGroupVersionKind(queryv1alpha2.SchemeGroupVersion.WithKind(kinds[0].Kind))
 				bs, err := yaml.Marshal(query)
 				if err != nil {
-					return errors.Wrap(err, "failed to marshal query")
+					return nil, errors.Wrap(err, "failed to marshal query")
 				}
-				fmt.Fprintf(kongCtx.Stderr, "Sending query:\n\n%s\n", string(bs)) //nolint:errcheck // just debug output
+				fmt.Fprintf(stderr, "Sending query:\n\n%s\n", string(bs)) //nolint:errcheck // just debug output
 			}
 
 			// send request
 			if err := kc.Create(ctx, query); err != nil {
-				return errors.Wrap(err, "SpaceQuery request failed")
+				return nil, errors.Wrap(err, "SpaceQuery request failed")
 			}
 			resp := query.GetResponse()
 			for _, w := range resp.Warnings {
@@ -153,6 +279,7 @@ func (c *cmd) Run(ctx context.Context, kongCtx *kong.Context, queryTemplate reso
 			}
 
 			// collect objects
+			var infos []*cliresource.Info
 			if len(resp.Tables) > 0 {
 				for i := range resp.Tables {
 					tbl := &resp.Tables[i]
@@ -170,10 +297,21 @@ func (c *cmd) Run(ctx context.Context, kongCtx *kong.Context, queryTemplate reso
 							u := &unstructured.Unstructured{}
 							r.Object.Object = u
 							if err := json.Unmarshal(r.Object.Raw, &u.Object); err != nil {
-								return fmt.Errorf("failed to unmarshal object: %w", err)
+								return nil, fmt.Errorf("failed to unmarshal object: %w", err)
 							}
 						}
 					}
+					rows := c.selectors.filterRows(tbl.Rows)
+					tbl.Rows = rows[:0]
+					for _, r := range rows {
+						if allow("") {
+							tbl.Rows = append(tbl.Rows, r)
+						}
+					}
+					if len(tbl.Rows) == 0 {
+						continue
+					}
+					t.Rows = tbl.Rows
 					info := &cliresource.Info{
 						Client: nil,
 						Mapping: &meta.RESTMapping{
@@ -209,15 +347,19 @@ func (c *cmd) Run(ctx context.Context, kongCtx *kong.Context, queryTemplate reso
 						}
 					}
 					infos = append(infos, info)
-					gks.Insert(runtimeschema.GroupKind{Group: tbl.GroupVersionKind.Group, Kind: tbl.GroupVersionKind.Kind})
+					res.gks.Insert(runtimeschema.GroupKind{Group: tbl.GroupVersionKind.Group, Kind: tbl.GroupVersionKind.Kind})
 				}
 			} else {
 				for _, obj := range resp.Objects {
 					if obj.Object == nil {
-						return fmt.Errorf("received unexpected nil object in response")
+						return nil, fmt.Errorf("received unexpected nil object in response")
 					}
 
 					u := &unstructured.Unstructured{Object: obj.Object.Object}
+					source := types.NamespacedName{Namespace: obj.ControlPlane.Namespace, Name: obj.ControlPlane.Name}.String()
+					if !c.selectors.matches(u) || !allow(source) {
+						continue
+					}
 					infos = append(infos, &cliresource.Info{
 						Client: nil,
 						Mapping: &meta.RESTMapping{
@@ -229,53 +371,53 @@ func (c *cmd) Run(ctx context.Context, kongCtx *kong.Context, queryTemplate reso
 						},
 						Namespace:       u.GetNamespace(),
 						Name:            u.GetName(),
-						Source:          types.NamespacedName{Namespace: obj.ControlPlane.Namespace, Name: obj.ControlPlane.Name}.String(),
+						Source:          source,
 						Object:          u,
 						ResourceVersion: u.GetResourceVersion(),
 					})
-					gks.Insert(u.GroupVersionKind().GroupKind())
+					res.gks.Insert(u.GroupVersionKind().GroupKind())
 				}
 			}
 
+			if err := emit(infos); err != nil {
+				return nil, err
+			}
+
 			// do paging
 			cursor = resp.Cursor.Next
 			page++
 			if cursor == "" {
 				break
 			}
+			if singleControlPlane && c.Limit > 0 && total >= c.Limit {
+				res.truncated.Insert("")
+				if len(querySpecs) == 1 {
+					res.next = cursor
+				}
+				break
+			}
 			if c.Flags.Debug > 0 {
-				fmt.Fprintf(kongCtx.Stderr, "Fetching page %d\n", page) //nolint:errcheck // just debug output
+				fmt.Fprintf(stderr, "Fetching page %d\n", page) //nolint:errcheck // just debug output
 			}
 		}
 	}
 
-	// print objects
-	showKind := c.ShowKind || gks.Len() > 1 || len(categoryNames)+len(gkNames) > 1
-	humanReadableOutput := (c.OutputFormat == "" && c.Template == "") || c.OutputFormat == "wide"
-	if humanReadableOutput {
-		return c.humanReadablePrintObjects(kongCtx, infos, showKind, notFound)
-	}
-	return c.printGeneric(kongCtx, infos)
+	return res, nil
 }
 
-func (c *cmd) humanReadablePrintObjects(kongCtx *kong.Context, infos []*cliresource.Info, printWithKind bool, notFound NotFound) error { //nolint:gocognit // mostly taken from kubectl get. We don't want to divert.
-	objs := make([]kruntime.Object, len(infos))
-	for i, info := range infos {
-		objs[i] = info.Object
-	}
-
-	var positioner get.OriginalPositioner
-	if len(c.SortBy) > 0 {
-		robjs := make([]kruntime.Object, len(objs))
-		copy(robjs, objs)
-		sorter := get.NewRuntimeSorter(robjs, c.SortBy)
-		if err := sorter.Sort(); err != nil {
-			return err
-		}
-		positioner = sorter
+func (c *cmd) humanReadablePrintObjects(out io.Writer, infos []*cliresource.Info, printWithKind bool, notFound NotFound) error {
+	printBatch, finish := c.newHumanReadablePrinter(out, printWithKind, notFound)
+	if err := printBatch(infos); err != nil {
+		return err
 	}
+	return finish()
+}
 
-	trackingWriter := &trackingWriterWrapper{Delegate: kongCtx.Stdout}   // track if we write any output
+// newHumanReadablePrinter returns functions to print objects in tables, in one
+// or more batches as they arrive, and to finish printing. Objects of the same
+// mapping share a table across batches.
+func (c *cmd) newHumanReadablePrinter(out io.Writer, printWithKind bool, notFound NotFound) (printBatch func(infos []*cliresource.Info) error, finish func() error) { //nolint:gocognit // mostly taken from kubectl get. We don't want to divert.
+	trackingWriter := &trackingWriterWrapper{Delegate: out}              // track if we write any output
 	separatorWriter := &separatorWriterWrapper{Delegate: trackingWriter} // output an empty line separating output
 
 	w := printers.GetNewTabWriter(separatorWriter)
@@ -286,61 +428,88 @@ func (c *cmd) humanReadablePrintObjects(kongCtx *kong.Context, infos []*cliresou
 		printer     printers.ResourcePrinter
 		lastMapping *meta.RESTMapping
 	)
-	for ix := range objs {
-		var mapping *meta.RESTMapping
-		var info *cliresource.Info
-		if positioner != nil {
-			info = infos[positioner.OriginalPosition(ix)]
-			mapping = info.Mapping
-		} else {
-			info = infos[ix]
-			mapping = info.Mapping
+
+	printBatch = func(infos []*cliresource.Info) error {
+		objs := make([]kruntime.Object, len(infos))
+		for i, info := range infos {
+			objs[i] = info.Object
 		}
 
-		if shouldGetNewPrinterForMapping(printer, lastMapping, mapping) {
-			if err := w.Flush(); err != nil {
+		var positioner get.OriginalPositioner
+		if len(c.SortBy) > 0 {
+			robjs := make([]kruntime.Object, len(objs))
+			copy(robjs, objs)
+			sorter := get.NewRuntimeSorter(robjs, c.SortBy)
+			if err := sorter.Sort(); err != nil {
 				return err
 			}
-			w.SetRememberedWidths(nil)
-
-			// add linebreaks between resource groups (if there is more than one)
-			// when it satisfies all following 3 conditions:
-			// 1) it's not the first resource group
-			// 2) it has row header
-			// 3) we've written output since the last time we started a new set of headers
-			if lastMapping != nil && !c.NoHeaders && trackingWriter.Written > 0 {
-				separatorWriter.SetReady(true)
+			positioner = sorter
+		}
+
+		for ix := range objs {
+			var mapping *meta.RESTMapping
+			var info *cliresource.Info
+			if positioner != nil {
+				info = infos[positioner.OriginalPosition(ix)]
+				mapping = info.Mapping
+			} else {
+				info = infos[ix]
+				mapping = info.Mapping
 			}
 
-			var err error
-			printer, err = c.createPrinter(mapping, false, printWithKind)
-			if err != nil {
-				if !errs.Has(err.Error()) {
-					errs.Insert(err.Error())
-					allErrs = append(allErrs, err)
+			if shouldGetNewPrinterForMapping(printer, lastMapping, mapping) {
+				if err := w.Flush(); err != nil {
+					return err
+				}
+				w.SetRememberedWidths(nil)
+
+				// add linebreaks between resource groups (if there is more than one)
+				// when it satisfies all following 3 conditions:
+				// 1) it's not the first resource group
+				// 2) it has row header
+				// 3) we've written output since the last time we started a new set of headers
+				if lastMapping != nil && !c.NoHeaders && trackingWriter.Written > 0 {
+					separatorWriter.SetReady(true)
+				}
+
+				var err error
+				printer, err = c.createPrinter(mapping, false, printWithKind)
+				if err != nil {
+					if !errs.Has(err.Error()) {
+						errs.Insert(err.Error())
+						allErrs = append(allErrs, err)
+					}
+					continue
 				}
-				continue
+
+				lastMapping = mapping
 			}
 
-			lastMapping = mapping
+			if err := printer.PrintObj(info.Object, w); err != nil {
+				return err
+			}
 		}
 
-		if err := printer.PrintObj(info.Object, w); err != nil {
-			return err
-		}
-	}
-	if err := w.Flush(); err != nil {
-		return err
+		// flush every batch, so that output appears as it arrives
+		return w.Flush()
 	}
 
-	if trackingWriter.Written == 0 && len(allErrs) == 0 {
-		if err := notFound.PrintMessage(); err != nil {
-			return err
+	finish = func() error {
+		if trackingWriter.Written == 0 && len(allErrs) == 0 {
+			if err := notFound.PrintMessage(); err != nil {
+				return err
+			}
 		}
+		return kerrors.NewAggregate(allErrs)
 	}
-	return kerrors.NewAggregate(allErrs)
+
+	return printBatch, finish
 }
 
+// controlPlaneAnnotation is set on each object printed as a list, to record
+// the control plane it was queried from as <group>/<name>.
+const controlPlaneAnnotation = "cli.upbound.io/control-plane"
+
 func (c *cmd) printGeneric(kongCtx *kong.Context, infos []*cliresource.Info) error { //nolint:gocyclo // mostly taken from kubectl get. We don't want to divert.
 	// we flattened the data from the builder, so we have individual items, but now we'd like to either:
 	// 1. if there is more than one item, combine them all into a single list
@@ -351,6 +520,17 @@ func (c *cmd) printGeneric(kongCtx *kong.Context, infos []*cliresource.Info) err
 		return err
 	}
 
+	// JSON and YAML output is always a list, even of a single item, so that
+	// consumers can tell which control plane each item came from.
+	switch strings.TrimPrefix(c.OutputFormat, "=") {
+	case "json", "yaml", "kyaml":
+		list, err := newControlPlaneList(infos)
+		if err != nil {
+			return err
+		}
+		return printer.PrintObj(list, kongCtx.Stdout)
+	}
+
 	var obj kruntime.Object
 	var errs []error
 	if len(infos) != 1 {
@@ -421,6 +601,35 @@ func (c *cmd) printGeneric(kongCtx *kong.Context, infos []*cliresource.Info) err
 	return kerrors.Reduce(kerrors.Flatten(kerrors.NewAggregate(errs)))
 }
 
+// newControlPlaneList returns a List of the objects in infos. Each item is
+// annotated with the control plane it was queried from.
+func newControlPlaneList(infos []*cliresource.Info) (*unstructured.UnstructuredList, error) {
+	list := &unstructured.UnstructuredList{
+		Object: map[string]any{
+			"kind":       "List",
+			"apiVersion": "v1",
+			"metadata":   map[string]any{},
+		},
+	}
+	for _, info := range infos {
+		u, ok := info.Object.(*unstructured.Unstructured)
+		if !ok {
+			return nil, errors.Errorf("unexpected object of type %T", info.Object)
+		}
+		u = u.DeepCopy()
+		if info.Source != "" {
+			annotations := u.GetAnnotations()
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			annotations[controlPlaneAnnotation] = info.Source
+			u.SetAnnotations(annotations)
+		}
+		list.Items = append(list.Items, *u)
+	}
+	return list, nil
+}
+
 func (c *cmd) createPrinter(mapping *meta.RESTMapping, withNamespace bool, withKind bool) (printers.ResourcePrinterFunc, error) { //nolint:gocyclo // mostly taken from kubectl get. We don't want to divert.
 	// make a new copy of current flags / opts before mutating
 	printFlags := c.printFlags.Copy()
@@ -4,12 +4,27 @@
 package query
 
 import (
+	"context"
+	"io"
 	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	cliresource "k8s.io/cli-runtime/pkg/resource"
 	"k8s.io/kubectl/pkg/cmd/get"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+	"github.com/upbound/up/cmd/up/query/resource"
+	"github.com/upbound/up/internal/upterm"
 )
 
 func TestAllowedFormats(t *testing.T) {
@@ -40,3 +55,144 @@ func extractFormatsFromHelpTag() []string {
 	formats := helpTag[i+len(prefix):]
 	return strings.Split(formats, ",")
 }
+
+func TestNewControlPlaneList(t *testing.T) {
+	bucket := func(name string, annotations map[string]any) *unstructured.Unstructured {
+		metadata := map[string]any{"name": name}
+		if annotations != nil {
+			metadata["annotations"] = annotations
+		}
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "s3.aws.upbound.io/v1beta1",
+			"kind":       "Bucket",
+			"metadata":   metadata,
+		}}
+	}
+
+	infos := []*cliresource.Info{
+		{Source: "default/ctp1", Object: bucket("a", nil)},
+		{Source: "team/ctp2", Object: bucket("b", map[string]any{"crossplane.io/external-name": "b"})},
+	}
+
+	got, err := newControlPlaneList(infos)
+	if err != nil {
+		t.Fatalf("newControlPlaneList(...): unexpected error: %v", err)
+	}
+
+	want := &unstructured.UnstructuredList{
+		Object: map[string]any{
+			"kind":       "List",
+			"apiVersion": "v1",
+			"metadata":   map[string]any{},
+		},
+		Items: []unstructured.Unstructured{
+			*bucket("a", map[string]any{controlPlaneAnnotation: "default/ctp1"}),
+			*bucket("b", map[string]any{"crossplane.io/external-name": "b", controlPlaneAnnotation: "team/ctp2"}),
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("newControlPlaneList(...): -want, +got:\n%s", diff)
+	}
+
+	// The queried objects must not be modified.
+	if _, ok := infos[0].Object.(*unstructured.Unstructured).GetAnnotations()[controlPlaneAnnotation]; ok {
+		t.Errorf("newControlPlaneList(...): annotated the original object")
+	}
+}
+
+func TestCollectLimit(t *testing.T) {
+	object := func(ctp, name string) queryv1alpha2.QueryResponseObject {
+		return queryv1alpha2.QueryResponseObject{
+			ControlPlane: &queryv1alpha2.QueryResponseControlPlane{Namespace: "default", Name: ctp},
+			Object: &common.JSONObject{Object: map[string]any{
+				"apiVersion": "example.org/v1",
+				"kind":       "Widget",
+				"metadata":   map[string]any{"name": name},
+			}},
+		}
+	}
+
+	tests := map[string]struct {
+		reason        string
+		queryTemplate resource.QueryObject
+		objects       []queryv1alpha2.QueryResponseObject
+		next          string
+		wantNames     []string
+		wantPageSize  int
+		wantTruncated []string
+		wantNext      string
+	}{
+		"PerControlPlane": {
+			reason:        "Across control planes, the limit should apply to each control plane.",
+			queryTemplate: &resource.SpaceQuery{},
+			objects:       []queryv1alpha2.QueryResponseObject{object("ctp1", "a"), object("ctp1", "b"), object("ctp2", "c")},
+			wantNames:     []string{"a", "c"},
+			wantPageSize:  500,
+			wantTruncated: []string{"default/ctp1"},
+		},
+		"SingleControlPlane": {
+			reason:        "In a single control plane, the limit should bound the page size and return a cursor to continue from.",
+			queryTemplate: &resource.Query{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ctp1"}},
+			objects:       []queryv1alpha2.QueryResponseObject{object("ctp1", "a")},
+			next:          "cursor",
+			wantNames:     []string{"a"},
+			wantPageSize:  1,
+			wantTruncated: []string{""},
+			wantNext:      "cursor",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			sel, err := parseSelectors("", "")
+			if err != nil {
+				t.Fatalf("parseSelectors(...): unexpected error: %v", err)
+			}
+			c := &cmd{selectors: sel, Limit: 1}
+
+			var pageSizes []int
+			kc := fake.NewClientBuilder().
+				WithScheme(queryScheme).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+						q := obj.(resource.QueryObject) //nolint:forcetypeassert // Only queries are created.
+						pageSizes = append(pageSizes, q.GetSpec().Limit)
+						q.SetResponse(&queryv1alpha2.QueryResponse{
+							QueryResponseObjects: queryv1alpha2.QueryResponseObjects{
+								Cursor:  &queryv1alpha2.QueryResponseCursor{Next: tc.next},
+								Objects: tc.objects,
+							},
+						})
+						return nil
+					},
+				}).
+				Build()
+
+			var names []string
+			emit := func(page []*cliresource.Info) error {
+				for _, info := range page {
+					names = append(names, info.Name)
+				}
+				return nil
+			}
+			spec := createQuerySpec(types.NamespacedName{}, metav1.GroupKind{Kind: "Widget"}, nil, "json", "")
+			res, err := c.collect(t.Context(), io.Discard, kc, tc.queryTemplate, []*queryv1alpha2.QuerySpec{spec}, upterm.NewTestPrinter(), emit)
+			if err != nil {
+				t.Fatalf("%s\ncollect(...): unexpected error: %v", tc.reason, err)
+			}
+
+			if diff := cmp.Diff(tc.wantNames, names); diff != "" {
+				t.Errorf("%s\ncollect(...): names -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff([]int{tc.wantPageSize}, pageSizes); diff != "" {
+				t.Errorf("%s\ncollect(...): page sizes -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.wantTruncated, sets.List(res.truncated)); diff != "" {
+				t.Errorf("%s\ncollect(...): truncated -want, +got:\n%s", tc.reason, diff)
+			}
+			if res.next != tc.wantNext {
+				t.Errorf("%s\ncollect(...): got next cursor %q, want %q", tc.reason, res.next, tc.wantNext)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package query
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+// querySelectors holds the label and field selectors of a query. Requirements
+// the Query API can filter on are sent to the server. All requirements are
+// also checked client-side, which is cheap and covers those the server can't
+// filter on.
+type querySelectors struct {
+	labels labels.Selector
+	fields fields.Selector
+
+	// serverLabels, serverName and serverNamespace are sent to the server.
+	serverLabels    map[string]string
+	serverName      string
+	serverNamespace string
+
+	// clientOnly are the requirements only filtered client-side.
+	clientOnly []string
+}
+
+// parseSelectors parses label and field selectors in the kubectl syntax.
+func parseSelectors(labelSelector, fieldSelector string) (*querySelectors, error) {
+	s := &querySelectors{
+		labels: labels.Everything(),
+		fields: fields.Everything(),
+	}
+
+	if labelSelector != "" {
+		sel, err := labels.Parse(labelSelector)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid label selector")
+		}
+		s.labels = sel
+
+		reqs, _ := sel.Requirements()
+		for _, r := range reqs {
+			if isEquality(r.Operator()) && r.Values().Len() == 1 {
+				if s.serverLabels == nil {
+					s.serverLabels = map[string]string{}
+				}
+				s.serverLabels[r.Key()] = r.Values().UnsortedList()[0]
+				continue
+			}
+			s.clientOnly = append(s.clientOnly, r.String())
+		}
+	}
+
+	if fieldSelector != "" {
+		sel, err := fields.ParseSelector(fieldSelector)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid field selector")
+		}
+		s.fields = sel
+
+		for _, r := range sel.Requirements() {
+			switch {
+			case isEquality(r.Operator) && r.Field == "metadata.name" && s.serverName == "":
+				s.serverName = r.Value
+			case isEquality(r.Operator) && r.Field == "metadata.namespace" && s.serverNamespace == "":
+				s.serverNamespace = r.Value
+			default:
+				s.clientOnly = append(s.clientOnly, fmt.Sprintf("%s%s%s", r.Field, r.Operator, r.Value))
+			}
+		}
+	}
+
+	return s, nil
+}
+
+func isEquality(op selection.Operator) bool {
+	return op == selection.Equals || op == selection.DoubleEquals
+}
+
+// apply adds the requirements the server can filter on to the query filter.
+// Names and namespaces already set on the filter take precedence; the
+// client-side check still applies the selector's.
+func (s *querySelectors) apply(f *queryv1alpha2.QueryFilter) {
+	if len(s.serverLabels) > 0 {
+		f.Labels = s.serverLabels
+	}
+	if f.Name == "" {
+		f.Name = s.serverName
+	}
+	if f.Namespace == "" {
+		f.Namespace = s.serverNamespace
+	}
+}
+
+// warning returns a warning naming the requirements that are only filtered
+// client-side, or an empty string if there are none.
+func (s *querySelectors) warning() string {
+	if len(s.clientOnly) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("the Query API cannot filter on %s; filtering client-side", strings.Join(s.clientOnly, ", "))
+}
+
+// matches returns true if the object matches the label and field selectors.
+// Fields that are missing from the object have an empty value.
+func (s *querySelectors) matches(u *unstructured.Unstructured) bool {
+	if !s.labels.Matches(labels.Set(u.GetLabels())) {
+		return false
+	}
+	if s.fields.Empty() {
+		return true
+	}
+
+	set := fields.Set{}
+	p := fieldpath.Pave(u.Object)
+	for _, r := range s.fields.Requirements() {
+		v, err := p.GetValue(r.Field)
+		if err != nil || v == nil {
+			set[r.Field] = ""
+			continue
+		}
+		set[r.Field] = fmt.Sprint(v)
+	}
+	return s.fields.Matches(set)
+}
+
+// filterRows returns the table rows whose objects match the selectors. Rows
+// without an object to check are kept.
+func (s *querySelectors) filterRows(rows []metav1.TableRow) []metav1.TableRow {
+	out := rows[:0]
+	for _, r := range rows {
+		if u, ok := r.Object.Object.(*unstructured.Unstructured); ok && !s.matches(u) {
+			continue
+		}
+		out = append(out, r)
+	}
+	return out
+}
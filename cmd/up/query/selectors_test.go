@@ -0,0 +1,171 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package query
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	cliresource "k8s.io/cli-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+	"github.com/upbound/up/cmd/up/query/resource"
+	"github.com/upbound/up/internal/upterm"
+)
+
+func TestParseSelectors(t *testing.T) {
+	tests := map[string]struct {
+		reason         string
+		labelSelector  string
+		fieldSelector  string
+		wantLabels     map[string]string
+		wantName       string
+		wantNamespace  string
+		wantClientOnly []string
+		wantErr        bool
+	}{
+		"Empty": {
+			reason: "No selectors should filter nothing.",
+		},
+		"EqualityLabels": {
+			reason:        "Equality label requirements should be sent to the server.",
+			labelSelector: "app=web,tier==frontend",
+			wantLabels:    map[string]string{"app": "web", "tier": "frontend"},
+		},
+		"SetBasedLabels": {
+			reason:         "Set-based label requirements should be filtered client-side.",
+			labelSelector:  "app=web,tier in (frontend,backend),!legacy",
+			wantLabels:     map[string]string{"app": "web"},
+			wantClientOnly: []string{"!legacy", "tier in (backend,frontend)"},
+		},
+		"MetadataFields": {
+			reason:        "Equality requirements on name and namespace should be sent to the server.",
+			fieldSelector: "metadata.name=foo,metadata.namespace=bar",
+			wantName:      "foo",
+			wantNamespace: "bar",
+		},
+		"OtherFields": {
+			reason:         "Other field requirements should be filtered client-side.",
+			fieldSelector:  "metadata.name!=foo,status.phase=Running",
+			wantClientOnly: []string{"metadata.name!=foo", "status.phase=Running"},
+		},
+		"InvalidLabelSelector": {
+			reason:        "An invalid label selector should return an error.",
+			labelSelector: "app in (",
+			wantErr:       true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseSelectors(tc.labelSelector, tc.fieldSelector)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("%s\nparseSelectors(...): expected an error", tc.reason)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("%s\nparseSelectors(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.wantLabels, got.serverLabels); diff != "" {
+				t.Errorf("%s\nparseSelectors(...): server labels -want, +got:\n%s", tc.reason, diff)
+			}
+			if got.serverName != tc.wantName || got.serverNamespace != tc.wantNamespace {
+				t.Errorf("%s\nparseSelectors(...): got name %q and namespace %q, want %q and %q", tc.reason, got.serverName, got.serverNamespace, tc.wantName, tc.wantNamespace)
+			}
+			if diff := cmp.Diff(tc.wantClientOnly, got.clientOnly); diff != "" {
+				t.Errorf("%s\nparseSelectors(...): client-only -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCollectSelectors(t *testing.T) {
+	sel, err := parseSelectors("app=web,tier!=backend", "metadata.name=web-1,status.phase=Running")
+	if err != nil {
+		t.Fatalf("parseSelectors(...): unexpected error: %v", err)
+	}
+	c := &cmd{selectors: sel}
+
+	object := func(name, tier, phase string) queryv1alpha2.QueryResponseObject {
+		return queryv1alpha2.QueryResponseObject{
+			ControlPlane: &queryv1alpha2.QueryResponseControlPlane{Namespace: "default", Name: "ctp"},
+			Object: &common.JSONObject{Object: map[string]any{
+				"apiVersion": "example.org/v1",
+				"kind":       "Widget",
+				"metadata": map[string]any{
+					"name":   name,
+					"labels": map[string]any{"app": "web", "tier": tier},
+				},
+				"status": map[string]any{"phase": phase},
+			}},
+		}
+	}
+
+	var sent []queryv1alpha2.QueryFilter
+	kc := fake.NewClientBuilder().
+		WithScheme(queryScheme).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+				q := obj.(resource.QueryObject) //nolint:forcetypeassert // Only queries are created.
+				sent = append(sent, q.GetSpec().Filter.Objects...)
+				// The server only filters on the forwarded requirements, so it
+				// returns objects that the client-side requirements exclude.
+				q.SetResponse(&queryv1alpha2.QueryResponse{
+					QueryResponseObjects: queryv1alpha2.QueryResponseObjects{
+						Cursor: &queryv1alpha2.QueryResponseCursor{},
+						Objects: []queryv1alpha2.QueryResponseObject{
+							object("web-1", "frontend", "Running"),
+							object("web-1", "backend", "Running"),
+							object("web-1", "frontend", "Pending"),
+						},
+					},
+				})
+				return nil
+			},
+		}).
+		Build()
+
+	spec := createQuerySpec(types.NamespacedName{Namespace: "prod"}, metav1.GroupKind{Kind: "Widget"}, nil, "json", "")
+	for i := range spec.Filter.Objects {
+		c.selectors.apply(&spec.Filter.Objects[i])
+	}
+
+	var infos []*cliresource.Info
+	emit := func(page []*cliresource.Info) error {
+		infos = append(infos, page...)
+		return nil
+	}
+	if _, err := c.collect(t.Context(), io.Discard, kc, &resource.SpaceQuery{}, []*queryv1alpha2.QuerySpec{spec}, upterm.NewTestPrinter(), emit); err != nil {
+		t.Fatalf("collect(...): unexpected error: %v", err)
+	}
+
+	wantSent := []queryv1alpha2.QueryFilter{{
+		GroupKind: queryv1alpha2.QueryGroupKind{Kind: "Widget"},
+		Namespace: "prod",
+		Name:      "web-1",
+		Labels:    map[string]string{"app": "web"},
+	}}
+	if diff := cmp.Diff(wantSent, sent); diff != "" {
+		t.Errorf("collect(...): sent filters -want, +got:\n%s", diff)
+	}
+
+	if len(infos) != 1 {
+		t.Fatalf("collect(...): expected 1 object after client-side filtering, got %d", len(infos))
+	}
+	u := infos[0].Object.(*unstructured.Unstructured) //nolint:forcetypeassert // Objects are always unstructured.
+	if tier := u.GetLabels()["tier"]; tier != "frontend" {
+		t.Errorf("collect(...): expected the frontend object, got tier %q", tier)
+	}
+}
@@ -5,33 +5,125 @@ package repository
 
 import (
 	"context"
+	"os"
 
+	"sigs.k8s.io/yaml"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	sdkerrs "github.com/upbound/up-sdk-go/errors"
 	"github.com/upbound/up-sdk-go/service/repositories"
 	"github.com/upbound/up/internal/upbound"
 	"github.com/upbound/up/internal/upterm"
 )
 
+const (
+	visibilityPublic  = "public"
+	visibilityPrivate = "private"
+
+	publishPolicyDraft   = "draft"
+	publishPolicyPublish = "publish"
+)
+
+// repositoryTemplate holds the repository settings read from a template file.
+// Unset settings fall back to the defaults of public visibility and no
+// Marketplace listing.
+type repositoryTemplate struct {
+	Visibility    string `json:"visibility,omitempty"`
+	PublishPolicy string `json:"publishPolicy,omitempty"`
+}
+
+// readTemplate reads and validates a repository template file.
+func readTemplate(path string) (*repositoryTemplate, error) {
+	b, err := os.ReadFile(path) //nolint:gosec // Reading a user-provided file is intended.
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read repository template")
+	}
+	t := &repositoryTemplate{}
+	if err := yaml.UnmarshalStrict(b, t); err != nil {
+		return nil, errors.Wrap(err, "cannot parse repository template")
+	}
+	switch t.Visibility {
+	case "", visibilityPublic, visibilityPrivate:
+	default:
+		return nil, errors.Errorf("invalid visibility %q in repository template: must be %q or %q", t.Visibility, visibilityPublic, visibilityPrivate)
+	}
+	switch t.PublishPolicy {
+	case "", publishPolicyDraft, publishPolicyPublish:
+	default:
+		return nil, errors.Errorf("invalid publishPolicy %q in repository template: must be %q or %q", t.PublishPolicy, publishPolicyDraft, publishPolicyPublish)
+	}
+	return t, nil
+}
+
 // createCmd creates a repository on Upbound.
 type createCmd struct {
 	Name string `arg:"" help:"Name of repository." required:""`
 
-	Private bool `help:"Make the new repository private."`
-	Publish bool `help:"Enable Upbound Marketplace listing page for the new repository."`
+	Public  bool `help:"Make the new repository public. This is the default."                       xor:"visibility"`
+	Private bool `help:"Make the new repository private."                                           xor:"visibility"`
+	Publish bool `help:"Enable Upbound Marketplace listing page for the new repository."            xor:"publish"`
+	Draft   bool `help:"Don't list the new repository on Upbound Marketplace. This is the default." xor:"publish"`
+
+	Template     string `help:"YAML file with default settings for the repository. Supported settings are 'visibility' (public or private) and 'publishPolicy' (draft or publish). Flags take precedence." type:"existingfile"`
+	SkipExisting bool   `help:"Don't modify the repository if it already exists."`
 }
 
-// Run executes the create command.
-func (c *createCmd) Run(ctx context.Context, p upterm.Printer, rc *repositories.Client, upCtx *upbound.Context) error {
+// options returns the request options for the new repository. Settings from
+// the template are applied first, then any set by flags.
+func (c *createCmd) options(t *repositoryTemplate) []repositories.CreateOrUpdateOption {
 	// Defaults are public visibility and no indexing (publishing).
 	// The server does handle unset fields, but since this is a PUT endpoint we'll explicitly set every field in the request.
 	visibility := repositories.WithPublic()
 	publishPolicy := repositories.WithDraft()
-	if c.Private {
+
+	if t != nil {
+		if t.Visibility == visibilityPrivate {
+			visibility = repositories.WithPrivate()
+		}
+		if t.PublishPolicy == publishPolicyPublish {
+			publishPolicy = repositories.WithPublish()
+		}
+	}
+
+	switch {
+	case c.Public:
+		visibility = repositories.WithPublic()
+	case c.Private:
 		visibility = repositories.WithPrivate()
 	}
-	if c.Publish {
+	switch {
+	case c.Draft:
+		publishPolicy = repositories.WithDraft()
+	case c.Publish:
 		publishPolicy = repositories.WithPublish()
 	}
-	if err := rc.CreateOrUpdateWithOptions(ctx, upCtx.Organization, c.Name, visibility, publishPolicy); err != nil {
+
+	return []repositories.CreateOrUpdateOption{visibility, publishPolicy}
+}
+
+// Run executes the create command.
+func (c *createCmd) Run(ctx context.Context, p upterm.Printer, rc *repositories.Client, upCtx *upbound.Context) error {
+	var t *repositoryTemplate
+	if c.Template != "" {
+		var err error
+		if t, err = readTemplate(c.Template); err != nil {
+			return err
+		}
+	}
+
+	if c.SkipExisting {
+		_, err := rc.Get(ctx, upCtx.Organization, c.Name)
+		switch {
+		case err == nil:
+			p.Printfln("%s/%s already exists, skipping", upCtx.Organization, c.Name)
+			return nil
+		case !sdkerrs.IsNotFound(err):
+			return errors.Wrap(err, "cannot check whether the repository exists")
+		}
+	}
+
+	if err := rc.CreateOrUpdateWithOptions(ctx, upCtx.Organization, c.Name, c.options(t)...); err != nil {
 		return err
 	}
 	p.Printfln("%s/%s created", upCtx.Organization, c.Name)
@@ -0,0 +1,161 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package repository
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/upbound/up-sdk-go"
+	uerrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/fake"
+	"github.com/upbound/up-sdk-go/service/repositories"
+	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/upterm"
+)
+
+func TestCreateCommand(t *testing.T) {
+	type args struct {
+		cmd      *createCmd
+		template string
+		getErr   error
+	}
+	type want struct {
+		body *repositories.RepositoryCreateOrUpdateRequest
+		err  bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Defaults": {
+			reason: "Without flags or a template the repository should be public and not published.",
+			args: args{
+				cmd: &createCmd{Name: "repo"},
+			},
+			want: want{
+				body: &repositories.RepositoryCreateOrUpdateRequest{Public: true, Publish: false},
+			},
+		},
+		"Flags": {
+			reason: "Flags should set the visibility and publish policy.",
+			args: args{
+				cmd: &createCmd{Name: "repo", Private: true, Publish: true},
+			},
+			want: want{
+				body: &repositories.RepositoryCreateOrUpdateRequest{Public: false, Publish: true},
+			},
+		},
+		"Template": {
+			reason: "Settings from the template should be applied.",
+			args: args{
+				cmd:      &createCmd{Name: "repo"},
+				template: "visibility: private\npublishPolicy: publish\n",
+			},
+			want: want{
+				body: &repositories.RepositoryCreateOrUpdateRequest{Public: false, Publish: true},
+			},
+		},
+		"FlagsOverrideTemplate": {
+			reason: "Flags should take precedence over the template.",
+			args: args{
+				cmd:      &createCmd{Name: "repo", Public: true, Draft: true},
+				template: "visibility: private\npublishPolicy: publish\n",
+			},
+			want: want{
+				body: &repositories.RepositoryCreateOrUpdateRequest{Public: true, Publish: false},
+			},
+		},
+		"InvalidTemplate": {
+			reason: "A template with an invalid setting should return an error.",
+			args: args{
+				cmd:      &createCmd{Name: "repo"},
+				template: "visibility: internal\n",
+			},
+			want: want{
+				err: true,
+			},
+		},
+		"UnknownTemplateSetting": {
+			reason: "A template with an unsupported setting should return an error.",
+			args: args{
+				cmd:      &createCmd{Name: "repo"},
+				template: "description: my repository\n",
+			},
+			want: want{
+				err: true,
+			},
+		},
+		"SkipExisting": {
+			reason: "An existing repository should not be modified with --skip-existing.",
+			args: args{
+				cmd: &createCmd{Name: "repo", Private: true, SkipExisting: true},
+			},
+		},
+		"SkipExistingNotFound": {
+			reason: "A missing repository should be created with --skip-existing.",
+			args: args{
+				cmd:    &createCmd{Name: "repo", Private: true, SkipExisting: true},
+				getErr: &uerrors.Error{Status: http.StatusNotFound},
+			},
+			want: want{
+				body: &repositories.RepositoryCreateOrUpdateRequest{Public: false, Publish: false},
+			},
+		},
+		"SkipExistingGetFailed": {
+			reason: "Failing to check whether the repository exists should return an error.",
+			args: args{
+				cmd:    &createCmd{Name: "repo", SkipExisting: true},
+				getErr: &uerrors.Error{Status: http.StatusInternalServerError},
+			},
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if tc.args.template != "" {
+				tc.args.cmd.Template = filepath.Join(t.TempDir(), "template.yaml")
+				if err := os.WriteFile(tc.args.cmd.Template, []byte(tc.args.template), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var body *repositories.RepositoryCreateOrUpdateRequest
+			rc := repositories.NewClient(&up.Config{
+				Client: &fake.MockClient{
+					MockNewRequest: func(ctx context.Context, method, _, _ string, b interface{}) (*http.Request, error) {
+						if method == http.MethodPut {
+							body = b.(*repositories.RepositoryCreateOrUpdateRequest) //nolint:forcetypeassert // Only creates have a body.
+						}
+						return http.NewRequestWithContext(ctx, method, "https://api.upbound.io", nil)
+					},
+					MockDo: func(req *http.Request, _ interface{}) error {
+						if req.Method == http.MethodGet {
+							return tc.args.getErr
+						}
+						return nil
+					},
+				},
+			})
+
+			err := tc.args.cmd.Run(context.Background(), upterm.NewTestPrinter(), rc, &upbound.Context{Organization: "org"})
+			if (err != nil) != tc.want.err {
+				t.Fatalf("\n%s\nRun(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.body, body); diff != "" {
+				t.Errorf("\n%s\nRun(...): -want body, +got body:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package repository
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/upbound/up-sdk-go/service/repositories"
+	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/upterm"
+
+	_ "embed"
+)
+
+//go:embed describe.tmpl
+var describeTemplate string
+
+// describeCmd describes a repository and its tags.
+type describeCmd struct {
+	Name string `arg:"" help:"Name of repository." predictor:"repos" required:""`
+}
+
+// repositoryDescription is the description of a repository printed by
+// describeCmd.
+type repositoryDescription struct {
+	Name           string          `json:"name"`
+	Type           string          `json:"type"`
+	Visibility     string          `json:"visibility"`
+	PublishPolicy  string          `json:"publishPolicy"`
+	CurrentVersion string          `json:"currentVersion,omitempty"`
+	Created        time.Time       `json:"created"`
+	Updated        *time.Time      `json:"updated,omitempty"`
+	Artifacts      int             `json:"artifacts"`
+	LastPushed     *time.Time      `json:"lastPushed,omitempty"`
+	Tags           []repositoryTag `json:"tags"`
+}
+
+// repositoryTag is a tag pushed to a repository.
+type repositoryTag struct {
+	Tag    string    `json:"tag"`
+	Status string    `json:"status"`
+	Digest string    `json:"digest"`
+	Pushed time.Time `json:"pushed"`
+}
+
+// Run executes the describe command.
+func (c *describeCmd) Run(ctx context.Context, printer upterm.Printer, rc *repositories.Client, upCtx *upbound.Context) error {
+	repo, err := rc.Get(ctx, upCtx.Organization, c.Name)
+	if err != nil {
+		return err
+	}
+	return printer.PrintObjectTemplate(describe(repo), describeTemplate)
+}
+
+// describe describes a repository, with its most recently pushed tags first.
+func describe(resp *repositories.RepositoryResponse) *repositoryDescription {
+	s := newRepositoryStats(resp)
+	d := &repositoryDescription{
+		Name:          resp.Name,
+		Type:          repositoryType(resp.Repository),
+		Visibility:    visibility(resp.Repository),
+		PublishPolicy: publishPolicy(resp.Repository),
+		Created:       resp.CreatedAt,
+		Updated:       resp.UpdatedAt,
+		Artifacts:     s.Artifacts,
+		LastPushed:    s.LastPushed,
+		Tags:          make([]repositoryTag, 0, len(resp.Versions)),
+	}
+	if resp.CurrentVersion != nil {
+		d.CurrentVersion = *resp.CurrentVersion
+	}
+	for _, v := range resp.Versions {
+		d.Tags = append(d.Tags, repositoryTag{
+			Tag:    v.Version,
+			Status: string(v.Status),
+			Digest: v.Digest,
+			Pushed: v.CreatedAt,
+		})
+	}
+	sort.SliceStable(d.Tags, func(i, j int) bool {
+		return d.Tags[i].Pushed.After(d.Tags[j].Pushed)
+	})
+	return d
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/upbound/up-sdk-go/service/repositories"
+)
+
+func TestDescribe(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	older := created.Add(time.Hour)
+	newer := created.Add(2 * time.Hour)
+
+	cases := map[string]struct {
+		reason string
+		resp   *repositories.RepositoryResponse
+		want   *repositoryDescription
+	}{
+		"Tags": {
+			reason: "A repository should be described with its most recently pushed tags first.",
+			resp: &repositories.RepositoryResponse{
+				Repository: repositories.Repository{
+					Name:           "provider-aws",
+					Type:           ptr.To(repositories.RepositoryTypeProvider),
+					Public:         true,
+					CurrentVersion: ptr.To("v1.1.0"),
+					CreatedAt:      created,
+					Publish:        ptr.To(repositories.PublishPolicy(publishPolicyPublish)),
+				},
+				Versions: []repositories.Package{
+					{Version: "v1.0.0", Status: repositories.PackageStatusPublished, Digest: "sha256:old", CreatedAt: older},
+					{Version: "v1.1.0", Status: repositories.PackageStatusAccepted, Digest: "sha256:new", CreatedAt: newer},
+				},
+			},
+			want: &repositoryDescription{
+				Name:           "provider-aws",
+				Type:           "provider",
+				Visibility:     visibilityPublic,
+				PublishPolicy:  publishPolicyPublish,
+				CurrentVersion: "v1.1.0",
+				Created:        created,
+				Artifacts:      2,
+				LastPushed:     &newer,
+				Tags: []repositoryTag{
+					{Tag: "v1.1.0", Status: "accepted", Digest: "sha256:new", Pushed: newer},
+					{Tag: "v1.0.0", Status: "published", Digest: "sha256:old", Pushed: older},
+				},
+			},
+		},
+		"Empty": {
+			reason: "A repository nothing has been pushed to should be described without tags.",
+			resp: &repositories.RepositoryResponse{
+				Repository: repositories.Repository{
+					Name:      "empty",
+					CreatedAt: created,
+				},
+			},
+			want: &repositoryDescription{
+				Name:          "empty",
+				Type:          "unknown",
+				Visibility:    visibilityPrivate,
+				PublishPolicy: "unknown",
+				Created:       created,
+				Tags:          []repositoryTag{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := describe(tc.resp)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ndescribe(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -24,8 +24,5 @@ func (c *getCmd) Run(ctx context.Context, printer upterm.ResultPrinter, rc *repo
 	}
 
 	// We convert to a list so we can match the output of the list command
-	repoList := repos.RepositoryListResponse{
-		Repositories: []repos.Repository{repo.Repository},
-	}
-	return printer.PrintObject(repoList.Repositories, fieldNames, extractFields)
+	return printer.PrintObject([]repositoryStats{newRepositoryStats(repo)}, fieldNames, extractFields)
 }
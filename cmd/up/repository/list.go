@@ -6,10 +6,14 @@ package repository
 import (
 	"context"
 	"strconv"
+	"strings"
 	"time"
 
+	"golang.org/x/sync/errgroup"
 	"k8s.io/apimachinery/pkg/util/duration"
 
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
 	"github.com/upbound/up-sdk-go/service/common"
 	"github.com/upbound/up-sdk-go/service/repositories"
 	"github.com/upbound/up/internal/upbound"
@@ -18,37 +22,159 @@ import (
 
 const (
 	maxItems = 100
+
+	// maxConcurrentGets is the maximum number of repositories whose artifacts
+	// are fetched at once when listing repositories.
+	maxConcurrentGets = 8
 )
 
 // listCmd lists repositories in an account on Upbound.
-type listCmd struct{}
+type listCmd struct {
+	Filter string `help:"Only list repositories whose name contains this substring."`
+}
 
 //nolint:gochecknoglobals // Would make this a const if we could.
-var fieldNames = []string{"NAME", "TYPE", "PUBLIC", "PUBLISH POLICY", "UPDATED"}
+var fieldNames = []string{"NAME", "TYPE", "VISIBILITY", "PUBLISH POLICY", "ARTIFACTS", "LAST PUSHED", "UPDATED"}
+
+// repositoryStats is a repository along with stats about the artifacts that
+// have been pushed to it.
+type repositoryStats struct {
+	repositories.Repository
+
+	Artifacts  int        `json:"artifacts"`
+	LastPushed *time.Time `json:"lastPushed,omitempty"`
+}
 
 // Run executes the list command.
 func (c *listCmd) Run(ctx context.Context, printer upterm.Printer, rc *repositories.Client, upCtx *upbound.Context) error {
-	rList, err := rc.List(ctx, upCtx.Organization, common.WithSize(maxItems))
+	repos, err := listRepositories(ctx, rc, upCtx.Organization, c.Filter)
 	if err != nil {
 		return err
 	}
-	if len(rList.Repositories) == 0 {
+	if len(repos) == 0 {
+		if c.Filter != "" {
+			printer.Printfln("No repositories matching %q found in %s", c.Filter, upCtx.Organization)
+			return nil
+		}
 		printer.Printfln("No repositories found in %s", upCtx.Organization)
 		return nil
 	}
-	return printer.PrintObject(rList.Repositories, fieldNames, extractFields)
+
+	stats, err := getRepositoryStats(ctx, rc, upCtx.Organization, repos)
+	if err != nil {
+		return err
+	}
+	return printer.PrintObject(stats, fieldNames, extractFields)
 }
 
-func extractFields(obj any) []string {
-	r, _ := obj.(repositories.Repository)
+// listRepositories lists every repository in an account whose name contains
+// the filter, fetching as many pages as needed.
+func listRepositories(ctx context.Context, rc *repositories.Client, account, filter string) ([]repositories.Repository, error) {
+	var (
+		repos []repositories.Repository
+		seen  int
+	)
+	opts := []common.ListOption{common.WithSize(maxItems)}
+	for {
+		resp, err := rc.List(ctx, account, opts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range resp.Repositories {
+			if strings.Contains(r.Name, filter) {
+				repos = append(repos, r)
+			}
+		}
+		seen += len(resp.Repositories)
+
+		// The first page is whichever one the API returns by default, so we
+		// don't need to know whether pages are numbered from zero or one.
+		if len(resp.Repositories) == 0 || seen >= resp.Count {
+			return repos, nil
+		}
+		opts = []common.ListOption{common.WithSize(maxItems), common.WithPage(resp.Page + 1)}
+	}
+}
+
+// getRepositoryStats gets the artifacts of each repository, which aren't
+// included when listing repositories, and returns the repositories with their
+// stats in the same order.
+func getRepositoryStats(ctx context.Context, rc *repositories.Client, account string, repos []repositories.Repository) ([]repositoryStats, error) {
+	stats := make([]repositoryStats, len(repos))
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentGets)
+	for i, r := range repos {
+		g.Go(func() error {
+			resp, err := rc.Get(ctx, account, r.Name)
+			if err != nil {
+				return errors.Wrapf(err, "cannot get repository %s", r.Name)
+			}
+			stats[i] = newRepositoryStats(resp)
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
 
-	rt := "unknown"
-	if r.Type != nil {
-		rt = string(*r.Type)
+// newRepositoryStats returns the stats of a repository.
+func newRepositoryStats(resp *repositories.RepositoryResponse) repositoryStats {
+	s := repositoryStats{
+		Repository: resp.Repository,
+		Artifacts:  len(resp.Versions),
 	}
-	u := "n/a"
-	if r.UpdatedAt != nil {
-		u = duration.HumanDuration(time.Since(*r.UpdatedAt))
+	for _, v := range resp.Versions {
+		if s.LastPushed == nil || v.CreatedAt.After(*s.LastPushed) {
+			s.LastPushed = &v.CreatedAt
+		}
+	}
+	return s
+}
+
+// visibility returns whether a repository is public or private.
+func visibility(r repositories.Repository) string {
+	if r.Public {
+		return visibilityPublic
+	}
+	return visibilityPrivate
+}
+
+// publishPolicy returns the publish policy of a repository.
+func publishPolicy(r repositories.Repository) string {
+	if r.Publish == nil {
+		return "unknown"
+	}
+	return string(*r.Publish)
+}
+
+// repositoryType returns the type of a repository.
+func repositoryType(r repositories.Repository) string {
+	if r.Type == nil {
+		return "unknown"
+	}
+	return string(*r.Type)
+}
+
+// humanSince returns how long ago a time was, or n/a if it isn't set.
+func humanSince(t *time.Time) string {
+	if t == nil {
+		return "n/a"
+	}
+	return duration.HumanDuration(time.Since(*t))
+}
+
+func extractFields(obj any) []string {
+	s, _ := obj.(repositoryStats)
+
+	return []string{
+		s.Name,
+		repositoryType(s.Repository),
+		visibility(s.Repository),
+		publishPolicy(s.Repository),
+		strconv.Itoa(s.Artifacts),
+		humanSince(s.LastPushed),
+		humanSince(s.UpdatedAt),
 	}
-	return []string{r.Name, rt, strconv.FormatBool(r.Public), string(*r.Publish), u}
 }
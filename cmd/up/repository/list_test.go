@@ -0,0 +1,126 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package repository
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/fake"
+	"github.com/upbound/up-sdk-go/service/common"
+	"github.com/upbound/up-sdk-go/service/repositories"
+)
+
+// fakeRepositoriesClient returns a repositories client that serves the given
+// pages of repositories, numbered from one, and the given versions for each
+// repository.
+func fakeRepositoriesClient(pages [][]string, versions map[string][]repositories.Package) *repositories.Client {
+	count := 0
+	for _, p := range pages {
+		count += len(p)
+	}
+	return repositories.NewClient(&up.Config{
+		Client: &fake.MockClient{
+			MockNewRequest: func(ctx context.Context, method, _, urlPath string, _ interface{}) (*http.Request, error) {
+				return http.NewRequestWithContext(ctx, method, "https://api.upbound.io/"+urlPath, nil)
+			},
+			MockDo: func(req *http.Request, obj interface{}) error {
+				switch r := obj.(type) {
+				case *repositories.RepositoryListResponse:
+					page := 1
+					if p := req.URL.Query().Get(common.PageParam); p != "" {
+						page, _ = strconv.Atoi(p)
+					}
+					r.Page, r.Count = page, count
+					if page <= len(pages) {
+						for _, name := range pages[page-1] {
+							r.Repositories = append(r.Repositories, repositories.Repository{Name: name})
+						}
+					}
+				case *repositories.RepositoryResponse:
+					name := path.Base(req.URL.Path)
+					r.Repository = repositories.Repository{Name: name}
+					r.Versions = versions[name]
+				}
+				return nil
+			},
+		},
+	})
+}
+
+func TestListRepositories(t *testing.T) {
+	pages := [][]string{
+		{"configuration-aws", "provider-aws"},
+		{"configuration-gcp", "provider-gcp"},
+		{"configuration-azure"},
+	}
+
+	cases := map[string]struct {
+		reason string
+		filter string
+		want   []string
+	}{
+		"AllPages": {
+			reason: "Repositories from every page should be listed.",
+			want:   []string{"configuration-aws", "provider-aws", "configuration-gcp", "provider-gcp", "configuration-azure"},
+		},
+		"Filter": {
+			reason: "Only repositories whose name contains the filter should be listed.",
+			filter: "configuration",
+			want:   []string{"configuration-aws", "configuration-gcp", "configuration-azure"},
+		},
+		"NoMatches": {
+			reason: "No repositories should be listed if none match the filter.",
+			filter: "function",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			repos, err := listRepositories(t.Context(), fakeRepositoriesClient(pages, nil), "org", tc.filter)
+			if err != nil {
+				t.Fatalf("\n%s\nlistRepositories(...): unexpected error: %v", tc.reason, err)
+			}
+			var got []string
+			for _, r := range repos {
+				got = append(got, r.Name)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nlistRepositories(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGetRepositoryStats(t *testing.T) {
+	older := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(24 * time.Hour)
+
+	rc := fakeRepositoriesClient(nil, map[string][]repositories.Package{
+		"provider-aws": {
+			{Version: "v1.1.0", CreatedAt: newer},
+			{Version: "v1.0.0", CreatedAt: older},
+		},
+	})
+	repos := []repositories.Repository{{Name: "provider-aws"}, {Name: "empty"}}
+
+	got, err := getRepositoryStats(t.Context(), rc, "org", repos)
+	if err != nil {
+		t.Fatalf("getRepositoryStats(...): unexpected error: %v", err)
+	}
+	want := []repositoryStats{
+		{Repository: repositories.Repository{Name: "provider-aws"}, Artifacts: 2, LastPushed: &newer},
+		{Repository: repositories.Repository{Name: "empty"}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("getRepositoryStats(...): -want, +got:\n%s", diff)
+	}
+}
@@ -10,7 +10,6 @@ import (
 	"github.com/alecthomas/kong"
 	"github.com/posener/complete"
 
-	"github.com/upbound/up-sdk-go/service/common"
 	"github.com/upbound/up-sdk-go/service/repositories"
 	"github.com/upbound/up/cmd/up/repository/permission"
 	"github.com/upbound/up/internal/upbound"
@@ -46,17 +45,17 @@ func PredictRepos() complete.Predictor {
 			return nil
 		}
 
-		repos, err := rc.List(context.Background(), upCtx.Organization, common.WithSize(maxItems))
+		repos, err := listRepositories(context.Background(), rc, upCtx.Organization, "")
 		if err != nil {
 			return nil
 		}
 
-		if len(repos.Repositories) == 0 {
+		if len(repos) == 0 {
 			return nil
 		}
 
-		data := make([]string, len(repos.Repositories))
-		for i, o := range repos.Repositories {
+		data := make([]string, len(repos))
+		for i, o := range repos {
 			data[i] = o.Name
 		}
 		return data
@@ -72,5 +71,6 @@ type Cmd struct {
 	Delete     deleteCmd      `cmd:"" help:"Delete a repository."`
 	List       listCmd        `cmd:"" help:"List repositories for the account."`
 	Get        getCmd         `cmd:"" help:"Get a repository for the account."`
+	Describe   describeCmd    `cmd:"" help:"Describe a repository and its tags."`
 	Permission permission.Cmd `cmd:"" help:"Manage permissions of a repository for a team in the account."`
 }
@@ -0,0 +1,264 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package token
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up-sdk-go/service/accounts"
+	"github.com/upbound/up-sdk-go/service/organizations"
+	"github.com/upbound/up-sdk-go/service/robots"
+	"github.com/upbound/up-sdk-go/service/tokens"
+	"github.com/upbound/up/internal/input"
+	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/upterm"
+)
+
+// rotatedToken is a robot token that was replaced by a new one.
+type rotatedToken struct {
+	Robot    string `json:"robot"`
+	Name     string `json:"name"`
+	AccessID string `json:"accessId"`
+	Token    string `json:"token"`
+	// OldID is the ID of the token that was replaced.
+	OldID uuid.UUID `json:"oldId"`
+}
+
+// BeforeApply sets default values for the rotate command, before assignment and validation.
+func (c *rotateCmd) BeforeApply() error {
+	c.prompter = input.NewPrompter()
+	return nil
+}
+
+// AfterApply validates the combination of targets, revocation and output
+// flags.
+func (c *rotateCmd) AfterApply() error {
+	switch {
+	case c.All && c.RobotName != "":
+		return errors.New("cannot specify a robot name with --all")
+	case !c.All && c.RobotName == "":
+		return errors.New("must specify a robot name or --all")
+	case c.All && c.TokenName != "":
+		return errors.New("cannot specify a token name with --all")
+	case c.GracePeriod < 0:
+		return errors.New("--grace-period must not be negative")
+	case c.GracePeriod > 0 && !c.Revoke:
+		return errors.New("--grace-period requires --revoke")
+	case c.File == "":
+		return errors.New("refusing to emit sensitive output, please specify a file to write the new tokens to with --file")
+	case c.Revoke && c.File == "-":
+		return errors.New("--revoke asks for confirmation, which can't be combined with --file=-; use --revoke-immediately instead")
+	}
+	return nil
+}
+
+// rotateCmd replaces robot tokens with new ones.
+type rotateCmd struct {
+	prompter input.Prompter
+
+	RobotName string `arg:"" help:"Name of robot whose tokens to rotate. Omit when using --all."         optional:"" predictor:"robots"`
+	TokenName string `arg:"" help:"Name of token to rotate. Rotates all tokens of the robot if omitted." optional:""`
+
+	All               bool          `help:"Rotate the tokens of all robots in the organization."`
+	Revoke            bool          `help:"Revoke the old tokens after the grace period, once confirmed."                              xor:"revoke"`
+	RevokeImmediately bool          `help:"Revoke the old tokens right after creating the new ones, without confirmation."             xor:"revoke"`
+	GracePeriod       time.Duration `help:"How long to wait after creating the new tokens before revoking the old ones with --revoke."`
+	File              string        `help:"File to write the new tokens to as JSON. Use '-' to write to standard output."              short:"f"`
+}
+
+// Run executes the rotate command.
+func (c *rotateCmd) Run(ctx context.Context, p upterm.Printer, ac *accounts.Client, oc *organizations.Client, rc *robots.Client, tc *tokens.Client, upCtx *upbound.Context) error {
+	a, err := ac.Get(ctx, upCtx.Organization)
+	if err != nil {
+		return err
+	}
+	if a.Account.Type != accounts.AccountOrganization {
+		return errors.New(errUserAccount)
+	}
+	rs, err := oc.ListRobots(ctx, a.Organization.ID)
+	if err != nil {
+		return err
+	}
+
+	targets, err := c.robots(rs, upCtx.Organization)
+	if err != nil {
+		return err
+	}
+
+	// The output file is opened before any tokens are created, so that new
+	// tokens can't be lost because it can't be written.
+	out, err := c.openFile()
+	if err != nil {
+		return errors.Wrap(err, "cannot open file for the new tokens")
+	}
+	defer out.Close() //nolint:errcheck // Can't do anything useful with this error.
+
+	// Tokens created before an error are still written, so that they aren't
+	// lost, but the old tokens are kept.
+	rotated, rerr := c.rotate(ctx, rc, tc, targets, upCtx.Organization)
+	if rerr != nil && len(rotated) == 0 {
+		return rerr
+	}
+
+	if len(rotated) == 0 {
+		c.infof(p, "No tokens found to rotate in %s", upCtx.Organization)
+		return nil
+	}
+
+	// The new tokens are only written here, before anything can go wrong
+	// revoking the old ones.
+	if err := json.NewEncoder(out).Encode(rotated); err != nil {
+		return errors.Wrap(err, "cannot write the new tokens")
+	}
+	if err := out.Close(); err != nil {
+		return errors.Wrap(err, "cannot write the new tokens")
+	}
+	for _, r := range rotated {
+		c.infof(p, "%s/%s/%s rotated", upCtx.Organization, r.Robot, r.Name)
+	}
+	if rerr != nil {
+		return errors.Wrap(rerr, "cannot rotate all tokens, old tokens were kept")
+	}
+
+	switch {
+	case c.RevokeImmediately:
+	case c.Revoke:
+		if c.GracePeriod > 0 {
+			c.infof(p, "Waiting %s before revoking the old tokens", c.GracePeriod)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(c.GracePeriod):
+			}
+		}
+		confirm, err := c.prompter.Prompt(fmt.Sprintf("Revoke %d old robot token(s)? [y/n]", len(rotated)), false)
+		if err != nil {
+			return err
+		}
+		if !input.InputYes(confirm) {
+			c.infof(p, "Kept the old tokens")
+			return nil
+		}
+	default:
+		c.infof(p, "Kept the old tokens. Use --revoke or --revoke-immediately to revoke them.")
+		return nil
+	}
+
+	for _, r := range rotated {
+		if err := tc.Delete(ctx, r.OldID); err != nil {
+			return errors.Wrapf(err, "cannot revoke old token %s for robot %s", r.Name, r.Robot)
+		}
+		c.infof(p, "%s/%s/%s old token revoked", upCtx.Organization, r.Robot, r.Name)
+	}
+	return nil
+}
+
+// rotate creates a new token with the same name for each token of the target
+// robots. It returns the tokens created before any error.
+func (c *rotateCmd) rotate(ctx context.Context, rc *robots.Client, tc *tokens.Client, targets []organizations.Robot, org string) ([]rotatedToken, error) {
+	var rotated []rotatedToken
+	for _, r := range targets {
+		ts, err := rc.ListTokens(ctx, r.ID)
+		if err != nil {
+			return rotated, err
+		}
+
+		found := false
+		for _, t := range ts.DataSet {
+			name := fmt.Sprint(t.AttributeSet["name"])
+			if c.TokenName != "" && name != c.TokenName {
+				continue
+			}
+			found = true
+
+			res, err := tc.Create(ctx, &tokens.TokenCreateParameters{
+				Attributes: tokens.TokenAttributes{
+					Name: name,
+				},
+				Relationships: tokens.TokenRelationships{
+					Owner: tokens.TokenOwner{
+						Data: tokens.TokenOwnerData{
+							Type: tokens.TokenOwnerRobot,
+							ID:   r.ID.String(),
+						},
+					},
+				},
+			})
+			if err != nil {
+				return rotated, errors.Wrapf(err, "cannot create new token %s for robot %s", name, r.Name)
+			}
+			rotated = append(rotated, rotatedToken{
+				Robot:    r.Name,
+				Name:     name,
+				AccessID: res.ID.String(),
+				Token:    fmt.Sprint(res.Meta["jwt"]),
+				OldID:    t.ID,
+			})
+		}
+		if c.TokenName != "" && !found {
+			return rotated, errors.Errorf(errFindTokenFmt, c.TokenName, r.Name, org)
+		}
+	}
+	return rotated, nil
+}
+
+// robots returns the robots whose tokens to rotate.
+func (c *rotateCmd) robots(rs []organizations.Robot, org string) ([]organizations.Robot, error) {
+	if c.All {
+		return rs, nil
+	}
+
+	// TODO(hasheddan): because this API does not guarantee name uniqueness, we
+	// must guarantee that exactly one robot exists in the specified account
+	// with the provided name. Logic should be simplified when the API is
+	// updated.
+	var out []organizations.Robot
+	for _, r := range rs {
+		if r.Name == c.RobotName {
+			out = append(out, r)
+		}
+	}
+	switch len(out) {
+	case 0:
+		return nil, errors.Errorf(errFindRobotFmt, c.RobotName, org)
+	case 1:
+		return out, nil
+	default:
+		return nil, errors.Errorf(errMultipleRobotFmt, c.RobotName, org)
+	}
+}
+
+// openFile opens the file to write the new tokens to, which is standard output
+// if the file is '-'. This is the only place the new tokens are shown.
+func (c *rotateCmd) openFile() (io.WriteCloser, error) {
+	if c.File == "-" {
+		return nopWriteCloser{os.Stdout}, nil
+	}
+	return os.OpenFile(filepath.Clean(c.File), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+}
+
+// nopWriteCloser doesn't close the writer it wraps.
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// infof prints informational output, which is omitted when the new tokens are
+// written to standard output so that they can be parsed.
+func (c *rotateCmd) infof(p upterm.Printer, format string, args ...any) {
+	if c.File != "-" {
+		p.Printfln(format, args...)
+	}
+}
@@ -0,0 +1,293 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package token
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/upbound/up-sdk-go"
+	upboundfake "github.com/upbound/up-sdk-go/fake"
+	"github.com/upbound/up-sdk-go/service/accounts"
+	"github.com/upbound/up-sdk-go/service/common"
+	"github.com/upbound/up-sdk-go/service/organizations"
+	"github.com/upbound/up-sdk-go/service/robots"
+	"github.com/upbound/up-sdk-go/service/tokens"
+	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/upterm"
+)
+
+func TestRotateCmdAfterApply(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		cmd    rotateCmd
+		want   error
+	}{
+		"Robot": {
+			reason: "Rotating the tokens of a named robot to a file should be valid.",
+			cmd:    rotateCmd{RobotName: "ci", File: "tokens.json"},
+		},
+		"AllWithRevoke": {
+			reason: "Rotating the tokens of all robots and revoking the old ones after a grace period should be valid.",
+			cmd:    rotateCmd{All: true, Revoke: true, GracePeriod: time.Minute, File: "tokens.json"},
+		},
+		"AllWithRobot": {
+			reason: "A robot name can't be combined with --all.",
+			cmd:    rotateCmd{All: true, RobotName: "ci", File: "tokens.json"},
+			want:   errors.New("cannot specify a robot name with --all"),
+		},
+		"NoTarget": {
+			reason: "Either a robot name or --all is required.",
+			cmd:    rotateCmd{File: "tokens.json"},
+			want:   errors.New("must specify a robot name or --all"),
+		},
+		"AllWithToken": {
+			reason: "A token name can't be combined with --all.",
+			cmd:    rotateCmd{All: true, TokenName: "deploy", File: "tokens.json"},
+			want:   errors.New("cannot specify a token name with --all"),
+		},
+		"NegativeGracePeriod": {
+			reason: "The grace period can't be negative.",
+			cmd:    rotateCmd{RobotName: "ci", Revoke: true, GracePeriod: -time.Minute, File: "tokens.json"},
+			want:   errors.New("--grace-period must not be negative"),
+		},
+		"GracePeriodWithoutRevoke": {
+			reason: "A grace period only applies when revoking the old tokens.",
+			cmd:    rotateCmd{RobotName: "ci", GracePeriod: time.Minute, File: "tokens.json"},
+			want:   errors.New("--grace-period requires --revoke"),
+		},
+		"NoFile": {
+			reason: "The new tokens are sensitive, so a file to write them to is required.",
+			cmd:    rotateCmd{RobotName: "ci"},
+			want:   errors.New("refusing to emit sensitive output, please specify a file to write the new tokens to with --file"),
+		},
+		"RevokeToStandardOutput": {
+			reason: "Confirming revocation would corrupt tokens written to standard output.",
+			cmd:    rotateCmd{RobotName: "ci", Revoke: true, File: "-"},
+			want:   errors.New("--revoke asks for confirmation, which can't be combined with --file=-; use --revoke-immediately instead"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.cmd.AfterApply()
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nAfterApply(): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRotateCmdRobots(t *testing.T) {
+	ci := organizations.Robot{ID: uuid.New(), Name: "ci"}
+	deploy := organizations.Robot{ID: uuid.New(), Name: "deploy"}
+
+	type want struct {
+		robots []organizations.Robot
+		err    error
+	}
+	cases := map[string]struct {
+		reason string
+		cmd    rotateCmd
+		robots []organizations.Robot
+		want   want
+	}{
+		"All": {
+			reason: "All robots should be rotated with --all.",
+			cmd:    rotateCmd{All: true},
+			robots: []organizations.Robot{ci, deploy},
+			want:   want{robots: []organizations.Robot{ci, deploy}},
+		},
+		"Named": {
+			reason: "Only the named robot should be rotated.",
+			cmd:    rotateCmd{RobotName: "deploy"},
+			robots: []organizations.Robot{ci, deploy},
+			want:   want{robots: []organizations.Robot{deploy}},
+		},
+		"NotFound": {
+			reason: "A robot that doesn't exist should return an error.",
+			cmd:    rotateCmd{RobotName: "missing"},
+			robots: []organizations.Robot{ci, deploy},
+			want:   want{err: errors.Errorf(errFindRobotFmt, "missing", "my-org")},
+		},
+		"Ambiguous": {
+			reason: "A robot name shared by several robots should return an error.",
+			cmd:    rotateCmd{RobotName: "ci"},
+			robots: []organizations.Robot{ci, {ID: uuid.New(), Name: "ci"}},
+			want:   want{err: errors.Errorf(errMultipleRobotFmt, "ci", "my-org")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.cmd.robots(tc.robots, "my-org")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nrobots(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.robots, got); diff != "" {
+				t.Errorf("\n%s\nrobots(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// mockConfig returns an SDK config whose client responds to each request with
+// the result of fn, given the request's method and path.
+func mockConfig(fn func(method, path string) (any, error)) *up.Config {
+	return &up.Config{
+		Client: &upboundfake.MockClient{
+			MockNewRequest: func(ctx context.Context, method, prefix, urlPath string, _ any) (*http.Request, error) {
+				return http.NewRequestWithContext(ctx, method, "/"+path.Join(prefix, urlPath), nil)
+			},
+			MockDo: func(req *http.Request, obj any) error {
+				res, err := fn(req.Method, req.URL.Path)
+				if err != nil || obj == nil {
+					return err
+				}
+				b, err := json.Marshal(res)
+				if err != nil {
+					return err
+				}
+				return json.Unmarshal(b, obj)
+			},
+		},
+	}
+}
+
+func TestRotateCmdRun(t *testing.T) {
+	robotID := uuid.New()
+	oldID := uuid.New()
+	newID := uuid.New()
+	errBoom := errors.New("boom")
+
+	type want struct {
+		tokens  []rotatedToken
+		revoked []uuid.UUID
+		err     error
+	}
+	cases := map[string]struct {
+		reason    string
+		cmd       rotateCmd
+		createErr error
+		deleteErr error
+		want      want
+	}{
+		"KeepsOldTokens": {
+			reason: "The new token should be written to the file, and the old one kept unless revocation is requested.",
+			cmd:    rotateCmd{RobotName: "ci"},
+			want: want{
+				tokens: []rotatedToken{{Robot: "ci", Name: "deploy", AccessID: newID.String(), Token: "new-jwt", OldID: oldID}},
+			},
+		},
+		"RevokesOldTokens": {
+			reason: "The old token should be revoked after the new one is written with --revoke-immediately.",
+			cmd:    rotateCmd{RobotName: "ci", RevokeImmediately: true},
+			want: want{
+				tokens:  []rotatedToken{{Robot: "ci", Name: "deploy", AccessID: newID.String(), Token: "new-jwt", OldID: oldID}},
+				revoked: []uuid.UUID{oldID},
+			},
+		},
+		"TokenNotFound": {
+			reason: "A token that doesn't exist should return an error without creating a new one.",
+			cmd:    rotateCmd{RobotName: "ci", TokenName: "missing"},
+			want: want{
+				err: errors.Errorf(errFindTokenFmt, "missing", "ci", "my-org"),
+			},
+		},
+		"CreateFails": {
+			reason:    "If the new token can't be created the old one should be kept.",
+			cmd:       rotateCmd{RobotName: "ci", RevokeImmediately: true},
+			createErr: errBoom,
+			want: want{
+				err: errors.Wrap(errBoom, "cannot create new token deploy for robot ci"),
+			},
+		},
+		"RevokeFails": {
+			reason:    "If the old token can't be revoked the new one should still have been written, so that it isn't lost.",
+			cmd:       rotateCmd{RobotName: "ci", RevokeImmediately: true},
+			deleteErr: errBoom,
+			want: want{
+				tokens:  []rotatedToken{{Robot: "ci", Name: "deploy", AccessID: newID.String(), Token: "new-jwt", OldID: oldID}},
+				revoked: []uuid.UUID{oldID},
+				err:     errors.Wrap(errBoom, "cannot revoke old token deploy for robot ci"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ac := accounts.NewClient(mockConfig(func(_, _ string) (any, error) {
+				return accounts.AccountResponse{
+					Account:      accounts.Account{Name: "my-org", Type: accounts.AccountOrganization},
+					Organization: &organizations.Organization{ID: 1, Name: "my-org"},
+				}, nil
+			}))
+			oc := organizations.NewClient(mockConfig(func(_, _ string) (any, error) {
+				return []organizations.Robot{{ID: robotID, Name: "ci"}}, nil
+			}))
+			rc := robots.NewClient(mockConfig(func(_, _ string) (any, error) {
+				return tokens.TokensResponse{DataSet: []common.DataSet{{
+					ID:           oldID,
+					AttributeSet: common.AttributeSet{"name": "deploy"},
+				}}}, nil
+			}))
+			var revoked []uuid.UUID
+			tokensClient := tokens.NewClient(mockConfig(func(method, p string) (any, error) {
+				switch method {
+				case http.MethodPost:
+					if tc.createErr != nil {
+						return nil, tc.createErr
+					}
+					return tokens.TokenResponse{DataSet: common.DataSet{
+						ID:   newID,
+						Meta: common.Meta{"jwt": "new-jwt"},
+					}}, nil
+				case http.MethodDelete:
+					revoked = append(revoked, uuid.MustParse(path.Base(p)))
+					return nil, tc.deleteErr
+				}
+				return nil, errors.Errorf("unexpected %s %s", method, p)
+			}))
+
+			file := filepath.Join(t.TempDir(), "tokens.json")
+			tc.cmd.File = file
+			upCtx := &upbound.Context{Organization: "my-org"}
+
+			err := tc.cmd.Run(context.Background(), upterm.NewTestPrinter(), ac, oc, rc, tokensClient, upCtx)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nRun(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.revoked, revoked); diff != "" {
+				t.Errorf("\n%s\nRun(...): revoked tokens: -want, +got:\n%s", tc.reason, diff)
+			}
+
+			b, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatalf("\n%s\nReadFile(...): %v", tc.reason, err)
+			}
+			var got []rotatedToken
+			if strings.TrimSpace(string(b)) != "" {
+				if err := json.Unmarshal(b, &got); err != nil {
+					t.Fatalf("\n%s\nUnmarshal(...): %v", tc.reason, err)
+				}
+			}
+			if diff := cmp.Diff(tc.want.tokens, got); diff != "" {
+				t.Errorf("\n%s\nRun(...): written tokens: -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
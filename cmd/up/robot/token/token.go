@@ -36,4 +36,5 @@ type Cmd struct {
 	Delete deleteCmd `cmd:"" help:"Delete a token for the robot."`
 	List   listCmd   `cmd:"" help:"List the tokens for the robot."`
 	Get    getCmd    `cmd:"" help:"Get a token for the robot."`
+	Rotate rotateCmd `cmd:"" help:"Replace the tokens of a robot, or of all robots, with new ones."`
 }
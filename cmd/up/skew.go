@@ -0,0 +1,103 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"golang.org/x/term"
+	"k8s.io/client-go/kubernetes"
+
+	v "github.com/upbound/up/cmd/up/version"
+	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/version"
+)
+
+const (
+	// skipSkewCheckEnv disables the version skew check when set.
+	skipSkewCheckEnv = "UP_SKIP_VERSION_SKEW_CHECK"
+
+	// skewCheckTimeout bounds how long the check waits for the Space.
+	skewCheckTimeout = 5 * time.Second
+	// skewCheckWait is how long up waits for an unfinished check once the
+	// command is done, so that the check doesn't delay the command.
+	skewCheckWait = 200 * time.Millisecond
+)
+
+// skewCheck checks in the background whether the Space of the current context
+// runs a version too far from the version of up.
+type skewCheck struct {
+	done    chan struct{}
+	warning string
+}
+
+// startSkewCheck starts a version skew check for the Space of the current
+// context. It returns nil if the check is disabled, or the current context
+// isn't a Space or group context.
+func startSkewCheck(upCtx *upbound.Context) *skewCheck {
+	if _, skip := os.LookupEnv(skipSkewCheckEnv); skip {
+		return nil
+	}
+	if _, ctp, inSpace := upCtx.GetCurrentSpaceContextScope(); !inSpace || ctp.Name != "" {
+		return nil
+	}
+	cli := version.Version()
+	if cli == "" {
+		return nil
+	}
+
+	kubeCtx, _, _, ok := upCtx.GetCurrentContext()
+	if !ok || kubeCtx == nil {
+		return nil
+	}
+	rest, err := upCtx.GetKubeconfig()
+	if err != nil {
+		return nil
+	}
+	clientset, err := kubernetes.NewForConfig(rest)
+	if err != nil {
+		return nil
+	}
+
+	s := &skewCheck{done: make(chan struct{})}
+	go func() {
+		defer close(s.done)
+
+		ctx, cancel := context.WithTimeout(context.Background(), skewCheckTimeout)
+		defer cancel()
+
+		server, err := v.FetchSpacesVersion(ctx, kubeCtx, *clientset)
+		if err != nil {
+			return
+		}
+		s.warning = version.SpacesSkewWarning(cli, server)
+	}()
+	return s
+}
+
+// interactive returns true if both standard output and standard error are
+// terminals, i.e. if up isn't being run by a script.
+func interactive() bool {
+	return term.IsTerminal(int(os.Stdout.Fd())) && term.IsTerminal(int(os.Stderr.Fd()))
+}
+
+// warn writes the warning of the check, if any, to w. It waits briefly for the
+// check to complete, and gives up silently if it doesn't.
+func (s *skewCheck) warn(w io.Writer) {
+	if s == nil {
+		return
+	}
+	select {
+	case <-s.done:
+	case <-time.After(skewCheckWait):
+		return
+	}
+	if s.warning != "" {
+		fmt.Fprintf(w, "Warning: %s\n", s.warning) //nolint:errcheck // Best effort.
+	}
+}
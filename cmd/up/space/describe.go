@@ -0,0 +1,125 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package space
+
+import (
+	"context"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	upboundv1alpha1 "github.com/upbound/up-sdk-go/apis/upbound/v1alpha1"
+	uerrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/service/accounts"
+	"github.com/upbound/up/internal/spaces"
+	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/upterm"
+
+	_ "embed"
+)
+
+const (
+	errDescribeSpace = "unable to describe Upbound Space"
+)
+
+//go:embed describe.tmpl
+var describeTemplate string
+
+// describeCmd describes a space in Upbound.
+type describeCmd struct {
+	upbound.RequiresContext
+
+	Name string `arg:"" help:"Name of the space." required:""`
+
+	kc client.Client
+	ac *accounts.Client
+	ir spaces.IngressReader
+}
+
+// spaceDescription is the description of a space printed by describeCmd.
+type spaceDescription struct {
+	Name        string `json:"name"`
+	Mode        string `json:"mode,omitempty"`
+	Provider    string `json:"provider,omitempty"`
+	Region      string `json:"region,omitempty"`
+	Connection  string `json:"connection"`
+	Access      string `json:"access"`
+	FQDN        string `json:"fqdn,omitempty"`
+	APIURL      string `json:"apiURL,omitempty"`
+	IngressHost string `json:"ingressHost,omitempty"`
+}
+
+// AfterApply sets default values in command after assignment and validation.
+func (c *describeCmd) AfterApply(upCtx *upbound.Context) error {
+	ac, kc, err := cloudClients(upCtx)
+	if err != nil {
+		return errors.Wrap(err, errDescribeSpace)
+	}
+	c.ac, c.kc = ac, kc
+	c.ir = spaces.NewConfigMapReader(upCtx.Profile.Session)
+
+	return nil
+}
+
+// Run executes the describe command.
+func (c *describeCmd) Run(ctx context.Context, printer upterm.Printer, upCtx *upbound.Context) error {
+	a, err := upbound.GetOrganization(ctx, c.ac, upCtx.Organization)
+	var uerr *uerrors.Error
+	if errors.As(err, &uerr) {
+		if uerr.Status == http.StatusUnauthorized {
+			printer.Println("You must be logged in and authorized to describe Upbound Cloud Spaces")
+			return uerr
+		}
+	}
+
+	if err != nil {
+		return errors.Wrap(err, errDescribeSpace)
+	}
+
+	var space upboundv1alpha1.Space
+	if err := c.kc.Get(ctx, types.NamespacedName{Namespace: a.Organization.Name, Name: c.Name}, &space); err != nil {
+		return errors.Wrap(err, errDescribeSpace)
+	}
+
+	d, err := c.describe(ctx, space)
+	if err != nil {
+		return errors.Wrap(err, errDescribeSpace)
+	}
+
+	return printer.PrintObjectTemplate(d, describeTemplate)
+}
+
+// describe describes a space, looking up its ingress if the space is
+// accessible.
+func (c *describeCmd) describe(ctx context.Context, space upboundv1alpha1.Space) (*spaceDescription, error) {
+	d := &spaceDescription{
+		Name:       space.GetName(),
+		Mode:       space.Labels[upboundv1alpha1.SpaceModeLabelKey],
+		Provider:   string(ptr.Deref(space.Spec.Provider, "")),
+		Region:     string(ptr.Deref(space.Spec.Region, "")),
+		Connection: spaceConnection(space),
+		Access:     spaceAccess(space),
+		FQDN:       space.Status.FQDN,
+		APIURL:     space.Status.APIURL,
+	}
+	if d.Access != spaceAccessAvailable {
+		return d, nil
+	}
+
+	ingress, err := c.ir.Get(ctx, space)
+	switch {
+	case errors.Is(err, spaces.ErrSpaceConnection):
+		d.Access = spaceAccessUnreachable
+	case err != nil:
+		return nil, errors.Wrap(err, "cannot get space ingress")
+	default:
+		d.IngressHost = ingress.Host
+	}
+
+	return d, nil
+}
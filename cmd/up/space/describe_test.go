@@ -0,0 +1,117 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package space
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	upboundv1alpha1 "github.com/upbound/up-sdk-go/apis/upbound/v1alpha1"
+	"github.com/upbound/up/internal/spaces"
+)
+
+type mockIngressReader struct {
+	ingress *spaces.SpaceIngress
+	err     error
+}
+
+func (m *mockIngressReader) Get(_ context.Context, _ upboundv1alpha1.Space) (*spaces.SpaceIngress, error) {
+	return m.ingress, m.err
+}
+
+func TestDescribe(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	connected := upboundv1alpha1.Space{
+		ObjectMeta: metav1.ObjectMeta{Name: "some-space"},
+		Status: upboundv1alpha1.SpaceStatus{
+			APIURL:            "https://some-space.example.org",
+			ConnectionDetails: upboundv1alpha1.ConnectionDetails{Status: upboundv1alpha1.ConnectionStatusConnected},
+		},
+	}
+
+	type want struct {
+		d   *spaceDescription
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		space  upboundv1alpha1.Space
+		ir     spaces.IngressReader
+		want   want
+	}{
+		"Ingress": {
+			reason: "An available space should be described with its ingress host.",
+			space:  connected,
+			ir:     &mockIngressReader{ingress: &spaces.SpaceIngress{Host: "ingress.example.org"}},
+			want: want{
+				d: &spaceDescription{
+					Name:        "some-space",
+					Connection:  "connected",
+					Access:      spaceAccessAvailable,
+					APIURL:      "https://some-space.example.org",
+					IngressHost: "ingress.example.org",
+				},
+			},
+		},
+		"ConnectionFailed": {
+			reason: "A space whose ingress can't be reached should be described as unreachable.",
+			space:  connected,
+			ir:     &mockIngressReader{err: spaces.ErrSpaceConnection},
+			want: want{
+				d: &spaceDescription{
+					Name:       "some-space",
+					Connection: "connected",
+					Access:     spaceAccessUnreachable,
+					APIURL:     "https://some-space.example.org",
+				},
+			},
+		},
+		"IngressError": {
+			reason: "Other errors getting a space's ingress should be returned.",
+			space:  connected,
+			ir:     &mockIngressReader{err: errBoom},
+			want: want{
+				err: errors.Wrap(errBoom, "cannot get space ingress"),
+			},
+		},
+		"Inaccessible": {
+			reason: "The ingress of an inaccessible space shouldn't be looked up.",
+			space: upboundv1alpha1.Space{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "some-space",
+					Labels: map[string]string{upboundv1alpha1.SpaceInaccessibleLabelKey: "true"},
+				},
+			},
+			ir: &mockIngressReader{err: errBoom},
+			want: want{
+				d: &spaceDescription{
+					Name:       "some-space",
+					Connection: "unknown",
+					Access:     spaceAccessRequiresUpgrade,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &describeCmd{ir: tc.ir}
+			d, err := c.describe(context.Background(), tc.space)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ndescribe(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.d, d); diff != "" {
+				t.Errorf("\n%s\ndescribe(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -22,6 +22,14 @@ const (
 	errListSpaces = "unable to list Upbound Spaces"
 )
 
+// Accessibility of a space, mirroring how `up ctx` annotates spaces that can't
+// be selected.
+const (
+	spaceAccessAvailable       = "available"
+	spaceAccessUnreachable     = "unreachable"
+	spaceAccessRequiresUpgrade = "requires tier upgrade"
+)
+
 // listCmd lists all of the spaces in Upbound.
 type listCmd struct {
 	upbound.RequiresContext
@@ -32,24 +40,34 @@ type listCmd struct {
 
 // AfterApply sets default values in command after assignment and validation.
 func (c *listCmd) AfterApply(upCtx *upbound.Context) error {
+	ac, kc, err := cloudClients(upCtx)
+	if err != nil {
+		return errors.Wrap(err, errListSpaces)
+	}
+	c.ac, c.kc = ac, kc
+
+	return nil
+}
+
+// cloudClients returns clients for the Upbound accounts API and for the cloud
+// API in which spaces are listed.
+func cloudClients(upCtx *upbound.Context) (*accounts.Client, client.Client, error) {
 	cfg, err := upCtx.BuildSDKConfig()
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	ctrlCfg, err := upCtx.BuildControllerClientConfig()
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
-	c.ac = accounts.NewClient(cfg)
 
 	kc, err := client.New(ctrlCfg, client.Options{})
 	if err != nil {
-		return errors.Wrap(err, errListSpaces)
+		return nil, nil, err
 	}
-	c.kc = kc
 
-	return nil
+	return accounts.NewClient(cfg), kc, nil
 }
 
 // Run executes the list command.
@@ -78,14 +96,14 @@ func (c *listCmd) Run(ctx context.Context, printer upterm.Printer, upCtx *upboun
 		return nil
 	}
 
-	fieldNames := []string{"NAME", "MODE", "PROVIDER", "REGION"}
+	fieldNames := []string{"NAME", "MODE", "PROVIDER", "REGION", "CONNECTION", "ACCESS"}
 	return printer.PrintObject(l.Items, fieldNames, extractSpaceListFields)
 }
 
 func extractSpaceListFields(obj any) []string {
 	space, ok := obj.(upboundv1alpha1.Space)
 	if !ok {
-		return []string{"unknown", "unknown", "", ""}
+		return []string{"unknown", "unknown", "", "", "unknown", "unknown"}
 	}
 
 	provider, region := "", ""
@@ -104,5 +122,28 @@ func extractSpaceListFields(obj any) []string {
 		mode,
 		provider,
 		region,
+		spaceConnection(space),
+		spaceAccess(space),
+	}
+}
+
+// spaceConnection returns the status of the connection to a space.
+func spaceConnection(space upboundv1alpha1.Space) string {
+	if space.Status.ConnectionDetails.Status == "" {
+		return string(upboundv1alpha1.ConnectionStatusUnknown)
+	}
+	return string(space.Status.ConnectionDetails.Status)
+}
+
+// spaceAccess returns whether a space can be used, based on its labels and
+// connection status.
+func spaceAccess(space upboundv1alpha1.Space) string {
+	switch {
+	case space.Labels[upboundv1alpha1.SpaceInaccessibleLabelKey] == "true":
+		return spaceAccessRequiresUpgrade
+	case space.Status.ConnectionDetails.Status == upboundv1alpha1.ConnectionStatusUnreachable:
+		return spaceAccessUnreachable
+	default:
+		return spaceAccessAvailable
 	}
 }
@@ -186,3 +186,58 @@ func TestListCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractSpaceListFields(t *testing.T) {
+	provider := upboundv1alpha1.CloudProvider("aws")
+	region := upboundv1alpha1.Region("us-east-1")
+
+	cases := map[string]struct {
+		reason string
+		space  upboundv1alpha1.Space
+		want   []string
+	}{
+		"Available": {
+			reason: "A connected space should be available.",
+			space: upboundv1alpha1.Space{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "some-space",
+					Labels: map[string]string{upboundv1alpha1.SpaceModeLabelKey: string(upboundv1alpha1.ModeManaged)},
+				},
+				Spec: upboundv1alpha1.SpaceSpec{Provider: &provider, Region: &region},
+				Status: upboundv1alpha1.SpaceStatus{
+					ConnectionDetails: upboundv1alpha1.ConnectionDetails{Status: upboundv1alpha1.ConnectionStatusConnected},
+				},
+			},
+			want: []string{"some-space", "managed", "aws", "us-east-1", "connected", "available"},
+		},
+		"Unreachable": {
+			reason: "An unreachable space should be marked unreachable.",
+			space: upboundv1alpha1.Space{
+				ObjectMeta: metav1.ObjectMeta{Name: "some-space"},
+				Status: upboundv1alpha1.SpaceStatus{
+					ConnectionDetails: upboundv1alpha1.ConnectionDetails{Status: upboundv1alpha1.ConnectionStatusUnreachable},
+				},
+			},
+			want: []string{"some-space", "", "", "", "unreachable", "unreachable"},
+		},
+		"RequiresTierUpgrade": {
+			reason: "An inaccessible space should require a tier upgrade, whatever its connection status.",
+			space: upboundv1alpha1.Space{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "some-space",
+					Labels: map[string]string{upboundv1alpha1.SpaceInaccessibleLabelKey: "true"},
+				},
+			},
+			want: []string{"some-space", "", "", "", "unknown", "requires tier upgrade"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := extractSpaceListFields(tc.space)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nextractSpaceListFields(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -4,9 +4,17 @@
 package space
 
 import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"net/url"
+	"path"
 	"path/filepath"
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
@@ -20,6 +28,7 @@ import (
 
 	"github.com/upbound/up/internal/oci"
 	"github.com/upbound/up/internal/registry"
+	"github.com/upbound/up/internal/upbound"
 	"github.com/upbound/up/internal/upterm"
 	"github.com/upbound/up/internal/uxp"
 
@@ -32,15 +41,29 @@ const (
 	uxpV2HelmChart = "xpkg.upbound.io/spaces-artifacts/crossplane"
 	// uxpV2ControllerManagerImage is mirrored for each UXP v2 supportedVersion alongside the crossplane runtime image.
 	uxpV2ControllerManagerImage = "xpkg.upbound.io/spaces-artifacts/controller-manager"
+	// checksumsFile is the name of the file in the output directory that lists
+	// the SHA-256 checksum of each mirrored artifact, in sha256sum format.
+	checksumsFile = "SHA256SUMS"
 )
 
 //go:embed help/mirror.md
 var mirrorHelp string
 
+func (c *mirrorGroupCmd) Help() string {
+	return mirrorHelp
+}
+
 func (c *mirrorCmd) Help() string {
 	return mirrorHelp
 }
 
+// mirrorGroupCmd mirrors artifacts when no subcommand is given, and groups the
+// commands that operate on mirrored artifacts.
+type mirrorGroupCmd struct {
+	Mirror mirrorCmd       `cmd:"" default:"withargs"                                                      help:"Mirror the artifacts for a Spaces version." hidden:""`
+	Verify mirrorVerifyCmd `cmd:"" help:"Verify the checksums of artifacts mirrored to a local directory."`
+}
+
 type repository struct {
 	Chart        string           `yaml:"chart"`
 	Images       []imageReference `yaml:"images"`
@@ -68,14 +91,30 @@ type ociconfig struct {
 type mirrorCmd struct {
 	Registry registry.AuthorizedFlags `embed:""`
 
-	OutputDir           string `help:"The local directory path where exported artifacts will be saved as .tgz files." optional:"" short:"t"`
-	DestinationRegistry string `help:"The target container registry where the artifacts will be mirrored."            optional:"" short:"d"`
-	Version             string `help:"The specific Spaces version for which the artifacts will be mirrored."          required:"" short:"v"`
+	OutputDir           string `help:"The local directory path where exported artifacts will be saved as .tgz files."                                optional:"" short:"t"`
+	DestinationRegistry string `help:"The target container registry where the artifacts will be mirrored."                                           optional:"" short:"d"`
+	Version             string `help:"The specific Spaces version for which the artifacts will be mirrored. Required unless --from-manifest is set." optional:"" short:"v"`
 	DryRun              bool   `help:"Print what would be mirrored but do not take action."`
+	Checksums           bool   `help:"Write a SHA256SUMS file with the checksum of each artifact saved to the output directory."`
+
+	Manifest     string `help:"Write a manifest of the mirrored artifacts and their digests to this file, for use with --from-manifest."                          type:"path"`
+	FromManifest string `help:"Mirror the exact artifact digests recorded in a manifest written by --manifest, instead of resolving the artifacts for --version." type:"existingfile"`
+
+	Include []string `help:"Only mirror artifacts whose component name, such as 'crossplane' or 'kube-apiserver', matches one of these glob patterns." placeholder:"GLOB"`
+	Exclude []string `help:"Don't mirror artifacts whose component name matches one of these glob patterns. Takes precedence over --include."          placeholder:"GLOB"`
+
+	Proxy *url.URL `env:"UP_PROXY" help:"Proxy to use for HTTP(S) requests. Overrides the HTTP_PROXY and HTTPS_PROXY environment variables. NO_PROXY is still honored."`
 
 	craneOpts []crane.Option
+	checksums map[string]string
+
+	// manifest is the manifest read from --from-manifest, if any. recorded is
+	// the manifest being written to --manifest, if any.
+	manifest *mirrorManifest
+	recorded *mirrorManifest
 
 	fetchManifest       func(ref string, opts ...crane.Option) ([]byte, error)
+	fetchDigest         func(ref string, opts ...crane.Option) (string, error)
 	getValuesFromChart  func(chart, version string, pathNavigator oci.PathNavigator, username, password string) ([]string, error)
 	getUxpV2RuntimeTags func(chart, version, username, password string) (crossplaneTag, controllerManagerTag string, err error)
 
@@ -88,6 +127,20 @@ func (c *mirrorCmd) AfterApply() error {
 	}
 	// remove leading v
 	c.Version = strings.TrimPrefix(c.Version, "v")
+	if c.Version == "" && c.FromManifest == "" {
+		return errors.New("--version is required unless --from-manifest is set")
+	}
+	if c.FromManifest != "" {
+		m, err := readMirrorManifest(c.FromManifest)
+		if err != nil {
+			return err
+		}
+		if c.Version != "" && c.Version != m.Version {
+			return errors.Errorf("--version %s does not match the Spaces version %s in manifest %s", c.Version, m.Version, c.FromManifest)
+		}
+		c.manifest = m
+	}
+
 	multiKeychain := authn.NewMultiKeychain(authn.DefaultKeychain)
 
 	if c.Registry.TokenFile != nil {
@@ -102,7 +155,16 @@ func (c *mirrorCmd) AfterApply() error {
 		multiKeychain = authn.NewMultiKeychain(multiKeychain, staticKeychain)
 	}
 
-	c.craneOpts = append(c.craneOpts, crane.WithAuthFromKeychain(multiKeychain))
+	c.craneOpts = append(c.craneOpts,
+		crane.WithAuthFromKeychain(multiKeychain),
+		crane.WithTransport(upbound.NewTransport(c.Proxy)),
+	)
+
+	for _, pattern := range slices.Concat(c.Include, c.Exclude) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return errors.Wrapf(err, "invalid artifact filter %q", pattern)
+		}
+	}
 
 	if c.OutputDir != "" {
 		fs := afero.NewBasePathFs(afero.NewOsFs(), c.OutputDir)
@@ -115,6 +177,7 @@ func (c *mirrorCmd) AfterApply() error {
 	}
 
 	c.fetchManifest = crane.Manifest
+	c.fetchDigest = crane.Digest
 	c.getValuesFromChart = oci.GetValuesFromChart
 	c.getUxpV2RuntimeTags = uxp.GetV2RuntimeTags
 
@@ -123,17 +186,85 @@ func (c *mirrorCmd) AfterApply() error {
 
 // Run executes the mirror command.
 func (c *mirrorCmd) Run(p upterm.Printer) error {
-	artifacts, err := initPathNavigator()
-	if err != nil {
-		return errors.Wrap(err, "unable to get artifact list")
+	if c.Checksums && len(c.path) > 0 {
+		c.checksums = make(map[string]string)
+	}
+	if c.Manifest != "" && !c.DryRun {
+		c.recorded = &mirrorManifest{Version: c.Version}
 	}
 
-	for _, repo := range artifacts {
-		if err := c.mirror(p, repo); err != nil {
-			return errors.Wrap(err, "mirror artifacts failed")
+	if c.manifest != nil {
+		if c.recorded != nil {
+			c.recorded.Version = c.manifest.Version
+		}
+		if err := c.mirrorFromManifest(p); err != nil {
+			return errors.Wrap(err, "mirror artifacts from manifest failed")
+		}
+	} else {
+		artifacts, err := initPathNavigator()
+		if err != nil {
+			return errors.Wrap(err, "unable to get artifact list")
+		}
+
+		for _, repo := range artifacts {
+			if err := c.mirror(p, repo); err != nil {
+				return errors.Wrap(err, "mirror artifacts failed")
+			}
 		}
 	}
 
+	if c.checksums != nil {
+		if err := c.writeChecksums(p); err != nil {
+			return err
+		}
+	}
+	if c.recorded != nil {
+		if err := writeMirrorManifest(c.Manifest, c.recorded); err != nil {
+			return err
+		}
+		p.Printfln("Successfully wrote manifest of %d artifacts to '%s'", len(c.recorded.Artifacts), c.Manifest)
+	}
+
+	return nil
+}
+
+// mirrorFromManifest mirrors the artifacts recorded in the --from-manifest
+// manifest by digest.
+func (c *mirrorCmd) mirrorFromManifest(p upterm.Printer) error {
+	for _, a := range c.manifest.Artifacts {
+		if !c.includeArtifact(path.Base(a.Image)) {
+			continue
+		}
+		if err := c.handleArtifact(p, a); err != nil {
+			return errors.Wrapf(err, "unable to mirror artifact %s", a.tagged())
+		}
+	}
+	return nil
+}
+
+// writeChecksums writes the checksums of the artifacts saved to the output
+// directory to its SHA256SUMS file.
+func (c *mirrorCmd) writeChecksums(p upterm.Printer) error {
+	path := filepath.Join(c.path, checksumsFile)
+	if c.DryRun {
+		p.Printfln("sha256sum %s > %s", filepath.Join(c.path, "*.tgz"), path)
+		return nil
+	}
+
+	names := make([]string, 0, len(c.checksums))
+	for name := range c.checksums {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var b bytes.Buffer
+	for _, name := range names {
+		fmt.Fprintf(&b, "%s  %s\n", c.checksums[name], name)
+	}
+	if err := afero.WriteFile(afero.NewOsFs(), path, b.Bytes(), 0o644); err != nil {
+		return errors.Wrapf(err, "error writing checksums %s", path)
+	}
+	p.Printfln("Successfully wrote checksums of %d artifacts to '%s'", len(names), path)
 	return nil
 }
 
@@ -326,6 +457,25 @@ func (c *mirrorCmd) processImage(p upterm.Printer, image imageReference, baseVer
 }
 
 func (c *mirrorCmd) mirrorArtifact(p upterm.Printer, image, version string) error {
+	if !c.includeArtifact(path.Base(image)) {
+		return nil
+	}
+
+	a := mirroredArtifact{Image: image, Tag: version}
+	if c.recorded != nil {
+		// Mirror the digest that is recorded, in case the tag moves while
+		// mirroring.
+		digest, err := c.fetchDigest(a.tagged(), c.craneOpts...)
+		if err != nil {
+			return errors.Wrapf(err, "cannot resolve digest of %s", a.tagged())
+		}
+		a.Digest = digest
+	}
+	return c.handleArtifact(p, a)
+}
+
+// handleArtifact mirrors an artifact to the configured destination.
+func (c *mirrorCmd) handleArtifact(p upterm.Printer, a mirroredArtifact) error {
 	var artifact artifactHandler
 
 	switch {
@@ -338,8 +488,9 @@ func (c *mirrorCmd) mirrorArtifact(p upterm.Printer, image, version string) erro
 		}
 	case len(c.path) > 0:
 		artifact = &localMirror{
-			folder: c.path,
-			opts:   c.craneOpts,
+			folder:    c.path,
+			opts:      c.craneOpts,
+			checksums: c.checksums,
 		}
 	default:
 		artifact = &registryMirror{
@@ -348,7 +499,31 @@ func (c *mirrorCmd) mirrorArtifact(p upterm.Printer, image, version string) erro
 		}
 	}
 
-	return artifact.handle(p, fmt.Sprintf("%s:%s", image, version))
+	if err := artifact.handle(p, a.tagged(), a.source()); err != nil {
+		return err
+	}
+	if c.recorded != nil {
+		c.recorded.Artifacts = append(c.recorded.Artifacts, a)
+	}
+	return nil
+}
+
+// includeArtifact returns true if an artifact with the given component name
+// passes the --include and --exclude filters.
+func (c *mirrorCmd) includeArtifact(component string) bool {
+	if len(c.Include) > 0 && !matchesAny(c.Include, component) {
+		return false
+	}
+	return !matchesAny(c.Exclude, component)
+}
+
+func matchesAny(patterns []string, s string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, s); ok {
+			return true
+		}
+	}
+	return false
 }
 
 func initPathNavigator() (repo []repository, rErr error) {
@@ -384,8 +559,11 @@ func initPathNavigator() (repo []repository, rErr error) {
 	return artifacts.OCI, nil
 }
 
+// artifactHandler mirrors an artifact. The artifact is the tagged reference
+// that names the mirrored artifact; source is the reference it's mirrored
+// from, which pins a digest when one is known.
 type artifactHandler interface {
-	handle(p upterm.Printer, artifact string) error
+	handle(p upterm.Printer, artifact, source string) error
 }
 
 type dryRunMirror struct {
@@ -395,15 +573,15 @@ type dryRunMirror struct {
 	fetchManifest func(artifact string, opts ...crane.Option) ([]byte, error)
 }
 
-func (h *dryRunMirror) handle(p upterm.Printer, artifact string) error {
-	if _, err := h.fetchManifest(artifact, h.opts...); err != nil {
-		return errors.Wrapf(err, "artifact is not available in registry %s", artifact)
+func (h *dryRunMirror) handle(p upterm.Printer, artifact, source string) error {
+	if _, err := h.fetchManifest(source, h.opts...); err != nil {
+		return errors.Wrapf(err, "artifact is not available in registry %s", source)
 	}
 	if h.folder != "" {
-		p.Printfln("crane pull %s %s.tgz", artifact, filepath.Join(h.folder, oci.GetArtifactName(artifact)))
+		p.Printfln("crane pull %s %s.tgz", source, filepath.Join(h.folder, oci.GetArtifactName(artifact)))
 	}
 	if h.registry != "" {
-		p.Printfln("crane copy %s %s/%s", artifact, h.registry, oci.RemoveDomainAndOrg(artifact))
+		p.Printfln("crane copy %s %s/%s", source, h.registry, oci.RemoveDomainAndOrg(artifact))
 	}
 	return nil
 }
@@ -411,18 +589,29 @@ func (h *dryRunMirror) handle(p upterm.Printer, artifact string) error {
 type localMirror struct {
 	folder string
 	opts   []crane.Option
+
+	// checksums records the checksum of each saved artifact by file name,
+	// when non-nil.
+	checksums map[string]string
 }
 
-func (h *localMirror) handle(p upterm.Printer, artifact string) error {
+func (h *localMirror) handle(p upterm.Printer, artifact, source string) error {
 	path := filepath.Join(h.folder, fmt.Sprintf("%s.tgz", oci.GetArtifactName(artifact)))
 
-	img, err := crane.Pull(artifact, h.opts...)
+	img, err := crane.Pull(source, h.opts...)
 	if err != nil {
 		return errors.Wrap(err, "error pulling image")
 	}
 	if err := crane.Save(img, artifact, path); err != nil {
 		return errors.Wrapf(err, "error saving image %s", path)
 	}
+	if h.checksums != nil {
+		sum, err := fileSHA256(afero.NewOsFs(), path)
+		if err != nil {
+			return errors.Wrapf(err, "error computing checksum of %s", path)
+		}
+		h.checksums[filepath.Base(path)] = sum
+	}
 	p.Printfln("Successfully mirrored artifact '%s' to destination '%s'", artifact, path)
 	return nil
 }
@@ -432,15 +621,50 @@ type registryMirror struct {
 	opts     []crane.Option
 }
 
-func (h *registryMirror) handle(p upterm.Printer, artifact string) error {
+func (h *registryMirror) handle(p upterm.Printer, artifact, source string) error {
 	registry := fmt.Sprintf("%s/%s", h.registry, oci.RemoveDomainAndOrg(artifact))
-	if err := crane.Copy(artifact, registry, h.opts...); err != nil {
+	if err := crane.Copy(source, registry, h.opts...); err != nil {
 		return errors.Wrapf(err, "copy/push failed %s", artifact)
 	}
 	p.Printfln("Successfully mirrored artifact '%s' to destination '%s'", artifact, registry)
 	return nil
 }
 
+// fileSHA256 returns the hex-encoded SHA-256 checksum of a file.
+func fileSHA256(fs afero.Fs, name string) (string, error) {
+	f, err := fs.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close() //nolint:errcheck // Only read from the file.
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// parseChecksums parses a SHA256SUMS file into a map of file name to
+// checksum. Lines are in sha256sum format; binary mode markers are accepted.
+func parseChecksums(data []byte) (map[string]string, error) {
+	sums := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		sum, name, ok := strings.Cut(text, " ")
+		name = strings.TrimPrefix(strings.TrimLeft(name, " "), "*")
+		if !ok || len(sum) != sha256.Size*2 || name == "" {
+			return nil, errors.Errorf("invalid checksum on line %d", line)
+		}
+		sums[name] = strings.ToLower(sum)
+	}
+	return sums, errors.Wrap(scanner.Err(), "cannot read checksums")
+}
+
 // StaticKeychain is a simple keychain that returns different credentials for specific registries.
 type StaticKeychain struct {
 	credentials map[string]authn.AuthConfig
@@ -5,11 +5,16 @@ package space
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/spf13/afero"
 	"gotest.tools/v3/assert"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
@@ -26,6 +31,8 @@ func TestMirror(t *testing.T) {
 		version                 string
 		outputDir               string
 		destinationRegistry     string
+		include                 []string
+		exclude                 []string
 		expectedError           string
 		expectedOutput          []string
 		mockFetchManifest       func(ref string, opts ...crane.Option) ([]byte, error)
@@ -715,6 +722,66 @@ func TestMirror(t *testing.T) {
 				"crane pull xpkg.upbound.io/spaces-artifacts/xgql:v0.2.0-rc.0.167.gb4b3e68 testdata/output/xgql-v0.2.0-rc.0.167.gb4b3e68.tgz",
 			},
 		},
+		"SpaceVersion131FolderOutputIncludeCrossplane": {
+			version:       "1.13.1",
+			outputDir:     "testdata/output",
+			include:       []string{"crossplane", "universal-crossplane"},
+			expectedError: "",
+			mockFetchManifest: func(_ string, _ ...crane.Option) ([]byte, error) {
+				return []byte(`{"schemaVersion": 2}`), nil
+			},
+			mockGetValuesFromChart: mockGetValuesFromChart(pathNavigatorMockData{
+				imageTag:                []string{"v0.0.0-1116.g14cbfe6"},
+				kubeVersionPath:         []string{"v1.31.0"},
+				registerImageTag:        []string{"v0.0.0-1116.g14cbfe6"},
+				uxpVersionsPath:         []string{"1.18.0-up.1", "1.18.3-up.1", "1.18.5-up.1", "1.19.0-up.1", "1.19.2-up.1", "1.20.0-up.1"},
+				xgqlVersionPath:         []string{"v0.2.0-rc.0.167.gb4b3e68"},
+				routerProxyImageTagPath: []string{"v1.26-latest"},
+			}),
+			expectedOutput: []string{
+				"crane pull xpkg.upbound.io/spaces-artifacts/crossplane:v1.18.0-up.1 testdata/output/crossplane-v1.18.0-up.1.tgz",
+				"crane pull xpkg.upbound.io/spaces-artifacts/crossplane:v1.18.3-up.1 testdata/output/crossplane-v1.18.3-up.1.tgz",
+				"crane pull xpkg.upbound.io/spaces-artifacts/crossplane:v1.18.5-up.1 testdata/output/crossplane-v1.18.5-up.1.tgz",
+				"crane pull xpkg.upbound.io/spaces-artifacts/crossplane:v1.19.0-up.1 testdata/output/crossplane-v1.19.0-up.1.tgz",
+				"crane pull xpkg.upbound.io/spaces-artifacts/crossplane:v1.19.2-up.1 testdata/output/crossplane-v1.19.2-up.1.tgz",
+				"crane pull xpkg.upbound.io/spaces-artifacts/crossplane:v1.20.0-up.1 testdata/output/crossplane-v1.20.0-up.1.tgz",
+				"crane pull xpkg.upbound.io/spaces-artifacts/universal-crossplane:1.18.0-up.1 testdata/output/universal-crossplane-1.18.0-up.1.tgz",
+				"crane pull xpkg.upbound.io/spaces-artifacts/universal-crossplane:1.18.3-up.1 testdata/output/universal-crossplane-1.18.3-up.1.tgz",
+				"crane pull xpkg.upbound.io/spaces-artifacts/universal-crossplane:1.18.5-up.1 testdata/output/universal-crossplane-1.18.5-up.1.tgz",
+				"crane pull xpkg.upbound.io/spaces-artifacts/universal-crossplane:1.19.0-up.1 testdata/output/universal-crossplane-1.19.0-up.1.tgz",
+				"crane pull xpkg.upbound.io/spaces-artifacts/universal-crossplane:1.19.2-up.1 testdata/output/universal-crossplane-1.19.2-up.1.tgz",
+				"crane pull xpkg.upbound.io/spaces-artifacts/universal-crossplane:1.20.0-up.1 testdata/output/universal-crossplane-1.20.0-up.1.tgz",
+			},
+		},
+		"SpaceVersion131FolderOutputIncludeExclude": {
+			version:       "1.13.1",
+			outputDir:     "testdata/output",
+			include:       []string{"kube-*", "*crossplane*"},
+			exclude:       []string{"kube-state-metrics", "universal-*"},
+			expectedError: "",
+			mockFetchManifest: func(_ string, _ ...crane.Option) ([]byte, error) {
+				return []byte(`{"schemaVersion": 2}`), nil
+			},
+			mockGetValuesFromChart: mockGetValuesFromChart(pathNavigatorMockData{
+				imageTag:                []string{"v0.0.0-1116.g14cbfe6"},
+				kubeVersionPath:         []string{"v1.31.0"},
+				registerImageTag:        []string{"v0.0.0-1116.g14cbfe6"},
+				uxpVersionsPath:         []string{"1.18.0-up.1", "1.18.3-up.1", "1.18.5-up.1", "1.19.0-up.1", "1.19.2-up.1", "1.20.0-up.1"},
+				xgqlVersionPath:         []string{"v0.2.0-rc.0.167.gb4b3e68"},
+				routerProxyImageTagPath: []string{"v1.26-latest"},
+			}),
+			expectedOutput: []string{
+				"crane pull xpkg.upbound.io/spaces-artifacts/crossplane:v1.18.0-up.1 testdata/output/crossplane-v1.18.0-up.1.tgz",
+				"crane pull xpkg.upbound.io/spaces-artifacts/crossplane:v1.18.3-up.1 testdata/output/crossplane-v1.18.3-up.1.tgz",
+				"crane pull xpkg.upbound.io/spaces-artifacts/crossplane:v1.18.5-up.1 testdata/output/crossplane-v1.18.5-up.1.tgz",
+				"crane pull xpkg.upbound.io/spaces-artifacts/crossplane:v1.19.0-up.1 testdata/output/crossplane-v1.19.0-up.1.tgz",
+				"crane pull xpkg.upbound.io/spaces-artifacts/crossplane:v1.19.2-up.1 testdata/output/crossplane-v1.19.2-up.1.tgz",
+				"crane pull xpkg.upbound.io/spaces-artifacts/crossplane:v1.20.0-up.1 testdata/output/crossplane-v1.20.0-up.1.tgz",
+				"crane pull xpkg.upbound.io/spaces-artifacts/kube-apiserver:v1.31.0 testdata/output/kube-apiserver-v1.31.0.tgz",
+				"crane pull xpkg.upbound.io/spaces-artifacts/kube-controller-manager:v1.31.0 testdata/output/kube-controller-manager-v1.31.0.tgz",
+				"crane pull xpkg.upbound.io/spaces-artifacts/kube-scheduler:v1.31.0 testdata/output/kube-scheduler-v1.31.0.tgz",
+			},
+		},
 		"InvalidVersion": {
 			version:       "v2.invalid",
 			outputDir:     "testdata/output",
@@ -739,6 +806,8 @@ func TestMirror(t *testing.T) {
 				DryRun:              true,
 				path:                tc.outputDir,
 				DestinationRegistry: tc.destinationRegistry,
+				Include:             tc.include,
+				Exclude:             tc.exclude,
 				fetchManifest:       tc.mockFetchManifest,       // Inject the mock
 				getValuesFromChart:  tc.mockGetValuesFromChart,  // Inject the mock
 				getUxpV2RuntimeTags: tc.mockGetUxpV2RuntimeTags, // nil: stubbed below
@@ -806,6 +875,221 @@ func TestMirror(t *testing.T) {
 	}
 }
 
+func TestMirrorWriteChecksums(t *testing.T) {
+	t.Parallel()
+
+	t.Run("DryRun", func(t *testing.T) {
+		t.Parallel()
+
+		var stdout bytes.Buffer
+		printer := upterm.NewPrinter(&stdout, &stdout, config.FormatDefault, false)
+		cmd := &mirrorCmd{DryRun: true, path: "testdata/output"}
+
+		assert.NilError(t, cmd.writeChecksums(printer))
+		assert.Equal(t, "sha256sum testdata/output/*.tgz > testdata/output/SHA256SUMS\n", stdout.String())
+	})
+
+	t.Run("Write", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		var stdout bytes.Buffer
+		printer := upterm.NewPrinter(&stdout, &stdout, config.FormatDefault, false)
+		cmd := &mirrorCmd{
+			path: dir,
+			checksums: map[string]string{
+				"spaces-1.13.1.tgz": strings.Repeat("b", 64),
+				"agent-0.0.1.tgz":   strings.Repeat("a", 64),
+			},
+		}
+
+		assert.NilError(t, cmd.writeChecksums(printer))
+		got, err := os.ReadFile(filepath.Join(dir, checksumsFile))
+		assert.NilError(t, err)
+		want := strings.Repeat("a", 64) + "  agent-0.0.1.tgz\n" + strings.Repeat("b", 64) + "  spaces-1.13.1.tgz\n"
+		assert.Equal(t, want, string(got))
+
+		sums, err := parseChecksums(got)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, cmd.checksums, sums)
+	})
+}
+
+func TestMirrorVerify(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		files          map[string]string
+		expectedError  string
+		expectedOutput string
+	}{
+		"AllMatch": {
+			files: map[string]string{
+				"agent.tgz":  "agent",
+				"spaces.tgz": "spaces",
+			},
+			expectedOutput: "agent.tgz: OK\nspaces.tgz: OK\n",
+		},
+		"Mismatch": {
+			files: map[string]string{
+				"agent.tgz":  "tampered",
+				"spaces.tgz": "spaces",
+			},
+			expectedError:  "1 of 2 artifacts failed checksum verification",
+			expectedOutput: "agent.tgz: FAILED\nspaces.tgz: OK\n",
+		},
+		"Missing": {
+			files: map[string]string{
+				"agent.tgz": "agent",
+			},
+			expectedError:  "1 of 2 artifacts failed checksum verification",
+			expectedOutput: "agent.tgz: OK\nspaces.tgz: FAILED open or read\n",
+		},
+	}
+
+	for testName, tc := range tcs {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			fs := afero.NewMemMapFs()
+			for name, content := range tc.files {
+				assert.NilError(t, afero.WriteFile(fs, name, []byte(content), 0o644))
+			}
+			sums := fmt.Sprintf("%s  agent.tgz\n%s *spaces.tgz\n", sha256Hex("agent"), sha256Hex("spaces"))
+			assert.NilError(t, afero.WriteFile(fs, checksumsFile, []byte(sums), 0o644))
+
+			var stdout bytes.Buffer
+			printer := upterm.NewPrinter(&stdout, &stdout, config.FormatDefault, false)
+			cmd := &mirrorVerifyCmd{fs: fs}
+
+			err := cmd.Run(printer)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NilError(t, err)
+			}
+			assert.Equal(t, tc.expectedOutput, stdout.String())
+		})
+	}
+}
+
+func TestMirrorFromManifest(t *testing.T) {
+	t.Parallel()
+
+	crossplaneDigest := "sha256:" + strings.Repeat("a", 64)
+	spacesDigest := "sha256:" + strings.Repeat("b", 64)
+	manifest := &mirrorManifest{
+		Version: "1.13.1",
+		Artifacts: []mirroredArtifact{
+			{Image: "xpkg.upbound.io/spaces-artifacts/crossplane", Tag: "v1.20.0-up.1", Digest: crossplaneDigest},
+			{Image: "xpkg.upbound.io/spaces-artifacts/spaces", Tag: "1.13.1", Digest: spacesDigest},
+		},
+	}
+
+	tcs := map[string]struct {
+		outputDir           string
+		destinationRegistry string
+		exclude             []string
+		expectedOutput      string
+	}{
+		"FolderOutput": {
+			outputDir: "testdata/output",
+			expectedOutput: "crane pull xpkg.upbound.io/spaces-artifacts/crossplane@" + crossplaneDigest + " testdata/output/crossplane-v1.20.0-up.1.tgz\n" +
+				"crane pull xpkg.upbound.io/spaces-artifacts/spaces@" + spacesDigest + " testdata/output/spaces-1.13.1.tgz\n",
+		},
+		"RegistryOutput": {
+			destinationRegistry: "myregistry.io",
+			expectedOutput: "crane copy xpkg.upbound.io/spaces-artifacts/crossplane@" + crossplaneDigest + " myregistry.io/crossplane:v1.20.0-up.1\n" +
+				"crane copy xpkg.upbound.io/spaces-artifacts/spaces@" + spacesDigest + " myregistry.io/spaces:1.13.1\n",
+		},
+		"Filtered": {
+			destinationRegistry: "myregistry.io",
+			exclude:             []string{"spaces"},
+			expectedOutput:      "crane copy xpkg.upbound.io/spaces-artifacts/crossplane@" + crossplaneDigest + " myregistry.io/crossplane:v1.20.0-up.1\n",
+		},
+	}
+
+	for testName, tc := range tcs {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			var stdout bytes.Buffer
+			printer := upterm.NewPrinter(&stdout, &stdout, config.FormatDefault, false)
+
+			cmd := &mirrorCmd{
+				DryRun:              true,
+				path:                tc.outputDir,
+				DestinationRegistry: tc.destinationRegistry,
+				Exclude:             tc.exclude,
+				manifest:            manifest,
+				fetchManifest: func(ref string, _ ...crane.Option) ([]byte, error) {
+					if !strings.Contains(ref, "@sha256:") {
+						return nil, fmt.Errorf("expected a digest reference, got %q", ref)
+					}
+					return []byte(`{"schemaVersion": 2}`), nil
+				},
+				getValuesFromChart: func(_, _ string, _ oci.PathNavigator, _, _ string) ([]string, error) {
+					return nil, errors.New("unexpected chart lookup when mirroring from a manifest")
+				},
+			}
+
+			assert.NilError(t, cmd.Run(printer))
+			assert.Equal(t, tc.expectedOutput, stdout.String())
+		})
+	}
+}
+
+func TestParseMirrorManifest(t *testing.T) {
+	t.Parallel()
+
+	digest := "sha256:" + strings.Repeat("a", 64)
+	tcs := map[string]struct {
+		manifest      string
+		expectedError string
+	}{
+		"Valid": {
+			manifest: "version: 1.13.1\nartifacts:\n- image: xpkg.upbound.io/spaces-artifacts/spaces\n  tag: 1.13.1\n  digest: " + digest + "\n",
+		},
+		"MissingVersion": {
+			manifest:      "artifacts: []\n",
+			expectedError: "manifest does not specify a Spaces version",
+		},
+		"MissingDigest": {
+			manifest:      "version: 1.13.1\nartifacts:\n- image: xpkg.upbound.io/spaces-artifacts/spaces\n  tag: 1.13.1\n",
+			expectedError: "artifact 0 must specify an image, tag, and digest",
+		},
+		"InvalidDigest": {
+			manifest:      "version: 1.13.1\nartifacts:\n- image: xpkg.upbound.io/spaces-artifacts/spaces\n  tag: 1.13.1\n  digest: sha256:abc\n",
+			expectedError: "invalid artifact 0",
+		},
+	}
+
+	for testName, tc := range tcs {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := parseMirrorManifest([]byte(tc.manifest))
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			assert.NilError(t, err)
+
+			// A parsed manifest round-trips through the writer.
+			path := filepath.Join(t.TempDir(), "manifest.yaml")
+			assert.NilError(t, writeMirrorManifest(path, m))
+			got, err := readMirrorManifest(path)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, m, got)
+		})
+	}
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
 type pathNavigatorMockData struct {
 	imageTag                []string
 	kubeVersionPath         []string
@@ -0,0 +1,84 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package space
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"gopkg.in/yaml.v3"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// mirrorManifest records the artifacts mirrored for a Spaces version and their
+// digests, so that exactly the same artifacts can be mirrored again.
+type mirrorManifest struct {
+	Version   string             `yaml:"version"`
+	Artifacts []mirroredArtifact `yaml:"artifacts"`
+}
+
+type mirroredArtifact struct {
+	Image  string `yaml:"image"`
+	Tag    string `yaml:"tag"`
+	Digest string `yaml:"digest,omitempty"`
+}
+
+// tagged returns the tagged reference of the artifact.
+func (a mirroredArtifact) tagged() string {
+	return fmt.Sprintf("%s:%s", a.Image, a.Tag)
+}
+
+// source returns the reference the artifact is mirrored from: its digest if
+// known, otherwise its tag.
+func (a mirroredArtifact) source() string {
+	if a.Digest == "" {
+		return a.tagged()
+	}
+	return fmt.Sprintf("%s@%s", a.Image, a.Digest)
+}
+
+// parseMirrorManifest parses and validates a manifest written by --manifest.
+func parseMirrorManifest(data []byte) (*mirrorManifest, error) {
+	m := &mirrorManifest{}
+	if err := yaml.Unmarshal(data, m); err != nil {
+		return nil, errors.Wrap(err, "cannot unmarshal manifest")
+	}
+	if m.Version == "" {
+		return nil, errors.New("manifest does not specify a Spaces version")
+	}
+	for i, a := range m.Artifacts {
+		if a.Image == "" || a.Tag == "" || a.Digest == "" {
+			return nil, errors.Errorf("artifact %d must specify an image, tag, and digest", i)
+		}
+		if _, err := name.NewDigest(a.source()); err != nil {
+			return nil, errors.Wrapf(err, "invalid artifact %d", i)
+		}
+	}
+	return m, nil
+}
+
+func readMirrorManifest(path string) (*mirrorManifest, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // Reading a user-provided file is intended.
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read manifest %s", path)
+	}
+	m, err := parseMirrorManifest(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid manifest %s", path)
+	}
+	return m, nil
+}
+
+func writeMirrorManifest(path string, m *mirrorManifest) error {
+	data, err := yaml.Marshal(m)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal manifest")
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil { //nolint:gosec // nothing system sensitive in the file
+		return errors.Wrapf(err, "cannot write manifest %s", path)
+	}
+	return nil
+}
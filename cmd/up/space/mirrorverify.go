@@ -0,0 +1,64 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package space
+
+import (
+	"slices"
+
+	"github.com/spf13/afero"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up/internal/upterm"
+)
+
+type mirrorVerifyCmd struct {
+	Dir string `arg:"" help:"The directory the artifacts were mirrored to with --checksums." type:"existingdir"`
+
+	fs afero.Fs
+}
+
+// AfterApply sets default values in command after assignment and validation.
+func (c *mirrorVerifyCmd) AfterApply() error {
+	c.fs = afero.NewBasePathFs(afero.NewOsFs(), c.Dir)
+	return nil
+}
+
+// Run executes the verify command.
+func (c *mirrorVerifyCmd) Run(p upterm.Printer) error {
+	data, err := afero.ReadFile(c.fs, checksumsFile)
+	if err != nil {
+		return errors.Wrapf(err, "cannot read %s", checksumsFile)
+	}
+	sums, err := parseChecksums(data)
+	if err != nil {
+		return errors.Wrapf(err, "cannot parse %s", checksumsFile)
+	}
+
+	names := make([]string, 0, len(sums))
+	for name := range sums {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	failed := 0
+	for _, name := range names {
+		sum, err := fileSHA256(c.fs, name)
+		switch {
+		case err != nil:
+			p.Printfln("%s: FAILED open or read", name)
+			failed++
+		case sum != sums[name]:
+			p.Printfln("%s: FAILED", name)
+			failed++
+		default:
+			p.Printfln("%s: OK", name)
+		}
+	}
+
+	if failed > 0 {
+		return errors.Errorf("%d of %d artifacts failed checksum verification", failed, len(names))
+	}
+	return nil
+}
@@ -28,11 +28,12 @@ type Cmd struct {
 	Connect    connectCmd    `aliases:"attach" cmd:"" help:"Connect an Upbound Space to the Upbound web console."`
 	Disconnect disconnectCmd `aliases:"detach" cmd:"" help:"Disconnect an Upbound Space from the Upbound web console."`
 
-	Destroy destroyCmd `cmd:"" help:"Remove the Upbound Spaces deployment."`
-	Init    initCmd    `cmd:"" help:"Initialize an Upbound Spaces deployment."`
-	List    listCmd    `cmd:"" help:"List all accessible spaces in Upbound."`
-	Mirror  mirrorCmd  `cmd:"" help:"Managing the mirroring of artifacts to local storage or private container registries."`
-	Upgrade upgradeCmd `cmd:"" help:"Upgrade the Upbound Spaces deployment."`
+	Describe describeCmd    `cmd:"" help:"Describe a space in Upbound, including its ingress."`
+	Destroy  destroyCmd     `cmd:"" help:"Remove the Upbound Spaces deployment."`
+	Init     initCmd        `cmd:"" help:"Initialize an Upbound Spaces deployment."`
+	List     listCmd        `cmd:"" help:"List all accessible spaces in Upbound."`
+	Mirror   mirrorGroupCmd `cmd:"" help:"Managing the mirroring of artifacts to local storage or private container registries."`
+	Upgrade  upgradeCmd     `cmd:"" help:"Upgrade the Upbound Spaces deployment."`
 
 	Billing billing.Cmd `cmd:""`
 	License license.Cmd `cmd:""`
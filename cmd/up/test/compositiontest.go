@@ -6,7 +6,6 @@ package test
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/spf13/afero"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -32,13 +31,14 @@ func (c *runCmd) runCompositionTests(ctx context.Context, upCtx *upbound.Context
 			Project: c.proj.Project,
 			// Use the original projFS here so schema generation knows the real
 			// path.
-			ProjFS:             c.projFS,
-			Concurrency:        c.concurrency,
-			NoBuildCache:       c.NoBuildCache,
-			BuildCacheDir:      c.BuildCacheDir,
-			DependencyManager:  c.m,
-			FunctionIdentifier: c.functionIdentifier,
-			EventChannel:       ch,
+			ProjFS:                c.projFS,
+			Concurrency:           c.concurrency,
+			NoBuildCache:          c.NoBuildCache,
+			BuildCacheDir:         c.BuildCacheDir,
+			FunctionBuildCacheDir: c.FunctionBuildCache,
+			DependencyManager:     c.m,
+			FunctionIdentifier:    c.functionIdentifier,
+			EventChannel:          ch,
 		}
 
 		fns, err := render.BuildEmbeddedFunctionsLocalDaemon(ctx, upCtx, functionOptions)
@@ -53,40 +53,57 @@ func (c *runCmd) runCompositionTests(ctx context.Context, upCtx *upbound.Context
 		return 0, 0, 0, err
 	}
 
+	var testFiles []string
+	if c.Update {
+		testFiles, err = c.yamlTestFiles()
+		if err != nil {
+			return 0, 0, 0, err
+		}
+	}
+
 	// Create an overlay filesystem so we can write resources to temporary files
 	// that will be used during render only.
 	overlayFS := filesystem.MemOverlay(c.projFS)
 	var finalErr error
 	for _, test := range tests {
 		total++
+		c.recordTest(test.Name, async.EventStatusStarted, nil)
 
-		testFiles, err := c.prepareTestFiles(overlayFS, test)
+		paths, err := c.prepareTestFiles(overlayFS, test)
 		if err != nil {
+			err = errors.Wrapf(err, "cannot prepare test %s", test.Name)
 			errs++
 			finalErr = errors.Join(finalErr, err)
+			c.recordTest(test.Name, async.EventStatusFailure, err)
 			continue
 		}
 
-		options := c.buildRenderOptions(overlayFS, test, testFiles)
-		renderCtx, cancel := context.WithTimeout(ctx, time.Duration(test.Spec.TimeoutSeconds)*time.Second)
+		options := c.buildRenderOptions(overlayFS, test, paths)
+		renderCtx, cancel := context.WithTimeout(ctx, c.testTimeout(test.Spec.TimeoutSeconds))
 		defer cancel()
 
 		output, err := render.Render(renderCtx, log, efns, options)
 		if err != nil {
 			errs++
 			finalErr = errors.Join(finalErr, err)
+			c.recordTest(test.Name, async.EventStatusFailure, err)
 			printer.Println(err)
 			continue
 		}
 
 		if err = printer.WrapAsyncWithSuccessSpinners(func(ch async.EventChannel) error {
 			return assertions(ctx, output, test.Name, test.Spec.AssertResources, ch, printer)
-		}); err != nil {
+		}); err != nil && c.Update {
+			err = c.updateAssertions(testFiles, test.Name, output, printer)
+		}
+		if err != nil {
 			errs++
 			finalErr = errors.Join(finalErr, err)
+			c.recordTest(test.Name, async.EventStatusFailure, err)
 			continue
 		}
 		success++
+		c.recordTest(test.Name, async.EventStatusSuccess, nil)
 	}
 
 	return total, success, errs, finalErr
@@ -106,22 +123,40 @@ func (c *runCmd) prepareTestFiles(overlayFS afero.Fs, test compositiontest.Compo
 		context: make(map[string]string),
 	}
 
-	observedResourcesPath, err := writeToFile(overlayFS, test.Spec.ObservedResources, "observed")
+	observed, err := expandResources(test.Spec.ObservedResources, c.vars, "observed resources")
+	if err != nil {
+		return nil, err
+	}
+	observedResourcesPath, err := writeToFile(overlayFS, observed, "observed")
 	if err != nil {
 		return nil, err
 	}
 	paths.observedResources = observedResourcesPath
 
-	extraResourcesPath, err := writeToFile(overlayFS, test.Spec.ExtraResources, "extraresources")
+	extra, err := expandResources(test.Spec.ExtraResources, c.vars, "extra resources")
+	if err != nil {
+		return nil, err
+	}
+	extraResourcesPath, err := writeToFile(overlayFS, extra, "extraresources")
 	if err != nil {
 		return nil, err
 	}
 	paths.extraResources = extraResourcesPath
 
-	xrPath, err := c.resolveResourcePath(overlayFS, test.Spec.XRPath, test.Spec.XR, "xr")
+	xr, err := expandResources([]runtime.RawExtension{test.Spec.XR}, c.vars, "XR")
+	if err != nil {
+		return nil, err
+	}
+	xrPath, err := c.resolveResourcePath(overlayFS, test.Spec.XRPath, xr[0], "xr")
 	if err != nil {
 		return nil, err
 	}
+	if len(test.Spec.XR.Raw) == 0 {
+		xrPath, err = expandResourceFile(overlayFS, xrPath, c.vars, "xr")
+		if err != nil {
+			return nil, err
+		}
+	}
 	paths.xr = xrPath
 
 	compositionPath, err := c.resolveResourcePath(overlayFS, test.Spec.CompositionPath, test.Spec.Composition, "composition")
@@ -27,7 +27,7 @@ import (
 
 	upboundpkgv1alpha1 "github.com/upbound/up-sdk-go/apis/pkg/v1alpha1"
 	upboundpkgv1beta1 "github.com/upbound/up-sdk-go/apis/pkg/v1beta1"
-	"github.com/upbound/up/cmd/up/project/common"
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
 	"github.com/upbound/up/internal/async"
 	"github.com/upbound/up/internal/ctp"
 	"github.com/upbound/up/internal/filesystem"
@@ -65,19 +65,24 @@ func (c *runCmd) runE2ETests(ctx context.Context, upCtx *upbound.Context, tests
 		}
 	}
 
-	b := project.NewBuilder(
+	bopts := []project.BuilderOption{
 		project.BuildWithMaxConcurrency(c.concurrency),
 		project.BuildWithFunctionIdentifier(c.functionIdentifier),
-	)
+	}
+	if !c.NoBuildCache {
+		bopts = append(bopts, project.BuildWithFunctionCache(project.SharedFunctionCache(c.BuildCacheDir)))
+	}
+	b := project.NewBuilder(bopts...)
 
 	var imgMap project.ImageTagMap
 	if err = printer.WrapAsyncWithSuccessSpinners(func(ch async.EventChannel) error {
-		imgMap, err = b.Build(ctx, upCtx, c.proj.Project, c.projFS,
+		opts := c.ImageMetadata.BuildOptions(c)
+		opts = append(opts,
 			project.BuildWithEventChannel(ch),
-			project.BuildWithImageLabels(common.ImageLabels(c)),
 			project.BuildWithDependencyManager(c.m),
 			project.BuildWithProjectBasePath(basePath),
 		)
+		imgMap, err = b.Build(ctx, upCtx, c.proj.Project, c.projFS, opts...)
 		return err
 	}); err != nil {
 		return 0, 0, 0, err
@@ -99,13 +104,16 @@ func (c *runCmd) runE2ETests(ctx context.Context, upCtx *upbound.Context, tests
 
 	for _, test := range tests {
 		total++
+		c.recordTest(test.Name, async.EventStatusStarted, nil)
 		err = c.executeE2ETest(ctx, upCtx, c.proj, imgMap, test, printer)
 		if err != nil {
 			errs++
 			finalErr = errors.Join(finalErr, err)
+			c.recordTest(test.Name, async.EventStatusFailure, err)
 			continue
 		}
 		success++
+		c.recordTest(test.Name, async.EventStatusSuccess, nil)
 	}
 
 	return total, success, errs, finalErr
@@ -122,8 +130,9 @@ func (c *runCmd) executeE2ETest(ctx context.Context, upCtx *upbound.Context, pro
 		return errors.Wrap(err, "failed to create control plane")
 	}
 
-	// Determine if we should skip deleting test manifests (only from test spec)
-	skipTestManifestDeletion := test.Spec.SkipDelete != nil && *test.Spec.SkipDelete
+	// Determine if we should skip deleting test manifests, from either the
+	// CLI flag or the test spec
+	skipTestManifestDeletion := c.SkipDelete || (test.Spec.SkipDelete != nil && *test.Spec.SkipDelete)
 
 	// Determine if we should skip cleanup of managed resources and control plane teardown
 	// This is triggered by either the CLI flag or the test spec skipDelete
@@ -151,10 +160,14 @@ func (c *runCmd) executeE2ETest(ctx context.Context, upCtx *upbound.Context, pro
 			opts := []ctp.EnsureDevControlPlaneOption{
 				ctp.WithEventChannel(ch),
 				ctp.WithSpacesGroup(c.ControlPlaneGroup),
+				ctp.WithSpacesClass(c.ControlPlaneClass),
+				ctp.WithSpacesReadyTimeout(c.ReadyTimeout),
 				ctp.WithControlPlaneName(controlPlaneName),
 				ctp.SkipDevCheck(c.Force),
 				ctp.ForceLocal(c.Local),
 				ctp.WithLocalRegistryDirectory(c.LocalRegistryPath),
+				ctp.WithLocalKindImage(c.KindImage),
+				ctp.WithLocalClusterName(c.ReuseCluster),
 				ctp.WithClusterAdmin(c.ClusterAdmin),
 				ctp.SkipPrometheus(true),
 				ctp.WithLocalHelmValues(helmValues),
@@ -166,6 +179,8 @@ func (c *runCmd) executeE2ETest(ctx context.Context, upCtx *upbound.Context, pro
 					ctp.WithLocalCrossplaneVersion(c.ControlPlaneVersion),
 					ctp.WithSpacesCrossplaneVersionConstraint(c.ControlPlaneVersion),
 				)
+			case c.CrossplaneChannel != "":
+				opts = append(opts, ctp.WithSpacesCrossplaneChannel(spacesv1beta1.CrossplaneUpgradeChannel(c.CrossplaneChannel)))
 			case test.Spec.Crossplane != nil:
 				opts = append(opts, ctp.WithSpacesCrossplaneSpec(*test.Spec.Crossplane))
 				if test.Spec.Crossplane.Version != nil {
@@ -365,16 +380,24 @@ func (c *runCmd) executeE2ETest(ctx context.Context, upCtx *upbound.Context, pro
 		SetDefaultTimeout(time.Duration(*test.Spec.TimeoutSeconds) * time.Second).
 		SetDirectory(tempDir).
 		SetSkipDelete(skipTestManifestDeletion).
-		SetSkipUpdate(true).
-		SetSkipImport(true).
+		SetSkipUpdate(c.SkipUpdate).
+		SetSkipImport(c.SkipImport).
 		SetSkipWebhookCheck(true).
 		SetOnlyCleanUptestResources(true).
 		SetRenderOnly(false).
-		SetLogCollectionInterval(10 * time.Second).
+		SetLogCollectionInterval(c.LogCollectionInterval).
 		SetUseLibraryMode(true).
 		Build()
 
 	if err := uptest.RunTestContext(ctx, automatedTest); err != nil {
+		if c.ArtifactsDir != "" {
+			dir := filepath.Join(c.ArtifactsDir, test.Name)
+			if aerr := saveArtifacts(tempDir, dir); aerr != nil {
+				printer.Printfln("Failed to save artifacts for test %s: %v", test.Name, aerr)
+			} else {
+				printer.Printfln("Saved artifacts for test %s to %s", test.Name, dir)
+			}
+		}
 		return errors.Wrap(err, "uptest failed")
 	}
 
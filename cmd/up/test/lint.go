@@ -0,0 +1,194 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up/internal/project"
+	"github.com/upbound/up/internal/schemas/runner"
+	"github.com/upbound/up/internal/test"
+	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/upterm"
+	"github.com/upbound/up/pkg/apis"
+	compositiontest "github.com/upbound/up/pkg/apis/compositiontest/v1alpha1"
+	e2etest "github.com/upbound/up/pkg/apis/e2etest/v1alpha1"
+	operationtest "github.com/upbound/up/pkg/apis/operationtest/v1alpha1"
+
+	_ "embed"
+)
+
+// lintCmd is the `up test lint` command.
+type lintCmd struct {
+	Patterns    []string `arg:""                 help:"The path to the test manifests"`
+	ProjectFile string   `default:"upbound.yaml" help:"Path to project definition file." short:"f"`
+	CacheDir    string   `default:"~/.up/cache/" env:"CACHE_DIR"                         help:"Directory used for caching dependencies."         type:"path"`
+	Output      string   `default:"text"         enum:"text,json"                        help:"Output format for the results: 'text' or 'json'." short:"o"`
+
+	projFS       afero.Fs
+	testFS       afero.Fs
+	schemaRunner runner.SchemaRunner
+	m            *project.DependencyManager
+	proj         *project.WithVersion
+}
+
+//go:embed help/lint.md
+var lintHelp string
+
+func (c *lintCmd) Help() string {
+	return lintHelp
+}
+
+// lintResult is the result of linting the tests in one directory.
+type lintResult struct {
+	File   string   `json:"file"`
+	Tests  int      `json:"tests"`
+	Errors []string `json:"errors,omitempty"`
+}
+
+// AfterApply processes flags and sets defaults.
+func (c *lintCmd) AfterApply(upCtx *upbound.Context) error {
+	// Read the project file.
+	projFilePath, err := filepath.Abs(c.ProjectFile)
+	if err != nil {
+		return err
+	}
+	// The location of the project file defines the root of the project.
+	projDirPath := filepath.Dir(projFilePath)
+	c.projFS = afero.NewBasePathFs(afero.NewOsFs(), projDirPath)
+
+	proj, err := project.ParseWithVersion(c.projFS, filepath.Base(c.ProjectFile))
+	if err != nil {
+		return err
+	}
+	proj.Default()
+	c.proj = proj
+
+	c.testFS = afero.NewBasePathFs(c.projFS, proj.Spec.Paths.Tests)
+	c.schemaRunner = runner.NewRealSchemaRunner(
+		runner.WithImageConfig(proj.Spec.ImageConfig),
+	)
+
+	cchFS := afero.NewBasePathFs(afero.NewOsFs(), c.CacheDir)
+	m, err := project.NewDependencyManager(upCtx, proj.Project, c.projFS,
+		project.WithCacheFS(cchFS),
+	)
+	if err != nil {
+		return err
+	}
+	c.m = m
+
+	return nil
+}
+
+// Run is the body of the command.
+func (c *lintCmd) Run(ctx context.Context, printer upterm.Printer) error {
+	var results []lintResult
+	if err := printer.WrapWithSuccessSpinner(
+		"Parsing tests",
+		func() error {
+			if err := apis.GenerateSchema(ctx, c.m.SchemaManager()); err != nil {
+				return errors.Wrap(err, "unable to generate meta apis schemas")
+			}
+
+			var err error
+			results, err = lintTests(ctx, c.testFS, c.Patterns, c.proj.Spec.Paths.Tests, test.NewBuilder(test.BuildWithSchemaRunner(c.schemaRunner)))
+			return err
+		},
+	); err != nil {
+		return err
+	}
+
+	if len(results) == 0 {
+		printer.PrintError("No test files found")
+		return nil
+	}
+
+	invalid := 0
+	for _, r := range results {
+		if len(r.Errors) > 0 {
+			invalid++
+		}
+	}
+
+	if c.Output == "json" {
+		b, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "cannot marshal lint results")
+		}
+		printer.PrintResult(string(b))
+	} else {
+		for _, r := range results {
+			if len(r.Errors) == 0 {
+				printer.PrintSuccess(fmt.Sprintf("%s: %d valid test(s)", r.File, r.Tests))
+				continue
+			}
+			for _, e := range r.Errors {
+				printer.PrintError(fmt.Sprintf("%s: %s", r.File, e))
+			}
+		}
+	}
+
+	if invalid > 0 {
+		return errors.Errorf("%d of %d test files are invalid", invalid, len(results))
+	}
+	return nil
+}
+
+// lintTests builds the tests in each directory matching patterns and validates
+// them, without running them.
+func lintTests(ctx context.Context, fs afero.Fs, patterns []string, testsFolder string, builder test.Builder) ([]lintResult, error) {
+	dirs, err := test.DiscoverTestDirectories(fs, patterns, testsFolder)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to discover test directories")
+	}
+
+	results := make([]lintResult, 0, len(dirs))
+	for _, dir := range dirs {
+		r := lintResult{File: path.Join(testsFolder, dir)}
+
+		parsed, err := builder.Build(ctx, fs, []string{dir}, "")
+		switch {
+		case err != nil:
+			r.Errors = append(r.Errors, err.Error())
+		case len(parsed) == 0:
+			r.Errors = append(r.Errors, "no tests found")
+		}
+
+		for _, t := range parsed {
+			r.Tests++
+			if err := validateTest(t); err != nil {
+				r.Errors = append(r.Errors, err.Error())
+			}
+		}
+		results = append(results, r)
+	}
+
+	return results, nil
+}
+
+// validateTest validates a single parsed test.
+func validateTest(t any) error {
+	switch tt := t.(type) {
+	case compositiontest.CompositionTest:
+		_, err := compositiontest.Convert([]any{tt})
+		return errors.Wrapf(err, "test %q", tt.GetName())
+	case operationtest.OperationTest:
+		_, err := operationtest.Convert([]any{tt})
+		return errors.Wrapf(err, "test %q", tt.GetName())
+	case e2etest.E2ETest:
+		_, err := e2etest.Convert([]any{tt})
+		return errors.Wrapf(err, "test %q", tt.GetName())
+	default:
+		return errors.Errorf("unknown test type %T", t)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up/internal/test"
+	compositiontest "github.com/upbound/up/pkg/apis/compositiontest/v1alpha1"
+)
+
+// fakeBuilder returns the tests configured for each test directory.
+type fakeBuilder struct {
+	tests map[string][]any
+	errs  map[string]error
+}
+
+func (b *fakeBuilder) Build(_ context.Context, _ afero.Fs, patterns []string, _ string, _ ...test.BuildOption) ([]any, error) {
+	return b.tests[patterns[0]], b.errs[patterns[0]]
+}
+
+func TestLintTests(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	for _, dir := range []string{"valid", "invalid", "broken", "empty"} {
+		if err := fs.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	builder := &fakeBuilder{
+		tests: map[string][]any{
+			"valid": {
+				compositiontest.CompositionTest{
+					ObjectMeta: metav1.ObjectMeta{Name: "ok"},
+					Spec:       compositiontest.CompositionTestSpec{XRPath: "xr.yaml"},
+				},
+			},
+			"invalid": {
+				compositiontest.CompositionTest{
+					ObjectMeta: metav1.ObjectMeta{Name: "both-xrs"},
+					Spec: compositiontest.CompositionTestSpec{
+						XR:     runtime.RawExtension{Raw: []byte(`{}`)},
+						XRPath: "xr.yaml",
+					},
+				},
+			},
+		},
+		errs: map[string]error{
+			"broken": errors.New("failed to decode YAML document"),
+		},
+	}
+
+	results, err := lintTests(t.Context(), fs, []string{"*"}, "tests", builder)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := map[string]string{
+		"tests/valid":   "",
+		"tests/invalid": `test "both-xrs": only one of 'xr' or 'xrPath' may be specified`,
+		"tests/broken":  "failed to decode YAML document",
+		"tests/empty":   "no tests found",
+	}
+	if len(results) != len(want) {
+		t.Fatalf("Expected %d results, but got %d: %+v", len(want), len(results), results)
+	}
+	for _, r := range results {
+		wantErr, ok := want[r.File]
+		if !ok {
+			t.Errorf("Unexpected result for %q", r.File)
+			continue
+		}
+		if wantErr == "" {
+			if len(r.Errors) > 0 {
+				t.Errorf("%s: unexpected errors: %v", r.File, r.Errors)
+			}
+			continue
+		}
+		if !strings.Contains(strings.Join(r.Errors, "\n"), wantErr) {
+			t.Errorf("%s: expected errors to contain %q, but got %v", r.File, wantErr, r.Errors)
+		}
+	}
+}
@@ -6,7 +6,6 @@ package test
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/spf13/afero"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -31,14 +30,15 @@ func (c *runCmd) runOperationTests(ctx context.Context, upCtx *upbound.Context,
 	var efns []v1.Function
 	err := printer.WrapAsyncWithSuccessSpinners(func(ch async.EventChannel) error {
 		functionOptions := render.FunctionOptions{
-			Project:            c.proj.Project,
-			ProjFS:             c.projFS,
-			Concurrency:        c.concurrency,
-			NoBuildCache:       c.NoBuildCache,
-			BuildCacheDir:      c.BuildCacheDir,
-			DependencyManager:  c.m,
-			FunctionIdentifier: c.functionIdentifier,
-			EventChannel:       ch,
+			Project:               c.proj.Project,
+			ProjFS:                c.projFS,
+			Concurrency:           c.concurrency,
+			NoBuildCache:          c.NoBuildCache,
+			BuildCacheDir:         c.BuildCacheDir,
+			FunctionBuildCacheDir: c.FunctionBuildCache,
+			DependencyManager:     c.m,
+			FunctionIdentifier:    c.functionIdentifier,
+			EventChannel:          ch,
 		}
 
 		fns, err := render.BuildEmbeddedFunctionsLocalDaemon(ctx, upCtx, functionOptions)
@@ -59,18 +59,20 @@ func (c *runCmd) runOperationTests(ctx context.Context, upCtx *upbound.Context,
 	var finalErr error
 	for _, test := range tests {
 		total++
+		c.recordTest(test.Name, async.EventStatusStarted, nil)
 
 		testFiles, err := c.prepareOperationTestFiles(overlayFS, test)
 		if err != nil {
 			errs++
 			finalErr = errors.Join(finalErr, err)
+			c.recordTest(test.Name, async.EventStatusFailure, err)
 			continue
 		}
 
 		options := c.buildOperationRenderOptions(overlayFS, test, testFiles)
 
 		// Set timeout context
-		renderCtx, cancel := context.WithTimeout(ctx, time.Duration(test.Spec.TimeoutSeconds)*time.Second)
+		renderCtx, cancel := context.WithTimeout(ctx, c.testTimeout(test.Spec.TimeoutSeconds))
 		defer cancel()
 
 		// Render the operation
@@ -78,6 +80,7 @@ func (c *runCmd) runOperationTests(ctx context.Context, upCtx *upbound.Context,
 		if err != nil {
 			errs++
 			finalErr = errors.Join(finalErr, errors.Wrapf(err, "failed to render operation for test %s", test.Name))
+			c.recordTest(test.Name, async.EventStatusFailure, err)
 			printer.PrintError(err)
 			continue
 		}
@@ -88,9 +91,11 @@ func (c *runCmd) runOperationTests(ctx context.Context, upCtx *upbound.Context,
 		}); err != nil {
 			errs++
 			finalErr = errors.Join(finalErr, err)
+			c.recordTest(test.Name, async.EventStatusFailure, err)
 			continue
 		}
 		success++
+		c.recordTest(test.Name, async.EventStatusSuccess, nil)
 	}
 
 	return total, success, errs, finalErr
@@ -7,11 +7,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/fs"
 	"log"
 	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"time"
 
@@ -25,15 +28,18 @@ import (
 	chainsawerrors "github.com/kyverno/chainsaw/pkg/engine/operations/errors"
 	chainsawcompilers "github.com/kyverno/kyverno-json/pkg/core/compilers"
 	"github.com/spf13/afero"
+	yamlv3 "gopkg.in/yaml.v3"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/utils/ptr"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 
+	"github.com/upbound/up/cmd/up/project/common"
 	"github.com/upbound/up/internal/async"
 	"github.com/upbound/up/internal/ctp"
 	"github.com/upbound/up/internal/install/helm"
@@ -57,23 +63,30 @@ import (
 
 // runCmd is the `up test run` command.
 type runCmd struct {
-	Patterns                []string `arg:""                                                                                                                                     help:"The path to the test manifests"`
-	ProjectFile             string   `default:"upbound.yaml"                                                                                                                     help:"Path to project definition file."                                                            short:"f"`
-	Repository              string   `help:"Repository for the built package. Overrides the repository specified in the project file."                                           optional:""`
-	NoBuildCache            bool     `default:"false"                                                                                                                            help:"Don't cache image layers while building."`
-	BuildCacheDir           string   `default:"~/.up/build-cache"                                                                                                                help:"Path to the build cache directory."                                                          type:"path"`
-	MaxConcurrency          uint     `default:"8"                                                                                                                                env:"UP_MAX_CONCURRENCY"                                                                           help:"Maximum number of functions to build and push at once."`
-	ControlPlaneGroup       string   `help:"The control plane group that the control plane to use is contained in. This defaults to the group specified in the current context."`
-	ControlPlaneNamePrefix  string   `help:"Prefix of the control plane name to use. It will be created if not found."`
-	ControlPlaneVersion     string   `help:"Version of Crossplane to use for the control plane. By default, the latest compatible version will be used."`
-	Force                   bool     `alias:"allow-production"                                                                                                                   help:"Allow running on a non-development control plane."                                           name:"skip-control-plane-check"`
-	Local                   bool     `help:"Use a local dev control plane, even if Spaces is available."`
-	ClusterAdmin            bool     `default:"true"                                                                                                                             help:"Allow Crossplane cluster admin privileges in the local dev control plane. Defaults to true." negatable:""`
-	LocalRegistryPath       string   `help:"Directory to use for local registry images. The default is system-dependent."`
-	SkipControlPlaneCleanup bool     `help:"Skip cleanup of the control plane after the test run."                                                                               name:"skip-control-plane-cleanup"`
-	UseCurrentContext       bool     `help:"Run the project with the current kubeconfig context rather than creating a new dev control plane."`
-	CacheDir                string   `default:"~/.up/cache/"                                                                                                                     env:"CACHE_DIR"                                                                                    help:"Directory used for caching dependencies."               type:"path"`
-	FunctionAnnotations     []string `help:"Override function annotations for all functions (compositionTests and operationTests). Can be repeated."                             placeholder:"KEY=VALUE"`
+	Patterns                []string      `arg:""                                                                                                                                                                                                                                                                                                                              help:"The path to the test manifests"`
+	ProjectFile             string        `default:"upbound.yaml"                                                                                                                                                                                                                                                                                                              help:"Path to project definition file."                                                            short:"f"`
+	Repository              string        `help:"Repository for the built package. Overrides the repository specified in the project file."                                                                                                                                                                                                                                    optional:""`
+	NoBuildCache            bool          `default:"false"                                                                                                                                                                                                                                                                                                                     help:"Don't cache image layers while building."`
+	BuildCacheDir           string        `default:"~/.up/build-cache"                                                                                                                                                                                                                                                                                                         help:"Path to the build cache directory."                                                          type:"path"`
+	FunctionBuildCache      string        `help:"Directory in which to cache built embedded functions. Defaults to the functions directory in the build cache."                                                                                                                                                                                                                type:"path"`
+	MaxConcurrency          uint          `default:"8"                                                                                                                                                                                                                                                                                                                         env:"UP_MAX_CONCURRENCY"                                                                           help:"Maximum number of functions to build and push at once."`
+	ControlPlaneGroup       string        `help:"The control plane group that the control plane to use is contained in. This defaults to the group specified in the current context."`
+	ControlPlaneNamePrefix  string        `help:"Prefix of the control plane name to use. It will be created if not found."`
+	ControlPlaneVersion     string        `help:"Version of Crossplane to use for the control plane. By default, the latest compatible version will be used."`
+	CrossplaneChannel       string        `help:"Auto-upgrade channel of Crossplane to use for a dev control plane in Spaces: None, Patch, Stable or Rapid. Defaults to Rapid. Cannot be combined with --control-plane-version."`
+	ControlPlaneClass       string        `help:"Class of the dev control plane to create in Spaces, which determines its size. Defaults to 'small'."`
+	ReadyTimeout            time.Duration `help:"How long to wait for a dev control plane created in Spaces to become ready. By default there is no timeout."`
+	Force                   bool          `alias:"allow-production"                                                                                                                                                                                                                                                                                                            help:"Allow running on a non-development control plane."                                           name:"skip-control-plane-check"`
+	Local                   bool          `help:"Use a local dev control plane, even if Spaces is available."`
+	ClusterAdmin            bool          `default:"true"                                                                                                                                                                                                                                                                                                                      help:"Allow Crossplane cluster admin privileges in the local dev control plane. Defaults to true." negatable:""`
+	LocalRegistryPath       string        `help:"Directory to use for local registry images. The default is system-dependent."`
+	KindImage               string        `help:"Node image to use when creating a local dev control plane, e.g. 'kindest/node:v1.31.0'. Determines the control plane's Kubernetes version. Defaults to kind's default node image."`
+	ReuseCluster            string        `help:"Name of a local kind cluster to use for the dev control plane. If the cluster exists it is reused, and Crossplane is only installed if missing or at a different version. Otherwise it is created. By default the cluster is named after the control plane, truncated and suffixed with a hash if longer than 49 characters." placeholder:"NAME"`
+	SkipControlPlaneCleanup bool          `help:"Skip cleanup of the control plane after the test run."                                                                                                                                                                                                                                                                        name:"skip-control-plane-cleanup"`
+	UseCurrentContext       bool          `help:"Run the project with the current kubeconfig context rather than creating a new dev control plane."`
+	KubeconfigContext       string        `help:"Run E2E tests against an existing cluster using this kubeconfig context rather than creating a new dev control plane. The cluster isn't torn down after the run."                                                                                                                                                             placeholder:"NAME"`
+	CacheDir                string        `default:"~/.up/cache/"                                                                                                                                                                                                                                                                                                              env:"CACHE_DIR"                                                                                    help:"Directory used for caching dependencies."               type:"path"`
+	FunctionAnnotations     []string      `help:"Override function annotations for all functions (compositionTests and operationTests). Can be repeated."                                                                                                                                                                                                                      placeholder:"KEY=VALUE"`
 
 	Kubectl string `env:"KUBECTL" help:"Absolute path to the kubectl binary. Defaults to the one in $PATH." type:"path"`
 
@@ -81,9 +94,27 @@ type runCmd struct {
 	E2E       bool `help:"Run E2E tests"                                   name:"e2e"`
 	Operation bool `help:"Run Operation tests"                             name:"operation"`
 
+	SkipDelete bool `help:"Skip deleting e2e test manifests and cleaning up the control plane after each test, as if every test set skipDelete."`
+	SkipUpdate bool `default:"true"                                                                                                              help:"Skip the update step of e2e tests, which updates each manifest and waits for it to become ready again."          negatable:""`
+	SkipImport bool `default:"true"                                                                                                              help:"Skip the import step of e2e tests, which checks that managed resources can be imported by their external names." negatable:""`
+
+	Timeout time.Duration `help:"Timeout for each composition and operation test. Overrides the timeoutSeconds set in the tests."`
+	Update  bool          `help:"Update the assertions of composition tests written in YAML to the rendered resources, rather than failing tests whose assertions don't match."`
+
+	LogCollectionInterval time.Duration `default:"10s"                                                                                                 help:"How often to collect logs while running e2e tests."`
+	ArtifactsDir          string        `help:"Directory to save the manifests and collected logs of failed e2e tests to, in a subdirectory per test." type:"path"`
+
+	Var      map[string]string `help:"Set a variable to substitute for $${KEY} placeholders in composition test XRs, observed resources, and extra resources. Can be repeated." placeholder:"KEY=VALUE"`
+	VarsFile string            `help:"Path to a YAML file of variables to substitute into composition tests. Values set with --var take precedence."                            type:"existingfile"`
+
 	SetHelmValues map[string]string `help:"Set custom Crossplane helm chart values for the local test control plane, specified as key=value pairs."`
 	HelmValues    string            `help:"Path to a YAML file containing custom Crossplane helm chart values for the local test control plane."    type:"existingfile"`
 
+	EventsOutput string `help:"Stream newline-delimited JSON events for build stages and test lifecycle to this file as the run proceeds. Use '-' for stdout, in which case human-readable output is written to stderr." placeholder:"PATH"`
+	SummaryJSON  string `help:"Write a JSON summary of the test results, including the failure message of each failed test, to this file. It's written even if tests fail."                                              name:"summary-json" placeholder:"PATH" type:"path"`
+
+	ImageMetadata common.ImageMetadataFlags `embed:""`
+
 	projFS             afero.Fs
 	testFS             afero.Fs
 	functionIdentifier functions.Identifier
@@ -95,6 +126,9 @@ type runCmd struct {
 	concurrency        uint
 	proj               *project.WithVersion
 	chartValues        map[string]any
+	vars               map[string]string
+	events             *async.JSONSink
+	failures           []testFailure
 }
 
 //go:embed help/run.md
@@ -108,6 +142,27 @@ func (c *runCmd) Help() string {
 func (c *runCmd) AfterApply(kongCtx *kong.Context, upCtx *upbound.Context) error {
 	c.concurrency = max(1, c.MaxConcurrency)
 
+	if err := ctp.ValidateKindImage(c.KindImage); err != nil {
+		return err
+	}
+	if err := ctp.ValidateCrossplaneChannel(c.CrossplaneChannel); err != nil {
+		return err
+	}
+	if c.CrossplaneChannel != "" && c.ControlPlaneVersion != "" {
+		return errors.New("--crossplane-channel cannot be combined with --control-plane-version")
+	}
+	if c.Update && (c.E2E || c.Operation) {
+		return errors.New("--update can only be used with composition tests")
+	}
+	if c.KubeconfigContext != "" {
+		if err := upCtx.UseKubeContext(c.KubeconfigContext); err != nil {
+			return err
+		}
+		// From here on the named context is the current context, so it's
+		// used, and guarded, exactly like --use-current-context.
+		c.UseCurrentContext = true
+	}
+
 	// Read the project file.
 	projFilePath, err := filepath.Abs(c.ProjectFile)
 	if err != nil {
@@ -137,7 +192,7 @@ func (c *runCmd) AfterApply(kongCtx *kong.Context, upCtx *upbound.Context) error
 	c.schemaRunner = runner.NewRealSchemaRunner(
 		runner.WithImageConfig(proj.Spec.ImageConfig),
 	)
-	c.transport = http.DefaultTransport
+	c.transport = upCtx.Transport()
 	c.keychain = upCtx.RegistryKeychain()
 
 	r := image.NewResolver(
@@ -145,6 +200,7 @@ func (c *runCmd) AfterApply(kongCtx *kong.Context, upCtx *upbound.Context) error
 		image.WithFetcher(
 			image.NewLocalFetcher(
 				image.WithKeychain(upCtx.RegistryKeychain()),
+				image.WithTransport(upCtx.Transport()),
 			),
 		),
 	)
@@ -185,6 +241,11 @@ func (c *runCmd) AfterApply(kongCtx *kong.Context, upCtx *upbound.Context) error
 		}
 	}
 
+	c.vars, err = getTestVars(c.VarsFile, c.Var)
+	if err != nil {
+		return err
+	}
+
 	logger := logging.NewNopLogger()
 	kongCtx.BindTo(logger, (*logging.Logger)(nil))
 
@@ -223,6 +284,29 @@ func (c *runCmd) Run(ctx context.Context, upCtx *upbound.Context, printer upterm
 		}
 	}
 
+	if c.EventsOutput != "" {
+		var (
+			out     io.Writer = os.Stdout
+			closeFn           = func() {}
+		)
+		if c.EventsOutput == "-" {
+			// Keep stdout clean for events by moving human-readable output
+			// to stderr.
+			printer = upterm.Redirect(printer, os.Stderr)
+		} else {
+			f, err := os.Create(c.EventsOutput)
+			if err != nil {
+				return errors.Wrap(err, "failed to create events output file")
+			}
+			out = f
+			closeFn = func() { _ = f.Close() }
+		}
+		defer closeFn()
+
+		c.events = async.NewJSONSink(out)
+		printer = &eventPrinter{Printer: printer, events: c.events}
+	}
+
 	var err error
 	var parsedTests []any
 	if err = printer.WrapWithSuccessSpinner(
@@ -255,8 +339,10 @@ func (c *runCmd) Run(ctx context.Context, upCtx *upbound.Context, printer upterm
 		ttotal   int
 		tsuccess int
 		terr     int
+		runErr   error
 	)
 
+	start := time.Now()
 	switch {
 	case c.E2E:
 		tests, err := e2etest.Convert(parsedTests)
@@ -265,10 +351,7 @@ func (c *runCmd) Run(ctx context.Context, upCtx *upbound.Context, printer upterm
 		}
 
 		ttotal, tsuccess, terr, err = c.runE2ETests(ctx, upCtx, tests, printer)
-		if err != nil {
-			displayTestResults(printer, ttotal, tsuccess, terr)
-			return errors.Wrap(err, "unable to execute e2e tests")
-		}
+		runErr = errors.Wrap(err, "unable to execute e2e tests")
 	case c.Operation:
 		tests, err := operationtest.Convert(parsedTests)
 		if err != nil {
@@ -276,29 +359,24 @@ func (c *runCmd) Run(ctx context.Context, upCtx *upbound.Context, printer upterm
 		}
 
 		ttotal, tsuccess, terr, err = c.runOperationTests(ctx, upCtx, log, tests, printer)
-		if err != nil {
-			displayTestResults(printer, ttotal, tsuccess, terr)
-			return errors.Wrap(err, "unable to execute operation tests")
-		}
+		runErr = errors.Wrap(err, "unable to execute operation tests")
 	default:
 		tests, err := compositiontest.Convert(parsedTests)
 		if err != nil {
 			return errors.Wrap(err, "unable to validate composition tests")
 		}
 		ttotal, tsuccess, terr, err = c.runCompositionTests(ctx, upCtx, log, tests, printer)
-		if err != nil {
-			displayTestResults(printer, ttotal, tsuccess, terr)
-			return errors.Wrap(err, "unable to execute composition tests")
-		}
+		runErr = errors.Wrap(err, "unable to execute composition tests")
 	}
 
 	displayTestResults(printer, ttotal, tsuccess, terr)
-	// Return an error if there were failed tests
-	if terr > 0 {
-		return err
+	if c.SummaryJSON != "" {
+		if err := writeSummary(c.SummaryJSON, newTestSummary(ttotal, tsuccess, terr, time.Since(start), c.failures)); err != nil {
+			return errors.Join(runErr, err)
+		}
 	}
 
-	return nil
+	return runErr
 }
 
 const useCurrentContextConfirmFmt = `Running e2e tests on an existing control plane can be destructive.
@@ -321,8 +399,30 @@ func (c *runCmd) confirmUseCurrentContext(upCtx *upbound.Context) error {
 	return nil
 }
 
+// defaultTestTimeout is the timeout for composition and operation tests that
+// don't set one.
+const defaultTestTimeout = 30 * time.Second
+
+// testTimeout returns the timeout for a composition or operation test that
+// sets the given timeout in seconds. The --timeout flag takes precedence over
+// the test's timeout, which takes precedence over the default.
+func (c *runCmd) testTimeout(seconds int) time.Duration {
+	switch {
+	case c.Timeout > 0:
+		return c.Timeout
+	case seconds > 0:
+		return time.Duration(seconds) * time.Second
+	default:
+		return defaultTestTimeout
+	}
+}
+
+// kubeconfigFileName is the name of the kubeconfig written to an e2e test's
+// working directory.
+const kubeconfigFileName = "kubeconfig.yaml"
+
 func writeClientConfig(clientConfig clientcmd.ClientConfig, dir string) (string, error) {
-	kubeconfigPath := filepath.Join(dir, "kubeconfig.yaml")
+	kubeconfigPath := filepath.Join(dir, kubeconfigFileName)
 
 	// Extract the raw config
 	rawConfig, err := clientConfig.RawConfig()
@@ -339,6 +439,36 @@ func writeClientConfig(clientConfig clientcmd.ClientConfig, dir string) (string,
 	return kubeconfigPath, nil
 }
 
+// saveArtifacts copies the files an e2e test wrote to its working directory,
+// such as its manifests and collected logs, to dir. The kubeconfig is skipped
+// so that credentials aren't saved with the artifacts.
+func saveArtifacts(workDir, dir string) error {
+	return filepath.WalkDir(workDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(workDir, p)
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return os.MkdirAll(filepath.Join(dir, rel), 0o755)
+		}
+		if rel == kubeconfigFileName {
+			return nil
+		}
+
+		bs, err := os.ReadFile(p) //nolint:gosec // We wrote these files.
+		if err != nil {
+			return errors.Wrapf(err, "failed to read %s", p)
+		}
+		if err := os.WriteFile(filepath.Join(dir, rel), bs, 0o644); err != nil { //nolint:gosec // Artifacts are meant to be shared.
+			return errors.Wrapf(err, "failed to write artifact %s", rel)
+		}
+		return nil
+	})
+}
+
 func setEnvVars(vars map[string]string) (cleanup func(), err error) {
 	for key, value := range vars {
 		if err := os.Setenv(key, value); err != nil {
@@ -393,6 +523,18 @@ func (c *runCmd) pushOrLoadPackages(ctx context.Context, upCtx *upbound.Context,
 	return generatedTag, err
 }
 
+// eventPrinter is a printer that additionally writes events produced by async
+// operations to a JSON event sink.
+type eventPrinter struct {
+	upterm.Printer
+
+	events *async.JSONSink
+}
+
+func (p *eventPrinter) WrapAsyncWithSuccessSpinners(fn func(ch async.EventChannel) error) error {
+	return p.Printer.WrapAsyncWithSuccessSpinners(p.events.Wrap(fn))
+}
+
 func displayTestResults(p upterm.Printer, ttotal, tsuccess, terr int) {
 	printlnFunc := p.PrintSuccess
 	if terr > 0 {
@@ -412,7 +554,11 @@ func assertions(ctx context.Context, output, testName string, expectedAssertions
 	ch.SendEvent(statusStage, async.EventStatusStarted)
 
 	// Split the rendered output into individual manifests
-	manifests := parseManifests(output)
+	manifests, err := parseManifests(output)
+	if err != nil {
+		ch.SendEvent(statusStage, async.EventStatusFailure)
+		return errors.Wrap(err, "cannot parse rendered output")
+	}
 	renderedManifests := convertToUnstructured(manifests)
 	expectedObjects, err := parseExpectedAssertions(expectedAssertions)
 	if err != nil {
@@ -420,13 +566,12 @@ func assertions(ctx context.Context, output, testName string, expectedAssertions
 		return err
 	}
 
-	assertionErrors := compareManifests(ctx, renderedManifests, expectedObjects)
+	failed := compareManifests(ctx, renderedManifests, expectedObjects)
 
-	if len(assertionErrors) > 0 {
-		finalErr := formatErrors(assertionErrors)
-		p.Print(formatDiffError(finalErr))
+	if len(failed) > 0 {
+		p.Print(formatAssertionErrors(failed, len(expectedObjects), p.Pretty()))
 		ch.SendEvent(statusStage, async.EventStatusFailure)
-		return finalErr
+		return errors.New(formatAssertionErrors(failed, len(expectedObjects), false))
 	}
 
 	ch.SendEvent(statusStage, async.EventStatusSuccess)
@@ -434,7 +579,11 @@ func assertions(ctx context.Context, output, testName string, expectedAssertions
 	return nil
 }
 
-func formatDiffError(err error) string {
+func formatDiffError(err error, pretty bool) string {
+	if !pretty {
+		return err.Error() + "\n"
+	}
+
 	var (
 		red    = lipgloss.NewStyle().Foreground(style.RedColor)
 		yellow = lipgloss.NewStyle().Foreground(style.YellowColor)
@@ -465,16 +614,176 @@ func formatDiffError(err error) string {
 	return bld.String()
 }
 
-func parseManifests(output string) []string {
-	manifests := strings.Split(output, "---")
-	parsedManifests := make([]string, 0, len(manifests))
-	for _, manifest := range manifests {
-		trimmed := strings.TrimSpace(manifest)
-		if trimmed != "" {
-			parsedManifests = append(parsedManifests, trimmed)
+// assertionError is returned when a rendered resource does not satisfy its
+// expected assertion. It carries the fields that failed the check so they can
+// be displayed as a diff.
+type assertionError struct {
+	resource string
+	fields   []fieldDiff
+	err      error
+}
+
+func (e *assertionError) Error() string {
+	return e.err.Error()
+}
+
+func (e *assertionError) Unwrap() error {
+	return e.err
+}
+
+// fieldDiff is a single field that failed an assertion.
+type fieldDiff struct {
+	path     string
+	expected string
+	actual   string
+}
+
+// fieldDiffs extracts the failed fields from chainsaw check errors.
+func fieldDiffs(errs field.ErrorList) []fieldDiff {
+	diffs := make([]fieldDiff, 0, len(errs))
+	for _, e := range errs {
+		d := fieldDiff{
+			path:     e.Field,
+			expected: strings.TrimPrefix(e.Detail, "Expected value: "),
+			actual:   fmt.Sprint(e.BadValue),
+		}
+		if e.Type == field.ErrorTypeRequired || e.Type == field.ErrorTypeNotFound {
+			d.actual = "<missing>"
+		}
+		diffs = append(diffs, d)
+	}
+	return diffs
+}
+
+// formatAssertionErrors formats the failed assertions of a test, grouped by
+// the expected resource they failed for. The failed fields, or the reason the
+// assertion failed, are indented beneath each resource. Output is colored when
+// pretty is true.
+func formatAssertionErrors(errs []*assertionError, total int, pretty bool) string {
+	var (
+		order  []string
+		groups = make(map[string][]*assertionError)
+	)
+	for _, aerr := range errs {
+		if _, ok := groups[aerr.resource]; !ok {
+			order = append(order, aerr.resource)
+		}
+		groups[aerr.resource] = append(groups[aerr.resource], aerr)
+	}
+
+	bld := &strings.Builder{}
+	summary := fmt.Sprintf("%d of %d expected resources failed assertions:", len(order), total)
+	if pretty {
+		summary = lipgloss.NewStyle().Bold(true).Render(summary)
+	}
+	bld.WriteString(summary)
+	bld.WriteString("\n")
+
+	for _, resource := range order {
+		bld.WriteString(assertionHeader(resource, pretty))
+		for _, aerr := range groups[resource] {
+			if len(aerr.fields) > 0 {
+				bld.WriteString(formatFieldTable(aerr.fields, pretty))
+				continue
+			}
+			for line := range strings.Lines(formatDiffError(aerr.err, pretty)) {
+				bld.WriteString("  ")
+				bld.WriteString(line)
+			}
 		}
 	}
-	return parsedManifests
+
+	return bld.String()
+}
+
+// assertionHeader returns the header printed above the failures of an
+// expected resource. It is rendered bold red when pretty is true.
+func assertionHeader(resource string, pretty bool) string {
+	header := fmt.Sprintf("Assertion failed for %s:", resource)
+	if pretty {
+		header = lipgloss.NewStyle().Bold(true).Foreground(style.RedColor).Render(header)
+	}
+	return header + "\n"
+}
+
+// formatFieldDiffs formats the failed fields of an assertion as a table of
+// expected and actual values beneath the resource they failed for.
+func formatFieldDiffs(aerr *assertionError, pretty bool) string {
+	return assertionHeader(aerr.resource, pretty) + formatFieldTable(aerr.fields, pretty)
+}
+
+// formatFieldTable formats failed fields as an indented table of expected and
+// actual values. Expected values are rendered red and actual values green when
+// pretty is true.
+func formatFieldTable(fields []fieldDiff, pretty bool) string {
+	rows := [][]string{{"FIELD", "EXPECTED", "ACTUAL"}}
+	for _, d := range fields {
+		rows = append(rows, []string{d.path, d.expected, d.actual})
+	}
+
+	widths := make([]int, 3)
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], len(cell))
+		}
+	}
+
+	var (
+		bold  = lipgloss.NewStyle().Bold(true)
+		red   = lipgloss.NewStyle().Foreground(style.RedColor)
+		green = lipgloss.NewStyle().Foreground(style.GreenColor)
+	)
+
+	bld := &strings.Builder{}
+	for i, row := range rows {
+		bld.WriteString("  ")
+		for j, cell := range row {
+			padded := cell
+			if j < len(row)-1 {
+				padded += strings.Repeat(" ", widths[j]-len(cell)+2)
+			}
+			switch {
+			case !pretty:
+			case i == 0:
+				padded = bold.Render(padded)
+			case j == 1:
+				padded = red.Render(padded)
+			case j == 2:
+				padded = green.Render(padded)
+			}
+			bld.WriteString(padded)
+		}
+		bld.WriteString("\n")
+	}
+
+	return bld.String()
+}
+
+// parseManifests splits a stream of YAML documents into its manifests. The
+// stream is decoded rather than split on separators, so a "---" inside a value
+// doesn't split a manifest. Anchors and aliases are kept, to be resolved when
+// each manifest is unmarshaled. Empty documents are omitted.
+func parseManifests(output string) ([]string, error) {
+	var manifests []string
+	dec := yamlv3.NewDecoder(strings.NewReader(output))
+	for {
+		var doc yamlv3.Node
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, errors.Wrap(err, "cannot decode YAML documents")
+		}
+		if len(doc.Content) == 0 || doc.Content[0].Tag == "!!null" {
+			continue
+		}
+		b, err := yamlv3.Marshal(&doc)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot encode YAML document")
+		}
+		manifests = append(manifests, strings.TrimSpace(string(b)))
+	}
+	return manifests, nil
 }
 
 func convertToUnstructured(manifests []string) []unstructured.Unstructured {
@@ -503,51 +812,150 @@ func parseExpectedAssertions(expectedAssertions []runtime.RawExtension) ([]unstr
 	return expectedObjects, nil
 }
 
-func compareManifests(ctx context.Context, renderedManifests, expectedObjects []unstructured.Unstructured) []error {
-	var assertionErrors []error
+// compareManifests asserts on the rendered resource matching each expected
+// resource, and returns an error for each expected resource that failed its
+// assertion.
+func compareManifests(ctx context.Context, renderedManifests, expectedObjects []unstructured.Unstructured) []*assertionError {
+	var failed []*assertionError
 	for _, expected := range expectedObjects {
-		if err := matchExpectedManifest(ctx, expected, renderedManifests); err != nil {
-			assertionErrors = append(assertionErrors, err)
+		err := matchExpectedManifest(ctx, expected, renderedManifests)
+		if err == nil {
+			continue
+		}
+		var aerr *assertionError
+		if !errors.As(err, &aerr) {
+			aerr = &assertionError{resource: resourceID(expected), err: err}
 		}
+		failed = append(failed, aerr)
 	}
-	return assertionErrors
+	return failed
 }
 
+// resourceID identifies a resource by its apiVersion, kind, and name.
+func resourceID(u unstructured.Unstructured) string {
+	return fmt.Sprintf("%s/%s/%s", u.GetAPIVersion(), u.GetKind(), u.GetName())
+}
+
+// matchFieldsAnnotation may be set on an expected resource to identify the
+// rendered resource it asserts on by a comma-separated list of field paths
+// (e.g. "metadata.labels,spec.region"), in addition to its apiVersion, kind,
+// name, and annotations. The annotation itself is not asserted on.
+const matchFieldsAnnotation = "cli.upbound.io/match-fields"
+
 func matchExpectedManifest(ctx context.Context, expected unstructured.Unstructured, renderedManifests []unstructured.Unstructured) error {
+	expected = *expected.DeepCopy()
+	matchFields := identifyingFields(&expected)
+
 	expectedAPIVersion, expectedKind, expectedName := expected.GetAPIVersion(), expected.GetKind(), expected.GetName()
 	expectedAnnotations := expected.GetAnnotations()
 
+	var matches []unstructured.Unstructured
 	for _, rendered := range renderedManifests {
-		if isMatchingManifest(expected, rendered, expectedAnnotations) {
-			// Strip ownerReferences from the rendered copy unless the expected
-			// manifest explicitly asserts them. They are set by Crossplane on
-			// composed resources and are noise in the diff when not under test.
-			renderedForCheck := *rendered.DeepCopy()
-			if len(expected.GetOwnerReferences()) == 0 {
-				renderedForCheck.SetOwnerReferences(nil)
-			}
+		if isMatchingManifest(expected, rendered, expectedAnnotations) && matchesFields(expected, rendered, matchFields) {
+			matches = append(matches, rendered)
+		}
+	}
 
-			checkErrs, err := chainsawchecks.Check(ctx, chainsawapis.DefaultCompilers, renderedForCheck.UnstructuredContent(), chainsawapis.NewBindings(), ptr.To(chainsawv1alpha1.NewCheck(expected.UnstructuredContent())))
-			if err != nil {
-				return fmt.Errorf("error during manifest check: %w", err)
-			}
+	switch len(matches) {
+	case 0:
+		return errors.Errorf("no actual resource found: %s/%s/%s", expectedAPIVersion, expectedKind, expectedName)
+	case 1:
+	default:
+		return errors.Errorf("ambiguous assertion: %d rendered resources match %s/%s/%s; use the %s annotation to identify the resource by more fields", len(matches), expectedAPIVersion, expectedKind, expectedName, matchFieldsAnnotation)
+	}
 
-			if len(checkErrs) == 0 {
-				return nil
-			}
+	// Strip ownerReferences from the rendered copy unless the expected
+	// manifest explicitly asserts them. They are set by Crossplane on composed
+	// resources and are noise in the diff when not under test. The check runs
+	// against the whole rendered object, including its status.
+	renderedForCheck := *matches[0].DeepCopy()
+	if len(expected.GetOwnerReferences()) == 0 {
+		renderedForCheck.SetOwnerReferences(nil)
+	}
 
-			return chainsawerrors.ResourceError(
-				chainsawcompilers.DefaultCompilers,
-				expected,
-				renderedForCheck,
-				false,
-				chainsawapis.NewBindings(),
-				checkErrs,
-			)
+	checkErrs, err := chainsawchecks.Check(ctx, chainsawapis.DefaultCompilers, renderedForCheck.UnstructuredContent(), chainsawapis.NewBindings(), ptr.To(chainsawv1alpha1.NewCheck(expected.UnstructuredContent())))
+	if err != nil {
+		return fmt.Errorf("error during manifest check: %w", err)
+	}
+
+	if len(checkErrs) == 0 {
+		return nil
+	}
+
+	return &assertionError{
+		resource: resourceID(expected),
+		fields:   fieldDiffs(checkErrs),
+		err: chainsawerrors.ResourceError(
+			chainsawcompilers.DefaultCompilers,
+			expected,
+			renderedForCheck,
+			false,
+			chainsawapis.NewBindings(),
+			checkErrs,
+		),
+	}
+}
+
+// identifyingFields returns the field paths listed in the expected resource's
+// match-fields annotation, and removes the annotation so it is neither matched
+// nor asserted on.
+func identifyingFields(expected *unstructured.Unstructured) [][]string {
+	annotations := expected.GetAnnotations()
+	v, ok := annotations[matchFieldsAnnotation]
+	if !ok {
+		return nil
+	}
+	delete(annotations, matchFieldsAnnotation)
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	expected.SetAnnotations(annotations)
+
+	var paths [][]string
+	for p := range strings.SplitSeq(v, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			paths = append(paths, strings.Split(p, "."))
 		}
 	}
+	return paths
+}
+
+// matchesFields returns true if the rendered resource has the expected
+// resource's value at each of the given field paths. Maps match if the
+// rendered map contains every expected key and value.
+func matchesFields(expected, rendered unstructured.Unstructured, paths [][]string) bool {
+	for _, p := range paths {
+		want, ok, err := unstructured.NestedFieldNoCopy(expected.Object, p...)
+		if err != nil || !ok {
+			return false
+		}
+		got, ok, err := unstructured.NestedFieldNoCopy(rendered.Object, p...)
+		if err != nil || !ok {
+			return false
+		}
+		if !containsValue(got, want) {
+			return false
+		}
+	}
+	return true
+}
 
-	return errors.Errorf("no actual resource found: %s/%s/%s", expectedAPIVersion, expectedKind, expectedName)
+func containsValue(got, want any) bool {
+	wm, ok := want.(map[string]any)
+	if !ok {
+		return reflect.DeepEqual(got, want)
+	}
+	gm, ok := got.(map[string]any)
+	if !ok {
+		return false
+	}
+	for k, wv := range wm {
+		gv, ok := gm[k]
+		if !ok || !containsValue(gv, wv) {
+			return false
+		}
+	}
+	return true
 }
 
 func isMatchingManifest(expected, rendered unstructured.Unstructured, expectedAnnotations map[string]string) bool {
@@ -566,14 +974,6 @@ func isMatchingManifest(expected, rendered unstructured.Unstructured, expectedAn
 	return true
 }
 
-func formatErrors(errors []error) error {
-	formattedErrors := make([]string, len(errors))
-	for i, e := range errors {
-		formattedErrors[i] = e.Error()
-	}
-	return fmt.Errorf("\n%s", strings.Join(formattedErrors, "\n"))
-}
-
 // truncateAndValidateName ensures the final name is <=63 chars and valid as a DNS-1123 label.
 func truncateAndValidateName(prefix, name string) (string, error) {
 	fullName := fmt.Sprintf("%s-%s", prefix, name)
@@ -6,14 +6,18 @@ package test
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/clientcmd/api"
@@ -142,6 +146,141 @@ metadata:
 			expectErr:      true,
 			expectedErrMsg: "name",
 		},
+		{
+			name: "StatusMatches",
+			output: `
+apiVersion: example.org/v1
+kind: XNetwork
+metadata:
+  name: test-xr
+status:
+  ready: true
+  subnets: 3
+`,
+			expectedYAML: []string{
+				`
+apiVersion: example.org/v1
+kind: XNetwork
+metadata:
+  name: test-xr
+status:
+  ready: true
+`,
+			},
+			expectErr: false,
+		},
+		{
+			name: "StatusMismatch",
+			output: `
+apiVersion: example.org/v1
+kind: XNetwork
+metadata:
+  name: test-xr
+status:
+  ready: false
+`,
+			expectedYAML: []string{
+				`
+apiVersion: example.org/v1
+kind: XNetwork
+metadata:
+  name: test-xr
+status:
+  ready: true
+`,
+			},
+			expectErr:      true,
+			expectedErrMsg: "status.ready",
+		},
+		{
+			name: "AmbiguousMatch",
+			output: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  labels:
+    tier: web
+data:
+  key: a
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  labels:
+    tier: db
+data:
+  key: b
+`,
+			expectedYAML: []string{
+				`
+apiVersion: v1
+kind: ConfigMap
+data:
+  key: b
+`,
+			},
+			expectErr:      true,
+			expectedErrMsg: "ambiguous assertion",
+		},
+		{
+			name: "MatchFieldsDisambiguates",
+			output: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  labels:
+    tier: web
+data:
+  key: a
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  labels:
+    tier: db
+data:
+  key: b
+`,
+			expectedYAML: []string{
+				`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  annotations:
+    cli.upbound.io/match-fields: metadata.labels
+  labels:
+    tier: db
+data:
+  key: b
+`,
+			},
+			expectErr: false,
+		},
+		{
+			name: "MatchFieldsNoMatch",
+			output: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  labels:
+    tier: web
+data:
+  key: a
+`,
+			expectedYAML: []string{
+				`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  annotations:
+    cli.upbound.io/match-fields: metadata.labels
+  labels:
+    tier: db
+`,
+			},
+			expectErr:      true,
+			expectedErrMsg: "no actual resource found",
+		},
 	}
 
 	ctx := t.Context()
@@ -440,6 +579,194 @@ func TestWriteClientConfig(t *testing.T) {
 	}
 }
 
+func TestTestTimeout(t *testing.T) {
+	tests := []struct {
+		name     string
+		override time.Duration
+		seconds  int
+		want     time.Duration
+	}{
+		{name: "TestTimeout", seconds: 60, want: time.Minute},
+		{name: "Override", override: 5 * time.Minute, seconds: 60, want: 5 * time.Minute},
+		{name: "OverrideUnsetTestTimeout", override: 5 * time.Minute, want: 5 * time.Minute},
+		{name: "Default", want: defaultTestTimeout},
+		{name: "NegativeTestTimeout", seconds: -1, want: defaultTestTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &runCmd{Timeout: tt.override}
+			if got := c.testTimeout(tt.seconds); got != tt.want {
+				t.Errorf("testTimeout(%d): want %v, got %v", tt.seconds, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSaveArtifacts(t *testing.T) {
+	workDir := t.TempDir()
+	files := map[string]string{
+		"manifest-0.yaml":      "kind: XNetwork",
+		"logs/crossplane.log":  "reconciled",
+		kubeconfigFileName:     "secret",
+		"logs/nested/pods.log": "running",
+	}
+	for p, content := range files {
+		if err := os.MkdirAll(filepath.Join(workDir, filepath.Dir(p)), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(workDir, p), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dir := filepath.Join(t.TempDir(), "my-test")
+	if err := saveArtifacts(workDir, dir); err != nil {
+		t.Fatal(err)
+	}
+
+	for p, content := range files {
+		got, err := os.ReadFile(filepath.Join(dir, p))
+		if p == kubeconfigFileName {
+			if !os.IsNotExist(err) {
+				t.Errorf("expected kubeconfig not to be saved, got error %v", err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != content {
+			t.Errorf("saveArtifacts(...): %s: want %q, got %q", p, content, string(got))
+		}
+	}
+}
+
+func TestParseManifests(t *testing.T) {
+	tests := []struct {
+		name      string
+		output    string
+		expected  []map[string]any
+		expectErr bool
+	}{
+		{
+			name: "MultipleDocumentsWithComments",
+			output: `---
+# The first manifest.
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: first
+---
+---
+# An empty document.
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: second
+spec:
+  replicas: 2
+`,
+			expected: []map[string]any{
+				{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]any{"name": "first"}},
+				{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": map[string]any{"name": "second"}, "spec": map[string]any{"replicas": float64(2)}},
+			},
+		},
+		{
+			name: "SeparatorInsideValues",
+			output: `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: scripts
+data:
+  script: |
+    echo start
+    ---
+    echo end
+  quoted: "a
+    ---
+    b"
+  inline: a---b
+`,
+			expected: []map[string]any{
+				{
+					"apiVersion": "v1",
+					"kind":       "ConfigMap",
+					"metadata":   map[string]any{"name": "scripts"},
+					"data": map[string]any{
+						"script": "echo start\n---\necho end\n",
+						"quoted": "a --- b",
+						"inline": "a---b",
+					},
+				},
+			},
+		},
+		{
+			name: "AnchorsAndAliases",
+			output: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  labels: &labels
+    app: web
+spec:
+  selector:
+    matchLabels: *labels
+  template:
+    metadata:
+      labels:
+        <<: *labels
+        tier: frontend
+`,
+			expected: []map[string]any{
+				{
+					"apiVersion": "apps/v1",
+					"kind":       "Deployment",
+					"metadata":   map[string]any{"name": "web", "labels": map[string]any{"app": "web"}},
+					"spec": map[string]any{
+						"selector": map[string]any{"matchLabels": map[string]any{"app": "web"}},
+						"template": map[string]any{
+							"metadata": map[string]any{"labels": map[string]any{"app": "web", "tier": "frontend"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InvalidYAML",
+			output: `apiVersion: v1
+kind: ConfigMap
+metadata: [
+`,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifests, err := parseManifests(tt.output)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("expected an error but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			got := make([]map[string]any, 0, len(manifests))
+			for _, u := range convertToUnstructured(manifests) {
+				got = append(got, u.Object)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("parseManifests(...): want %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
 func TestIsMatchingManifest(t *testing.T) {
 	tests := []struct {
 		name                string
@@ -631,3 +958,126 @@ func TestTruncateAndValidateName(t *testing.T) {
 		})
 	}
 }
+
+func TestFieldDiffs(t *testing.T) {
+	errs := field.ErrorList{
+		field.Invalid(field.NewPath("spec", "replicas"), 2, "Expected value: 3"),
+		field.Required(field.NewPath("spec", "region"), "field not found in the input object"),
+	}
+
+	want := []fieldDiff{
+		{path: "spec.replicas", expected: "3", actual: "2"},
+		{path: "spec.region", expected: "field not found in the input object", actual: "<missing>"},
+	}
+
+	got := fieldDiffs(errs)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("fieldDiffs(...): want %+v, got %+v", want, got)
+	}
+}
+
+func TestFormatFieldDiffs(t *testing.T) {
+	aerr := &assertionError{
+		resource: "apps/v1/Deployment/test-deployment",
+		fields: []fieldDiff{
+			{path: "spec.replicas", expected: "3", actual: "2"},
+			{path: "metadata.labels.app", expected: `"web"`, actual: `"api"`},
+		},
+	}
+
+	want := `Assertion failed for apps/v1/Deployment/test-deployment:
+  FIELD                EXPECTED  ACTUAL
+  spec.replicas        3         2
+  metadata.labels.app  "web"     "api"
+`
+
+	got := formatFieldDiffs(aerr, false)
+	if got != want {
+		t.Errorf("formatFieldDiffs(...): want:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestFormatAssertionErrors(t *testing.T) {
+	errs := []*assertionError{
+		{
+			resource: "apps/v1/Deployment/test-deployment",
+			fields: []fieldDiff{
+				{path: "spec.replicas", expected: "3", actual: "2"},
+			},
+		},
+		{
+			resource: "v1/ConfigMap/test-config",
+			err:      errors.New("no actual resource found: v1/ConfigMap/test-config"),
+		},
+		{
+			resource: "apps/v1/Deployment/test-deployment",
+			fields: []fieldDiff{
+				{path: "metadata.labels.app", expected: `"web"`, actual: `"api"`},
+			},
+		},
+	}
+
+	want := `2 of 4 expected resources failed assertions:
+Assertion failed for apps/v1/Deployment/test-deployment:
+  FIELD          EXPECTED  ACTUAL
+  spec.replicas  3         2
+  FIELD                EXPECTED  ACTUAL
+  metadata.labels.app  "web"     "api"
+Assertion failed for v1/ConfigMap/test-config:
+  no actual resource found: v1/ConfigMap/test-config
+`
+
+	got := formatAssertionErrors(errs, 4, false)
+	if got != want {
+		t.Errorf("formatAssertionErrors(...): want:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestCompareManifests(t *testing.T) {
+	renderedManifests, err := parseManifests(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-config
+data:
+  key: value
+`)
+	if err != nil {
+		t.Fatalf("parseManifests(...): unexpected error: %v", err)
+	}
+	expectedManifests, err := parseManifests(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-config
+data:
+  key: other
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: missing-config
+`)
+	if err != nil {
+		t.Fatalf("parseManifests(...): unexpected error: %v", err)
+	}
+
+	got := compareManifests(t.Context(), convertToUnstructured(renderedManifests), convertToUnstructured(expectedManifests))
+	if len(got) != 2 {
+		t.Fatalf("compareManifests(...): want 2 failed assertions, got %d", len(got))
+	}
+
+	if got[0].resource != "v1/ConfigMap/test-config" {
+		t.Errorf("compareManifests(...)[0].resource: want %q, got %q", "v1/ConfigMap/test-config", got[0].resource)
+	}
+	if len(got[0].fields) != 1 || got[0].fields[0].path != "data.key" {
+		t.Errorf("compareManifests(...)[0].fields: want a single data.key diff, got %+v", got[0].fields)
+	}
+
+	if got[1].resource != "v1/ConfigMap/missing-config" {
+		t.Errorf("compareManifests(...)[1].resource: want %q, got %q", "v1/ConfigMap/missing-config", got[1].resource)
+	}
+	if len(got[1].fields) != 0 || !strings.Contains(got[1].Error(), "no actual resource found") {
+		t.Errorf("compareManifests(...)[1]: want a missing resource error, got %v", got[1])
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package test
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up/internal/async"
+)
+
+// testSummary is the machine-readable summary of a test run written by
+// --summary-json.
+type testSummary struct {
+	Total           int           `json:"total"`
+	Passed          int           `json:"passed"`
+	Failed          int           `json:"failed"`
+	DurationSeconds float64       `json:"durationSeconds"`
+	Failures        []testFailure `json:"failures"`
+}
+
+// testFailure describes a failed test in a test summary.
+type testFailure struct {
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+// recordTest records a test's status, writing an event for it and keeping the
+// failure message of a failed test for the summary.
+func (c *runCmd) recordTest(name string, status async.EventStatus, err error) {
+	c.events.WriteTest(name, status, err)
+	if status == async.EventStatusFailure && err != nil {
+		c.failures = append(c.failures, testFailure{Name: name, Message: err.Error()})
+	}
+}
+
+// newTestSummary returns a summary of a test run.
+func newTestSummary(total, passed, failed int, d time.Duration, failures []testFailure) testSummary {
+	if failures == nil {
+		failures = []testFailure{}
+	}
+	return testSummary{
+		Total:           total,
+		Passed:          passed,
+		Failed:          failed,
+		DurationSeconds: d.Seconds(),
+		Failures:        failures,
+	}
+}
+
+// writeSummary writes a test summary as JSON to the given path.
+func writeSummary(path string, s testSummary) error {
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal test summary")
+	}
+	return errors.Wrap(os.WriteFile(path, append(b, '\n'), 0o644), "failed to write test summary")
+}
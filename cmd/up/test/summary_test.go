@@ -0,0 +1,89 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/upbound/up/internal/async"
+)
+
+func TestWriteSummary(t *testing.T) {
+	tests := []struct {
+		name     string
+		record   func(c *runCmd)
+		total    int
+		passed   int
+		failed   int
+		expected map[string]any
+	}{
+		{
+			name: "MixedPassAndFail",
+			record: func(c *runCmd) {
+				c.recordTest("passes", async.EventStatusStarted, nil)
+				c.recordTest("passes", async.EventStatusSuccess, nil)
+				c.recordTest("fails", async.EventStatusStarted, nil)
+				c.recordTest("fails", async.EventStatusFailure, errors.New("resource mismatch"))
+			},
+			total:  2,
+			passed: 1,
+			failed: 1,
+			expected: map[string]any{
+				"total":           float64(2),
+				"passed":          float64(1),
+				"failed":          float64(1),
+				"durationSeconds": float64(1.5),
+				"failures": []any{
+					map[string]any{"name": "fails", "message": "resource mismatch"},
+				},
+			},
+		},
+		{
+			name: "AllPass",
+			record: func(c *runCmd) {
+				c.recordTest("passes", async.EventStatusSuccess, nil)
+			},
+			total:  1,
+			passed: 1,
+			expected: map[string]any{
+				"total":           float64(1),
+				"passed":          float64(1),
+				"failed":          float64(0),
+				"durationSeconds": float64(1.5),
+				"failures":        []any{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &runCmd{}
+			tt.record(c)
+
+			path := filepath.Join(t.TempDir(), "summary.json")
+			s := newTestSummary(tt.total, tt.passed, tt.failed, 1500*time.Millisecond, c.failures)
+			if err := writeSummary(path, s); err != nil {
+				t.Fatalf("writeSummary() error = %v", err)
+			}
+
+			raw, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("failed to read summary: %v", err)
+			}
+			var got map[string]any
+			if err := json.Unmarshal(raw, &got); err != nil {
+				t.Fatalf("failed to unmarshal summary: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("summary = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
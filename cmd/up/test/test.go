@@ -12,4 +12,5 @@ type Cmd struct {
 
 	Run      runCmd      `cmd:"" help:"Run project tests."`
 	Generate generateCmd `cmd:"" help:"Generate a Test for a project."`
+	Lint     lintCmd     `cmd:"" help:"Validate project tests without running them."`
 }
@@ -0,0 +1,211 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package test
+
+import (
+	"bytes"
+	"io"
+	"path"
+
+	"github.com/spf13/afero"
+	yamlv3 "gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up/internal/test"
+	"github.com/upbound/up/internal/upterm"
+)
+
+const (
+	// renderGroup is the API group of the function results and context
+	// included in rendered output, which aren't asserted on.
+	renderGroup = "render.crossplane.io"
+
+	testFile = "test.yaml"
+)
+
+// yamlTestFiles returns the paths, relative to the tests directory, of the
+// test files of the tests matched by the command's patterns that are written
+// in YAML. Only these can have their assertions updated, since tests written
+// in other languages generate their YAML.
+func (c *runCmd) yamlTestFiles() ([]string, error) {
+	dirs, err := test.DiscoverTestDirectories(c.testFS, c.Patterns, c.proj.Spec.Paths.Tests)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to discover test directories")
+	}
+
+	id := &test.DefaultIdentifier{}
+	files := make([]string, 0, len(dirs))
+	for _, dir := range dirs {
+		r, err := id.Identify(afero.NewBasePathFs(c.testFS, dir))
+		if err != nil {
+			continue
+		}
+		if _, ok := r.(*test.YAMLRunner); ok {
+			files = append(files, path.Join(dir, testFile))
+		}
+	}
+	return files, nil
+}
+
+// updateAssertions replaces the assertions of the named composition test with
+// the resources in its rendered output, in whichever of the given YAML test
+// files defines the test.
+func (c *runCmd) updateAssertions(files []string, testName, output string, p upterm.Printer) error {
+	resources, err := renderedResources(output)
+	if err != nil {
+		return errors.Wrap(err, "cannot parse rendered output")
+	}
+	for _, f := range files {
+		raw, err := afero.ReadFile(c.testFS, f)
+		if err != nil {
+			return errors.Wrapf(err, "cannot read test file %s", f)
+		}
+		updated, found, err := updateTestAssertions(raw, testName, resources)
+		if err != nil {
+			return errors.Wrapf(err, "cannot update test file %s", f)
+		}
+		if !found {
+			continue
+		}
+		if err := afero.WriteFile(c.testFS, f, updated, 0o644); err != nil {
+			return errors.Wrapf(err, "cannot write test file %s", f)
+		}
+		p.Printfln("Updated the assertions of test %s in %s to the %d rendered resources", testName, path.Join(c.proj.Spec.Paths.Tests, f), len(resources))
+		return nil
+	}
+	return errors.Errorf("cannot update the assertions of test %s: only tests written in YAML can be updated", testName)
+}
+
+// renderedResources returns the resources in rendered output, omitting the
+// function results and context.
+func renderedResources(output string) ([]unstructured.Unstructured, error) {
+	manifests, err := parseManifests(output)
+	if err != nil {
+		return nil, err
+	}
+	all := convertToUnstructured(manifests)
+	resources := make([]unstructured.Unstructured, 0, len(all))
+	for _, u := range all {
+		gv, err := schema.ParseGroupVersion(u.GetAPIVersion())
+		if err == nil && gv.Group == renderGroup {
+			continue
+		}
+		resources = append(resources, u)
+	}
+	return resources, nil
+}
+
+// updateTestAssertions replaces the assertResources of the named composition
+// test in a YAML test file, which may contain several tests either as separate
+// documents or as an items list. The rest of the file, including comments, is
+// preserved, though it may be re-indented. It returns false if the file
+// doesn't define the test.
+func updateTestAssertions(raw []byte, testName string, resources []unstructured.Unstructured) ([]byte, bool, error) {
+	var docs []*yamlv3.Node
+	dec := yamlv3.NewDecoder(bytes.NewReader(raw))
+	for {
+		var doc yamlv3.Node
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, false, errors.Wrap(err, "cannot decode test file")
+		}
+		docs = append(docs, &doc)
+	}
+
+	var spec *yamlv3.Node
+	for _, doc := range docs {
+		if spec = findTestSpec(doc, testName); spec != nil {
+			break
+		}
+	}
+	if spec == nil {
+		return raw, false, nil
+	}
+
+	content := make([]map[string]any, 0, len(resources))
+	for _, r := range resources {
+		content = append(content, r.Object)
+	}
+	var assertions yamlv3.Node
+	if err := assertions.Encode(content); err != nil {
+		return nil, false, errors.Wrap(err, "cannot encode assertions")
+	}
+	setMappingValue(spec, "assertResources", &assertions)
+
+	var buf bytes.Buffer
+	enc := yamlv3.NewEncoder(&buf)
+	enc.SetIndent(2)
+	for _, doc := range docs {
+		if err := enc.Encode(doc); err != nil {
+			return nil, false, errors.Wrap(err, "cannot encode test file")
+		}
+	}
+	if err := enc.Close(); err != nil {
+		return nil, false, errors.Wrap(err, "cannot encode test file")
+	}
+	return buf.Bytes(), true, nil
+}
+
+// findTestSpec returns the spec of the named composition test in a YAML
+// document, or nil if the document doesn't define it. The spec is created if
+// the test doesn't have one.
+func findTestSpec(doc *yamlv3.Node, testName string) *yamlv3.Node {
+	if doc.Kind != yamlv3.DocumentNode || len(doc.Content) == 0 {
+		return nil
+	}
+	root := doc.Content[0]
+
+	candidates := []*yamlv3.Node{root}
+	if items := mappingValue(root, "items"); items != nil && items.Kind == yamlv3.SequenceNode {
+		candidates = items.Content
+	}
+	for _, n := range candidates {
+		kind := mappingValue(n, "kind")
+		if kind == nil || kind.Value != "CompositionTest" {
+			continue
+		}
+		name := mappingValue(mappingValue(n, "metadata"), "name")
+		if name == nil || name.Value != testName {
+			continue
+		}
+		spec := mappingValue(n, "spec")
+		if spec == nil {
+			spec = &yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"}
+			setMappingValue(n, "spec", spec)
+		}
+		return spec
+	}
+	return nil
+}
+
+// mappingValue returns the value of a key in a YAML mapping, or nil if the node
+// isn't a mapping or doesn't have the key.
+func mappingValue(m *yamlv3.Node, key string) *yamlv3.Node {
+	if m == nil || m.Kind != yamlv3.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			return m.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// setMappingValue sets the value of a key in a YAML mapping, keeping the key's
+// position and comments if it's already present.
+func setMappingValue(m *yamlv3.Node, key string, value *yamlv3.Node) {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			m.Content[i+1] = value
+			return
+		}
+	}
+	m.Content = append(m.Content, &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: key}, value)
+}
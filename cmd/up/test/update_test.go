@@ -0,0 +1,193 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestUpdateTestAssertions(t *testing.T) {
+	bucket := unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "s3.aws.upbound.io/v1beta1",
+		"kind":       "Bucket",
+		"metadata":   map[string]any{"name": "my-bucket"},
+	}}
+
+	tests := []struct {
+		name      string
+		raw       string
+		testName  string
+		want      string
+		wantFound bool
+	}{
+		{
+			name:     "SingleDocument",
+			testName: "test-bucket",
+			raw: `# A test.
+apiVersion: meta.dev.upbound.io/v1alpha1
+kind: CompositionTest
+metadata:
+  name: test-bucket
+spec:
+  # The rendered resources.
+  assertResources:
+    - apiVersion: s3.aws.upbound.io/v1beta1
+      kind: Bucket
+      metadata:
+        name: old-bucket
+  timeoutSeconds: 60
+`,
+			want: `# A test.
+apiVersion: meta.dev.upbound.io/v1alpha1
+kind: CompositionTest
+metadata:
+  name: test-bucket
+spec:
+  # The rendered resources.
+  assertResources:
+    - apiVersion: s3.aws.upbound.io/v1beta1
+      kind: Bucket
+      metadata:
+        name: my-bucket
+  timeoutSeconds: 60
+`,
+			wantFound: true,
+		},
+		{
+			name:     "ItemsList",
+			testName: "test-b",
+			raw: `items:
+  - apiVersion: meta.dev.upbound.io/v1alpha1
+    kind: CompositionTest
+    metadata:
+      name: test-a
+    spec:
+      timeoutSeconds: 60
+  - apiVersion: meta.dev.upbound.io/v1alpha1
+    kind: CompositionTest
+    metadata:
+      name: test-b
+    spec:
+      timeoutSeconds: 60
+`,
+			want: `items:
+  - apiVersion: meta.dev.upbound.io/v1alpha1
+    kind: CompositionTest
+    metadata:
+      name: test-a
+    spec:
+      timeoutSeconds: 60
+  - apiVersion: meta.dev.upbound.io/v1alpha1
+    kind: CompositionTest
+    metadata:
+      name: test-b
+    spec:
+      timeoutSeconds: 60
+      assertResources:
+        - apiVersion: s3.aws.upbound.io/v1beta1
+          kind: Bucket
+          metadata:
+            name: my-bucket
+`,
+			wantFound: true,
+		},
+		{
+			name:     "MultipleDocuments",
+			testName: "test-b",
+			raw: `apiVersion: meta.dev.upbound.io/v1alpha1
+kind: CompositionTest
+metadata:
+  name: test-a
+---
+apiVersion: meta.dev.upbound.io/v1alpha1
+kind: CompositionTest
+metadata:
+  name: test-b
+`,
+			want: `apiVersion: meta.dev.upbound.io/v1alpha1
+kind: CompositionTest
+metadata:
+  name: test-a
+---
+apiVersion: meta.dev.upbound.io/v1alpha1
+kind: CompositionTest
+metadata:
+  name: test-b
+spec:
+  assertResources:
+    - apiVersion: s3.aws.upbound.io/v1beta1
+      kind: Bucket
+      metadata:
+        name: my-bucket
+`,
+			wantFound: true,
+		},
+		{
+			name:     "NotFound",
+			testName: "test-c",
+			raw: `apiVersion: meta.dev.upbound.io/v1alpha1
+kind: OperationTest
+metadata:
+  name: test-c
+`,
+			want: `apiVersion: meta.dev.upbound.io/v1alpha1
+kind: OperationTest
+metadata:
+  name: test-c
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found, err := updateTestAssertions([]byte(tt.raw), tt.testName, []unstructured.Unstructured{bucket})
+			if err != nil {
+				t.Fatalf("updateTestAssertions(...): unexpected error: %v", err)
+			}
+			if found != tt.wantFound {
+				t.Errorf("updateTestAssertions(...): want found %t, got %t", tt.wantFound, found)
+			}
+			if string(got) != tt.want {
+				t.Errorf("updateTestAssertions(...): want:\n%s\ngot:\n%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRenderedResources(t *testing.T) {
+	output := `apiVersion: example.org/v1
+kind: XBucket
+metadata:
+  name: my-xr
+---
+apiVersion: s3.aws.upbound.io/v1beta1
+kind: Bucket
+metadata:
+  name: my-bucket
+---
+apiVersion: render.crossplane.io/v1beta1
+kind: Result
+message: composed
+---
+apiVersion: render.crossplane.io/v1beta1
+kind: Context
+`
+
+	resources, err := renderedResources(output)
+	if err != nil {
+		t.Fatalf("renderedResources(...): unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, r := range resources {
+		got = append(got, r.GetKind())
+	}
+	want := []string{"XBucket", "Bucket"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("renderedResources(...): want kinds %v, got %v", want, got)
+	}
+}
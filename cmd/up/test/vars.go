@@ -0,0 +1,107 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package test
+
+import (
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up/internal/yaml"
+)
+
+// varRegexp matches ${VAR} placeholders, and $${VAR} escapes for a literal
+// ${VAR}.
+var varRegexp = regexp.MustCompile(`\$?\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// getTestVars returns the variables to substitute into test manifests, read
+// from a YAML file and overridden by values set on the command line.
+func getTestVars(varsFile string, setVars map[string]string) (map[string]string, error) {
+	if varsFile == "" && len(setVars) == 0 {
+		return nil, nil
+	}
+
+	vars := map[string]string{}
+	if varsFile != "" {
+		b, err := os.ReadFile(varsFile) //nolint:gosec // We parse this safely.
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to read vars file")
+		}
+		if err := yaml.Unmarshal(b, &vars); err != nil {
+			return nil, errors.Wrap(err, "unable to parse vars file")
+		}
+	}
+	for k, v := range setVars {
+		vars[k] = v
+	}
+
+	return vars, nil
+}
+
+// expandVars replaces ${VAR} placeholders in b with the values of vars. A
+// placeholder can be escaped as $${VAR}. It returns an error naming any
+// variables that are referenced but not defined.
+func expandVars(b []byte, vars map[string]string) ([]byte, error) {
+	var undefined []string
+	out := varRegexp.ReplaceAllFunc(b, func(m []byte) []byte {
+		if m[1] == '$' {
+			return m[1:]
+		}
+		name := string(m[2 : len(m)-1])
+		v, ok := vars[name]
+		if !ok {
+			if !slices.Contains(undefined, name) {
+				undefined = append(undefined, name)
+			}
+			return m
+		}
+		return []byte(v)
+	})
+	if len(undefined) > 0 {
+		return nil, errors.Errorf("undefined variable(s) %s", strings.Join(undefined, ", "))
+	}
+	return out, nil
+}
+
+// expandResources expands variables in each of the given resources. Resources
+// are returned unchanged if no variables are set.
+func expandResources(resources []runtime.RawExtension, vars map[string]string, kind string) ([]runtime.RawExtension, error) {
+	if vars == nil {
+		return resources, nil
+	}
+
+	out := make([]runtime.RawExtension, len(resources))
+	for i, res := range resources {
+		raw, err := expandVars(res.Raw, vars)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot expand variables in %s", kind)
+		}
+		out[i] = runtime.RawExtension{Raw: raw}
+	}
+	return out, nil
+}
+
+// expandResourceFile returns the path to a copy of the file at path with
+// variables expanded. The path is returned unchanged if no variables are set.
+func expandResourceFile(fs afero.Fs, path string, vars map[string]string, prefix string) (string, error) {
+	if vars == nil || path == "" {
+		return path, nil
+	}
+
+	b, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return "", errors.Wrapf(err, "cannot read %s", path)
+	}
+	raw, err := expandVars(b, vars)
+	if err != nil {
+		return "", errors.Wrapf(err, "cannot expand variables in %s", path)
+	}
+	return writeToFile(fs, []runtime.RawExtension{{Raw: raw}}, prefix)
+}
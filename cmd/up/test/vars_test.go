@@ -0,0 +1,96 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+)
+
+func TestExpandVars(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		vars           map[string]string
+		expectErr      bool
+		expectedErrMsg string
+		expectedOutput string
+	}{
+		{
+			name:           "Substitutes",
+			input:          `{"spec":{"region":"${REGION}","account":"${ACCOUNT_ID}"}}`,
+			vars:           map[string]string{"REGION": "us-west-2", "ACCOUNT_ID": "123456789012"},
+			expectedOutput: `{"spec":{"region":"us-west-2","account":"123456789012"}}`,
+		},
+		{
+			name:           "Escaped",
+			input:          `{"spec":{"script":"echo $${HOME}"}}`,
+			vars:           map[string]string{},
+			expectedOutput: `{"spec":{"script":"echo ${HOME}"}}`,
+		},
+		{
+			name:           "IgnoresNonPlaceholders",
+			input:          `{"spec":{"a":"$REGION","b":"${var.region}"}}`,
+			vars:           map[string]string{"REGION": "us-west-2"},
+			expectedOutput: `{"spec":{"a":"$REGION","b":"${var.region}"}}`,
+		},
+		{
+			name:           "Undefined",
+			input:          `{"spec":{"region":"${REGION}","zone":"${ZONE}","other":"${REGION}"}}`,
+			vars:           map[string]string{},
+			expectErr:      true,
+			expectedErrMsg: "undefined variable(s) REGION, ZONE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expandVars([]byte(tt.input), tt.vars)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("expected an error but got nil")
+				}
+				if !strings.Contains(err.Error(), tt.expectedErrMsg) {
+					t.Errorf("Expected error to contain %q, but got %q", tt.expectedErrMsg, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if string(got) != tt.expectedOutput {
+				t.Errorf("Expected output %q, but got %q", tt.expectedOutput, string(got))
+			}
+		})
+	}
+}
+
+func TestExpandResourceFile(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	if err := afero.WriteFile(fs, "/tests/xr.yaml", []byte("region: ${REGION}\n"), 0o644); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	path, err := expandResourceFile(fs, "/tests/xr.yaml", nil, "xr")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if path != "/tests/xr.yaml" {
+		t.Errorf("Expected path to be unchanged without vars, but got %q", path)
+	}
+
+	path, err = expandResourceFile(fs, "/tests/xr.yaml", map[string]string{"REGION": "eu-west-1"}, "xr")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got, err := afero.ReadFile(fs, path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := "region: eu-west-1\n---\n"; string(got) != want {
+		t.Errorf("Expected content %q, but got %q", want, string(got))
+	}
+}
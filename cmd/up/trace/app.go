@@ -41,17 +41,20 @@ type App struct {
 	grid     *tview.Grid
 	topLevel *upviews.TopLevel
 
-	pollFn  func(gkns query.GroupKindNames, cns query.CategoryNames) ([]queryv1alpha2.QueryResponseObject, error)
-	fetchFn func(id string) (*unstructured.Unstructured, error)
+	pollFn   func(gkns query.GroupKindNames, cns query.CategoryNames) ([]queryv1alpha2.QueryResponseObject, error)
+	fetchFn  func(id string) (*unstructured.Unstructured, error)
+	interval time.Duration
 }
 
-func NewApp(title string, resources []string, gkns query.GroupKindNames, cns query.CategoryNames, pollFn func(gkns query.GroupKindNames, cns query.CategoryNames) ([]queryv1alpha2.QueryResponseObject, error), fetchFn func(id string) (*unstructured.Unstructured, error)) *App {
+func NewApp(title string, resources []string, gkns query.GroupKindNames, cns query.CategoryNames, pollFn func(gkns query.GroupKindNames, cns query.CategoryNames) ([]queryv1alpha2.QueryResponseObject, error), fetchFn func(id string) (*unstructured.Unstructured, error), filter model.Filter, interval time.Duration) *App {
 	app := &App{
 		Application: tview.NewApplication(),
 		model:       model.NewApp(resources, gkns, cns),
 		pollFn:      pollFn,
 		fetchFn:     fetchFn,
+		interval:    interval,
 	}
+	app.model.Tree.Filter = filter
 
 	app.header = views.NewHeader()
 	app.tree = views.NewTree(app.Application, &app.model.Tree)
@@ -95,6 +98,9 @@ func NewApp(title string, resources []string, gkns query.GroupKindNames, cns que
 				if app.model.Tree.AutoCollapse {
 					b.WriteString("AutoCollapse ")
 				}
+				if !app.model.Tree.Filter.IsEmpty() {
+					b.WriteString("Filtered ")
+				}
 				if app.model.Zoomed {
 					b.WriteString("Zoomed ")
 				}
@@ -105,7 +111,7 @@ func NewApp(title string, resources []string, gkns query.GroupKindNames, cns que
 			}},
 		).
 		SetError(app.model.TopLevel.Error).
-		SetCommands("Help", "Kind", "View", "", "", "", "", "", "", "Quit").
+		SetCommands("Help", "Kind", "View", "List", "", "", "", "", "", "Quit").
 		SetDelegateInputHandler(app.TopLevelInputHandler)
 	app.Application.SetRoot(app.topLevel, true)
 	app.Application.SetFocus(app.tree)
@@ -248,6 +254,22 @@ func (a *App) TopLevelInputHandler(event *tcell.EventKey, setFocus func(p tview.
 		a.ResizeToFullScreen(txt)
 		a.SetRoot(txt, true)
 
+		return true
+	case tcell.KeyF4:
+		back := func() {
+			a.SetRoot(a.topLevel, true)
+			a.SetFocus(a.tree)
+		}
+		list := views.NewList(a.Application, &a.model.Tree).
+			SetSelectedFunc(func(row upviews.TableRow) {
+				a.tree.SelectObject(row.Reference.(*model.Object).Id)
+				back()
+			}).
+			SetDoneFunc(back).
+			Refresh()
+		a.ResizeToFullScreen(list)
+		a.SetRoot(list, true)
+
 		return true
 	default:
 	}
@@ -256,15 +278,25 @@ func (a *App) TopLevelInputHandler(event *tcell.EventKey, setFocus func(p tview.
 }
 
 func (a *App) Run(ctx context.Context) error {
+	// Stop the background loops when the application quits.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	go func() {
 		<-ctx.Done()
 		a.Application.Stop()
 	}()
 
 	go func() {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
 		for {
-			time.Sleep(time.Second)
-			a.QueueUpdateDraw(func() {})
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				a.QueueUpdateDraw(func() {})
+			}
 		}
 	}()
 
@@ -276,8 +308,15 @@ func (a *App) Run(ctx context.Context) error {
 	a.model.Tree.Update(resp)
 
 	go func() {
+		ticker := time.NewTicker(a.interval)
+		defer ticker.Stop()
 		for {
-			time.Sleep(time.Second * 1)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
 			objs, err := a.pollFn(*a.model.GroupKindNames.Load(), *a.model.CategoryNames.Load())
 			if err != nil {
 				a.model.TopLevel.SetError(errors.Errorf(" Error: %v ", err))
@@ -7,6 +7,8 @@ package trace
 import (
 	"context"
 	"fmt"
+	"os"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -14,11 +16,16 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
 	"github.com/upbound/up-sdk-go/apis/common"
 	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
 	"github.com/upbound/up/cmd/up/query"
 	"github.com/upbound/up/cmd/up/query/resource"
+	"github.com/upbound/up/cmd/up/trace/model"
+	tvstyle "github.com/upbound/up/internal/tview/style"
 	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/upterm"
 
 	_ "embed"
 )
@@ -32,6 +39,17 @@ type Cmd struct {
 	Namespace    string `description:"Namespace of objects to query (defaults to all namespaces)" env:"UPBOUND_NAMESPACE"    long:"namespace"    short:"n"`
 	AllGroups    bool   `help:"Query in all groups."                                              name:"all-groups"          short:"A"`
 
+	Output     string `default:"interactive"                                                             enum:"interactive,dot,json" help:"Output format: 'interactive' to browse the resources, 'dot' to write a Graphviz graph, or 'json'." short:"o"`
+	OutputFile string `help:"File to write the graph to when exporting it. Defaults to standard output." type:"path"`
+
+	NotReady     bool     `help:"Only show resources that are not Ready, and their ancestors."`
+	NotSynced    bool     `help:"Only show resources that are not Synced, and their ancestors."`
+	Reason       []string `help:"Only show resources whose Synced or Ready condition has the given reason, and their ancestors. Can be repeated."    placeholder:"REASON"`
+	OnlyProblems bool     `help:"Only show resources that are not Ready or not Synced, and their ancestors. Equivalent to --not-ready --not-synced."`
+
+	Watch    bool          `help:"Keep refreshing the traced resources. The interactive view always refreshes; in the 'dot' and 'json' output modes the graph is written again on each refresh." short:"w"`
+	Interval time.Duration `default:"1s"                                                                                                                                                         help:"Interval at which to refresh the traced resources."`
+
 	// positional arguments
 	Resources []string `arg:"" help:"Type(s) (resource, singular or plural, category, short-name) and names: TYPE[.GROUP][,TYPE[.GROUP]...] [NAME ...] | TYPE[.GROUP]/NAME .... If no resource is specified, all resources are queried, but --all-resources must be specified."`
 }
@@ -44,8 +62,16 @@ func (c *Cmd) Help() string {
 	return traceHelp
 }
 
+// Validate validates the command's flags.
+func (c *Cmd) Validate() error {
+	if c.Interval <= 0 {
+		return errors.New("--interval must be positive")
+	}
+	return nil
+}
+
 // Run is the implementation of the command.
-func (c *Cmd) Run(ctx context.Context, upCtx *upbound.Context) error { //nolint:gocognit // TODO: split up
+func (c *Cmd) Run(ctx context.Context, p upterm.Printer, upCtx *upbound.Context) error { //nolint:gocognit // TODO: split up
 	// create client
 	kubeconfig, err := upCtx.GetKubeconfig()
 	if err != nil {
@@ -180,11 +206,97 @@ func (c *Cmd) Run(ctx context.Context, upCtx *upbound.Context) error { //nolint:
 		return &unstructured.Unstructured{Object: query.GetResponse().Objects[0].Object.Object}, nil
 	}
 
+	if c.Output != outputInteractive {
+		return c.export(ctx, func() ([]queryv1alpha2.QueryResponseObject, error) {
+			return poll(gkNames, categoryNames)
+		})
+	}
+
 	upCtx.HideLogging()
-	app := NewApp("upbound trace", c.Resources, gkNames, categoryNames, poll, fetch)
+	app := NewApp("upbound trace", c.Resources, gkNames, categoryNames, poll, fetch, c.filter(), c.Interval)
+	app.topLevel.StatusBar.SetBreadcrumbs(c.breadcrumbs()...)
+	if !p.Pretty() {
+		app.topLevel.StatusBar.SetStyle(tvstyle.PlainStatusBar)
+	}
 	return app.Run(ctx)
 }
 
+// breadcrumbs returns the path of the queried scope.
+func (c *Cmd) breadcrumbs() []string {
+	switch {
+	case c.AllGroups:
+		return []string{"all groups"}
+	case c.Group != "" && c.ControlPlane != "":
+		return []string{c.Group, c.ControlPlane}
+	case c.Group != "":
+		return []string{c.Group}
+	default:
+		return nil
+	}
+}
+
+// filter returns the filter selecting the resources to show.
+func (c *Cmd) filter() model.Filter {
+	return model.Filter{
+		NotReady:  c.NotReady || c.OnlyProblems,
+		NotSynced: c.NotSynced || c.OnlyProblems,
+		Reasons:   c.Reason,
+	}
+}
+
+// export writes the traced objects to the output file, or to standard output
+// if no file is set. When watching, the objects are polled and written again
+// at each interval until the context is done.
+func (c *Cmd) export(ctx context.Context, poll func() ([]queryv1alpha2.QueryResponseObject, error)) error {
+	objs, err := poll()
+	if err != nil {
+		return err
+	}
+	if err := c.writeOutput(c.filter().Apply(model.NewGraph(objs))); err != nil {
+		return err
+	}
+	if !c.Watch {
+		return nil
+	}
+
+	ticker := time.NewTicker(c.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		objs, err = poll()
+		if err != nil {
+			return err
+		}
+		if err := c.writeOutput(c.filter().Apply(model.NewGraph(objs))); err != nil {
+			return err
+		}
+	}
+}
+
+// writeOutput writes the traced objects to the output file, replacing its
+// contents, or to standard output if no file is set.
+func (c *Cmd) writeOutput(objs []*model.Object) error {
+	if c.OutputFile == "" {
+		return writeGraph(os.Stdout, c.Output, objs)
+	}
+
+	f, err := os.Create(c.OutputFile)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer f.Close() //nolint:errcheck // Closed explicitly below.
+
+	if err := writeGraph(f, c.Output, objs); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
 func createQuerySpec(obj types.NamespacedName, gk metav1.GroupKind, categories []string) *queryv1alpha2.QuerySpec {
 	return &queryv1alpha2.QuerySpec{
 		QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{
@@ -0,0 +1,165 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package trace
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up/cmd/up/trace/model"
+)
+
+const (
+	outputInteractive = "interactive"
+	outputDOT         = "dot"
+	outputJSON        = "json"
+)
+
+// ownsLabel labels the edge from an object to a resource it owns.
+const ownsLabel = "owns"
+
+// exportedObject is the serialized form of a traced object.
+type exportedObject struct {
+	Group        string             `json:"group,omitempty"`
+	Kind         string             `json:"kind"`
+	ControlPlane string             `json:"controlPlane,omitempty"`
+	Namespace    string             `json:"namespace,omitempty"`
+	Name         string             `json:"name"`
+	Deleting     bool               `json:"deleting,omitempty"`
+	Synced       exportedCondition  `json:"synced"`
+	Ready        exportedCondition  `json:"ready"`
+	Resources    []exportedResource `json:"resources,omitempty"`
+}
+
+// exportedCondition is the serialized form of an object's condition.
+type exportedCondition struct {
+	Status  corev1.ConditionStatus `json:"status"`
+	Reason  xpv1.ConditionReason   `json:"reason,omitempty"`
+	Message string                 `json:"message,omitempty"`
+}
+
+// exportedResource is an edge from an object to a resource related to it.
+type exportedResource struct {
+	Relation string         `json:"relation"`
+	Object   exportedObject `json:"object"`
+}
+
+func newExportedObject(o *model.Object) exportedObject {
+	e := exportedObject{
+		Group:     o.Group,
+		Kind:      o.Kind,
+		Namespace: o.Namespace,
+		Name:      o.Name,
+		Deleting:  !o.DeletionTimestamp.IsZero(),
+		Synced:    newExportedCondition(o.JSON.GetCondition(xpv1.TypeSynced)),
+		Ready:     newExportedCondition(o.JSON.GetCondition(xpv1.TypeReady)),
+	}
+	if o.ControlPlane.Name != "" {
+		e.ControlPlane = o.ControlPlane.Namespace + "/" + o.ControlPlane.Name
+	}
+	for _, c := range o.Children {
+		e.Resources = append(e.Resources, exportedResource{Relation: ownsLabel, Object: newExportedObject(c)})
+	}
+	return e
+}
+
+func newExportedCondition(c xpv1.Condition) exportedCondition {
+	status := c.Status
+	if status == "" {
+		status = corev1.ConditionUnknown
+	}
+	return exportedCondition{Status: status, Reason: c.Reason, Message: c.Message}
+}
+
+// writeGraph writes the traced objects to w in the given output format.
+func writeGraph(w io.Writer, format string, objs []*model.Object) error {
+	switch format {
+	case outputJSON:
+		return writeJSON(w, objs)
+	case outputDOT:
+		return writeDOT(w, objs)
+	default:
+		return errors.Errorf("unsupported output format %q", format)
+	}
+}
+
+func writeJSON(w io.Writer, objs []*model.Object) error {
+	exported := make([]exportedObject, len(objs))
+	for i, o := range objs {
+		exported[i] = newExportedObject(o)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return errors.Wrap(enc.Encode(exported), "cannot encode graph")
+}
+
+// writeDOT writes the traced objects as a Graphviz digraph. Nodes are colored
+// by readiness, and edges point from an object to the resources it owns.
+func writeDOT(w io.Writer, objs []*model.Object) error {
+	var b strings.Builder
+	b.WriteString("digraph trace {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=rounded];\n")
+
+	seen := map[string]bool{}
+	var walk func(parent string, o *model.Object)
+	walk = func(parent string, o *model.Object) {
+		if !seen[o.Id] {
+			seen[o.Id] = true
+			fmt.Fprintf(&b, "  %q [label=%q, color=%q];\n", o.Id, dotLabel(o), dotColor(o))
+		}
+		if parent != "" {
+			fmt.Fprintf(&b, "  %q -> %q [label=%q];\n", parent, o.Id, ownsLabel)
+		}
+		for _, c := range o.Children {
+			walk(o.Id, c)
+		}
+	}
+	for _, o := range objs {
+		walk("", o)
+	}
+
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return errors.Wrap(err, "cannot write graph")
+}
+
+func dotLabel(o *model.Object) string {
+	kind := o.Kind
+	if o.Group != "" {
+		kind += "." + o.Group
+	}
+	name := o.Name
+	if o.Namespace != "" {
+		name = o.Namespace + "/" + o.Name
+	}
+	synced := newExportedCondition(o.JSON.GetCondition(xpv1.TypeSynced))
+	ready := newExportedCondition(o.JSON.GetCondition(xpv1.TypeReady))
+	label := fmt.Sprintf("%s\n%s\nSynced: %s, Ready: %s", kind, name, synced.Status, ready.Status)
+	if !o.DeletionTimestamp.IsZero() {
+		label += "\n(deleting)"
+	}
+	return label
+}
+
+func dotColor(o *model.Object) string {
+	synced := o.JSON.GetCondition(xpv1.TypeSynced).Status
+	ready := o.JSON.GetCondition(xpv1.TypeReady).Status
+	switch {
+	case synced == corev1.ConditionFalse || ready == corev1.ConditionFalse:
+		return "red"
+	case synced == corev1.ConditionTrue && ready == corev1.ConditionTrue:
+		return "green"
+	default:
+		return "orange"
+	}
+}
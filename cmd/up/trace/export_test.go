@@ -0,0 +1,103 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package trace
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+	"github.com/upbound/up/cmd/up/trace/model"
+)
+
+func responseObject(id, apiVersion, kind, name string, ready string, children ...queryv1alpha2.QueryResponseObject) queryv1alpha2.QueryResponseObject {
+	return queryv1alpha2.QueryResponseObject{
+		ID:           id,
+		ControlPlane: &queryv1alpha2.QueryResponseControlPlane{Namespace: "default", Name: "ctp"},
+		Object: &common.JSONObject{Object: map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       kind,
+			"metadata": map[string]interface{}{
+				"name":              name,
+				"creationTimestamp": "2025-01-01T00:00:00Z",
+			},
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Synced", "status": "True", "reason": "ReconcileSuccess", "lastTransitionTime": "2025-01-01T00:00:00Z"},
+					map[string]interface{}{"type": "Ready", "status": ready, "reason": "Creating", "lastTransitionTime": "2025-01-01T00:00:00Z"},
+				},
+			},
+		}},
+		Relations: map[string]queryv1alpha2.QueryResponseRelation{
+			"resources": {QueryResponseObjects: queryv1alpha2.QueryResponseObjects{Objects: children}},
+		},
+	}
+}
+
+func testGraph() []*model.Object {
+	return model.NewGraph([]queryv1alpha2.QueryResponseObject{
+		responseObject("xr", "example.org/v1", "XBucket", "my-bucket", "False",
+			responseObject("b", "s3.aws.upbound.io/v1beta1", "Bucket", "my-bucket-abc", "True"),
+		),
+	})
+}
+
+func TestWriteJSON(t *testing.T) {
+	var b bytes.Buffer
+	if err := writeGraph(&b, outputJSON, testGraph()); err != nil {
+		t.Fatalf("writeGraph(...): unexpected error: %v", err)
+	}
+
+	var got []exportedObject
+	if err := json.Unmarshal(b.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal(...): unexpected error: %v", err)
+	}
+
+	want := []exportedObject{{
+		Group:        "example.org",
+		Kind:         "XBucket",
+		ControlPlane: "default/ctp",
+		Name:         "my-bucket",
+		Synced:       exportedCondition{Status: "True", Reason: "ReconcileSuccess"},
+		Ready:        exportedCondition{Status: "False", Reason: "Creating"},
+		Resources: []exportedResource{{
+			Relation: "owns",
+			Object: exportedObject{
+				Group:        "s3.aws.upbound.io",
+				Kind:         "Bucket",
+				ControlPlane: "default/ctp",
+				Name:         "my-bucket-abc",
+				Synced:       exportedCondition{Status: "True", Reason: "ReconcileSuccess"},
+				Ready:        exportedCondition{Status: "True", Reason: "Creating"},
+			},
+		}},
+	}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("writeGraph(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestWriteDOT(t *testing.T) {
+	var b bytes.Buffer
+	if err := writeGraph(&b, outputDOT, testGraph()); err != nil {
+		t.Fatalf("writeGraph(...): unexpected error: %v", err)
+	}
+	got := b.String()
+
+	for _, want := range []string{
+		"digraph trace {",
+		`"xr" [label="XBucket.example.org\nmy-bucket\nSynced: True, Ready: False", color="red"];`,
+		`"b" [label="Bucket.s3.aws.upbound.io\nmy-bucket-abc\nSynced: True, Ready: True", color="green"];`,
+		`"xr" -> "b" [label="owns"];`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("writeGraph(...): expected output to contain %q, got:\n%s", want, got)
+		}
+	}
+}
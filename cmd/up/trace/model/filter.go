@@ -0,0 +1,68 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package model
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// Filter selects the objects of a graph to show by their conditions. An object
+// matches if it matches any of the criteria. The zero value matches all
+// objects.
+type Filter struct {
+	// NotReady matches objects whose Ready condition isn't True.
+	NotReady bool
+	// NotSynced matches objects whose Synced condition isn't True.
+	NotSynced bool
+	// Reasons matches objects whose Synced or Ready condition has one of the
+	// given reasons.
+	Reasons []string
+}
+
+// IsEmpty returns true if the filter matches all objects.
+func (f Filter) IsEmpty() bool {
+	return !f.NotReady && !f.NotSynced && len(f.Reasons) == 0
+}
+
+// Matches returns true if the object matches the filter.
+func (f Filter) Matches(o *Object) bool {
+	if f.IsEmpty() {
+		return true
+	}
+
+	synced := o.JSON.GetCondition(xpv1.TypeSynced)
+	ready := o.JSON.GetCondition(xpv1.TypeReady)
+	switch {
+	case f.NotReady && ready.Status != corev1.ConditionTrue:
+		return true
+	case f.NotSynced && synced.Status != corev1.ConditionTrue:
+		return true
+	case slices.Contains(f.Reasons, string(synced.Reason)), slices.Contains(f.Reasons, string(ready.Reason)):
+		return true
+	}
+	return false
+}
+
+// Apply prunes the graph to the objects matching the filter and their
+// ancestors, which are kept for context. Subtrees without matching objects are
+// removed. The given objects are modified in place.
+func (f Filter) Apply(objs []*Object) []*Object {
+	if f.IsEmpty() {
+		return objs
+	}
+
+	out := make([]*Object, 0, len(objs))
+	for _, o := range objs {
+		o.Children = f.Apply(o.Children)
+		if len(o.Children) == 0 && !f.Matches(o) {
+			continue
+		}
+		out = append(out, o)
+	}
+	return out
+}
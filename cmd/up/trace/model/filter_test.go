@@ -0,0 +1,94 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package model
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+)
+
+func conditionedObject(name, synced, ready, reason string, children ...*Object) *Object {
+	return &Object{
+		Id:   name,
+		Name: name,
+		JSON: common.JSONObject{Object: map[string]interface{}{
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Synced", "status": synced, "reason": "ReconcileSuccess", "lastTransitionTime": "2025-01-01T00:00:00Z"},
+					map[string]interface{}{"type": "Ready", "status": ready, "reason": reason, "lastTransitionTime": "2025-01-01T00:00:00Z"},
+				},
+			},
+		}},
+		Children: children,
+	}
+}
+
+// names returns the names of the objects in the graph, depth first.
+func names(objs []*Object) []string {
+	var out []string
+	for _, o := range objs {
+		out = append(out, o.Name)
+		out = append(out, names(o.Children)...)
+	}
+	return out
+}
+
+func TestFilterApply(t *testing.T) {
+	graph := func() []*Object {
+		return []*Object{
+			conditionedObject("healthy-xr", "True", "True", "Available",
+				conditionedObject("healthy-bucket", "True", "True", "Available"),
+			),
+			conditionedObject("broken-xr", "True", "False", "Creating",
+				conditionedObject("good-bucket", "True", "True", "Available"),
+				conditionedObject("nested-xr", "True", "True", "Available",
+					conditionedObject("bad-bucket", "False", "True", "Available"),
+				),
+			),
+		}
+	}
+
+	tests := map[string]struct {
+		reason string
+		filter Filter
+		want   []string
+	}{
+		"Empty": {
+			reason: "An empty filter should keep all objects.",
+			want:   []string{"healthy-xr", "healthy-bucket", "broken-xr", "good-bucket", "nested-xr", "bad-bucket"},
+		},
+		"NotReady": {
+			reason: "Only objects that aren't Ready should be kept.",
+			filter: Filter{NotReady: true},
+			want:   []string{"broken-xr"},
+		},
+		"NotSynced": {
+			reason: "Ancestors of objects that aren't Synced should be kept for context.",
+			filter: Filter{NotSynced: true},
+			want:   []string{"broken-xr", "nested-xr", "bad-bucket"},
+		},
+		"OnlyProblems": {
+			reason: "Objects that aren't Ready or Synced should be kept, and healthy subtrees removed.",
+			filter: Filter{NotReady: true, NotSynced: true},
+			want:   []string{"broken-xr", "nested-xr", "bad-bucket"},
+		},
+		"Reason": {
+			reason: "Objects with a condition with the given reason should be kept.",
+			filter: Filter{Reasons: []string{"Creating"}},
+			want:   []string{"broken-xr"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := names(tc.filter.Apply(graph()))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("%s\nApply(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
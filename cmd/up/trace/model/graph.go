@@ -0,0 +1,95 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package model
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+// NewGraph builds the graph of traced objects from a query response. It
+// returns the top-level objects, sorted. The resources owned by an object are
+// its Children.
+func NewGraph(respObjs []queryv1alpha2.QueryResponseObject) []*Object {
+	objs := make([]*Object, 0, len(respObjs))
+	for _, o := range respObjs {
+		obj, ok := newObject(o)
+		if !ok {
+			continue
+		}
+		obj.Children = NewGraph(o.Relations["resources"].Objects)
+		objs = append(objs, obj)
+	}
+	sort.Slice(objs, func(i, j int) bool { return objectLess(objs[i], objs[j]) })
+
+	return objs
+}
+
+// newObject translates a single query response object, without its children.
+// It returns false if the object is malformed.
+func newObject(o queryv1alpha2.QueryResponseObject) (*Object, bool) {
+	if o.Object == nil {
+		return nil, false // should never happen
+	}
+
+	gv, _, _ := unstructured.NestedString(o.Object.Object, "apiVersion")
+	ss := strings.SplitN(gv, "/", 2)
+	group := ""
+	if len(ss) == 2 {
+		group = ss[0]
+	}
+	kind, _, _ := unstructured.NestedString(o.Object.Object, "kind")
+	ns, _, _ := unstructured.NestedString(o.Object.Object, "metadata", "namespace")
+	name, _, _ := unstructured.NestedString(o.Object.Object, "metadata", "name")
+	creationString, _, _ := unstructured.NestedString(o.Object.Object, "metadata", "creationTimestamp")
+	creationTimestamp, err := time.Parse(time.RFC3339, creationString)
+	if err != nil {
+		return nil, false // should never happen as the kube API is type-safe
+	}
+	deletionString, _, _ := unstructured.NestedString(o.Object.Object, "metadata", "deletionTimestamp")
+	var deletionTimestamp time.Time
+	if deletionString != "" {
+		if deletionTimestamp, err = time.Parse(time.RFC3339, deletionString); err != nil {
+			return nil, false // should never happen as the kube API is type-safe
+		}
+	}
+	obj := &Object{
+		Group:     group,
+		Kind:      kind,
+		Id:        o.ID,
+		Namespace: ns,
+		Name:      name,
+		ControlPlane: ControlPlane{
+			Namespace: o.ControlPlane.Namespace,
+			Name:      o.ControlPlane.Name,
+		},
+		CreationTimestamp: creationTimestamp,
+		DeletionTimestamp: deletionTimestamp,
+		JSON:              *o.Object,
+	}
+
+	// translate events
+	for _, respEv := range o.Relations["events"].Objects {
+		var ev Event
+
+		ev.Message, _, _ = unstructured.NestedString(respEv.Object.Object, "message")
+		ev.Type, _, _ = unstructured.NestedString(respEv.Object.Object, "type")
+		count, _, _ := unstructured.NestedInt64(respEv.Object.Object, "count")
+		ev.Count = int(count)
+		ts, _, _ := unstructured.NestedString(respEv.Object.Object, "lastTimestamp")
+		if err := ev.LastTimestamp.Unmarshal([]byte(ts)); err != nil {
+			continue // ignore this event
+		}
+
+		obj.Events = append(obj.Events, ev)
+	}
+	sort.Sort(EventsOrder(obj.Events))
+
+	return obj, true
+}
@@ -9,6 +9,8 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
 	"github.com/upbound/up-sdk-go/apis/common"
 )
 
@@ -26,6 +28,9 @@ type Object struct {
 	JSON   common.JSONObject
 	Events []Event
 
+	// Changed is true if the object's conditions changed since the last poll.
+	Changed bool
+
 	Children []*Object
 }
 
@@ -49,10 +54,14 @@ func (o *Object) Title() string {
 	if !o.DeletionTimestamp.IsZero() {
 		prefix = "[:#ff0000]💀[:-] "
 	}
+	kind := o.Kind
+	if o.Changed {
+		kind = "[yellow::b]" + kind + "[-::-]"
+	}
 	if o.Namespace == "" {
-		return fmt.Sprintf("%s%s [darkgrey]%s[-]", prefix, o.Kind, o.Name)
+		return fmt.Sprintf("%s%s [darkgrey]%s[-]", prefix, kind, o.Name)
 	}
-	return fmt.Sprintf("%s%s [darkgrey]%s[-][::b]/[::-][darkgrey]%s[-]", prefix, o.Kind, o.Namespace, o.Name)
+	return fmt.Sprintf("%s%s [darkgrey]%s[-][::b]/[::-][darkgrey]%s[-]", prefix, kind, o.Namespace, o.Name)
 }
 
 func (o *Object) IsSynced(ts time.Time) bool {
@@ -78,3 +87,15 @@ func (o *Object) IsReady(ts time.Time) bool {
 	}
 	return false
 }
+
+// conditionsChanged returns true if the Synced or Ready condition of the
+// object differs from that of old.
+func conditionsChanged(old, o *Object) bool {
+	for _, ct := range []xpv1.ConditionType{xpv1.TypeSynced, xpv1.TypeReady} {
+		a, b := old.JSON.GetCondition(ct), o.JSON.GetCondition(ct)
+		if a.Status != b.Status || a.Reason != b.Reason {
+			return true
+		}
+	}
+	return false
+}
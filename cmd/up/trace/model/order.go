@@ -11,9 +11,13 @@ type ObjectsOrder []*tview.TreeNode
 
 func (o ObjectsOrder) Len() int { return len(o) }
 func (o ObjectsOrder) Less(i, j int) bool {
-	oi := o[i].GetReference().(*Object)
-	oj := o[i].GetReference().(*Object)
+	return objectLess(o[i].GetReference().(*Object), o[j].GetReference().(*Object))
+}
+func (o ObjectsOrder) Swap(i, j int) { o[i], o[j] = o[j], o[i] }
 
+// objectLess orders objects by group, kind, control plane, namespace and
+// name.
+func objectLess(oi, oj *Object) bool {
 	if a, b := oi.Group, oj.Group; a != b {
 		return a < b
 	}
@@ -35,7 +39,6 @@ func (o ObjectsOrder) Less(i, j int) bool {
 
 	return false
 }
-func (o ObjectsOrder) Swap(i, j int) { o[i], o[j] = o[j], o[i] }
 
 type EventsOrder []Event
 
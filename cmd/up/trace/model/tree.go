@@ -5,12 +5,9 @@ package model
 
 import (
 	"sort"
-	"strings"
-	"time"
 
 	"github.com/rivo/tview"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 
@@ -19,6 +16,7 @@ import (
 
 type Tree struct {
 	AutoCollapse bool
+	Filter       Filter
 
 	root *tview.TreeNode
 }
@@ -34,62 +32,23 @@ func (t *Tree) Root() *tview.TreeNode {
 }
 
 func (t *Tree) Update(objs []queryv1alpha2.QueryResponseObject) {
-	t.update(t.root, objs, 0)
+	t.update(t.root, t.Filter.Apply(NewGraph(objs)), 0)
 }
 
-func (t *Tree) update(parent *tview.TreeNode, respObjs []queryv1alpha2.QueryResponseObject, level int) []*Object { //nolint:gocyclo // TODO: split up
+func (t *Tree) update(parent *tview.TreeNode, objs []*Object, level int) []*Object {
 	existing := map[string]*tview.TreeNode{}
 	for _, n := range parent.GetChildren() {
 		obj := n.GetReference().(*Object)
 		existing[obj.Id] = n
 	}
 
-	for _, o := range respObjs {
-		if o.Object == nil {
-			continue // should never happen
-		}
-
-		gv, _, _ := unstructured.NestedString(o.Object.Object, "apiVersion")
-		ss := strings.SplitN(gv, "/", 2)
-		group := ""
-		if len(ss) == 2 {
-			group = ss[0]
-		}
-		kind, _, _ := unstructured.NestedString(o.Object.Object, "kind")
-		ns, _, _ := unstructured.NestedString(o.Object.Object, "metadata", "namespace")
-		name, _, _ := unstructured.NestedString(o.Object.Object, "metadata", "name")
-		creationString, _, _ := unstructured.NestedString(o.Object.Object, "metadata", "creationTimestamp")
-		creationTimestamp, err := time.Parse(time.RFC3339, creationString)
-		if err != nil {
-			continue // should never happen as the kube API is type-safe
-		}
-		deletionString, _, _ := unstructured.NestedString(o.Object.Object, "metadata", "deletionTimestamp")
-		var deletionTimestamp time.Time
-		if deletionString != "" {
-			if deletionTimestamp, err = time.Parse(time.RFC3339, deletionString); err != nil {
-				continue // should never happen as the kube API is type-safe
-			}
-		}
-		obj := &Object{
-			Group:     group,
-			Kind:      kind,
-			Id:        o.ID,
-			Namespace: ns,
-			Name:      name,
-			ControlPlane: ControlPlane{
-				Namespace: o.ControlPlane.Namespace,
-				Name:      o.ControlPlane.Name,
-			},
-			CreationTimestamp: creationTimestamp,
-			DeletionTimestamp: deletionTimestamp,
-			JSON:              *o.Object,
-		}
-
-		n, ok := existing[o.ID]
+	for _, obj := range objs {
+		n, ok := existing[obj.Id]
 		if ok {
 			old := n.GetReference().(*Object)
 			obj.Synced = old.Synced
 			obj.Ready = old.Ready
+			obj.Changed = conditionsChanged(old, obj)
 		} else {
 			n = tview.NewTreeNode("title")
 			parent.AddChild(n)
@@ -118,27 +77,9 @@ func (t *Tree) update(parent *tview.TreeNode, respObjs []queryv1alpha2.QueryResp
 			}
 		}
 
-		// translate events
-		obj.Events = nil
-		for _, respEv := range o.Relations["events"].Objects {
-			var ev Event
-
-			ev.Message, _, _ = unstructured.NestedString(respEv.Object.Object, "message")
-			ev.Type, _, _ = unstructured.NestedString(respEv.Object.Object, "type")
-			count, _, _ := unstructured.NestedInt64(respEv.Object.Object, "count")
-			ev.Count = int(count)
-			ts, _, _ := unstructured.NestedString(respEv.Object.Object, "lastTimestamp")
-			if err := ev.LastTimestamp.Unmarshal([]byte(ts)); err != nil {
-				continue // ignore this event
-			}
-
-			obj.Events = append(obj.Events, ev)
-		}
-		sort.Sort(EventsOrder(obj.Events))
-
-		obj.Children = t.update(n, o.Relations["resources"].Objects, level+1)
+		obj.Children = t.update(n, obj.Children, level+1)
 
-		delete(existing, o.ID)
+		delete(existing, obj.Id)
 	}
 
 	for _, n := range existing {
@@ -173,10 +114,10 @@ func (t *Tree) update(parent *tview.TreeNode, respObjs []queryv1alpha2.QueryResp
 
 	sort.Sort(ObjectsOrder(parent.GetChildren()))
 
-	objs := make([]*Object, len(parent.GetChildren()))
+	children := make([]*Object, len(parent.GetChildren()))
 	for i, n := range parent.GetChildren() {
-		objs[i] = n.GetReference().(*Object)
+		children[i] = n.GetReference().(*Object)
 	}
 
-	return objs
+	return children
 }
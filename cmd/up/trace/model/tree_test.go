@@ -0,0 +1,59 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package model
+
+import (
+	"testing"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+func readyObject(id, ready string) queryv1alpha2.QueryResponseObject {
+	return queryv1alpha2.QueryResponseObject{
+		ID:           id,
+		ControlPlane: &queryv1alpha2.QueryResponseControlPlane{Namespace: "default", Name: "ctp"},
+		Object: &common.JSONObject{Object: map[string]interface{}{
+			"apiVersion": "example.org/v1",
+			"kind":       "XBucket",
+			"metadata": map[string]interface{}{
+				"name":              id,
+				"creationTimestamp": "2025-01-01T00:00:00Z",
+			},
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": ready, "lastTransitionTime": "2025-01-01T00:00:00Z"},
+				},
+			},
+		}},
+	}
+}
+
+func TestTreeUpdateChanged(t *testing.T) {
+	tree := NewTree()
+
+	changed := func() map[string]bool {
+		got := map[string]bool{}
+		for _, n := range tree.Root().GetChildren() {
+			obj := n.GetReference().(*Object)
+			got[obj.Id] = obj.Changed
+		}
+		return got
+	}
+
+	tree.Update([]queryv1alpha2.QueryResponseObject{readyObject("a", "False"), readyObject("b", "False")})
+	if got := changed(); got["a"] || got["b"] {
+		t.Errorf("Update(...): new objects should not be marked changed, got %v", got)
+	}
+
+	tree.Update([]queryv1alpha2.QueryResponseObject{readyObject("a", "True"), readyObject("b", "False")})
+	if got := changed(); !got["a"] || got["b"] {
+		t.Errorf("Update(...): expected only a to be marked changed, got %v", got)
+	}
+
+	tree.Update([]queryv1alpha2.QueryResponseObject{readyObject("a", "True"), readyObject("b", "False")})
+	if got := changed(); got["a"] || got["b"] {
+		t.Errorf("Update(...): unchanged objects should not be marked changed, got %v", got)
+	}
+}
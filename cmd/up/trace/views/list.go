@@ -0,0 +1,58 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package views
+
+import (
+	"github.com/rivo/tview"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	"github.com/upbound/up/cmd/up/trace/model"
+	upviews "github.com/upbound/up/internal/tview/views"
+)
+
+var listColumns = []upviews.TableColumn{
+	{Title: "KIND"},
+	{Title: "GROUP"},
+	{Title: "CONTROLPLANE"},
+	{Title: "NAMESPACE"},
+	{Title: "NAME", Expansion: 1},
+	{Title: "SYNCED"},
+	{Title: "READY"},
+	{Title: "CREATED"},
+}
+
+// NewList returns a searchable table of all objects in the tree, including
+// those in collapsed subtrees.
+func NewList(app *tview.Application, m *model.Tree) *upviews.Table {
+	t := upviews.NewTable(app, listColumns, func() []upviews.TableRow {
+		var rows []upviews.TableRow
+		m.Root().Walk(func(node, _ *tview.TreeNode) bool {
+			ref := node.GetReference()
+			if ref == nil {
+				return true
+			}
+			o := ref.(*model.Object)
+			rows = append(rows, upviews.TableRow{
+				Cells: []string{
+					o.Kind,
+					o.Group,
+					objectName(o.ControlPlane.Namespace, o.ControlPlane.Name),
+					o.Namespace,
+					o.Name,
+					string(o.JSON.GetCondition(xpv1.TypeSynced).Status),
+					string(o.JSON.GetCondition(xpv1.TypeReady).Status),
+					o.CreationTimestamp.Format("2006-01-02 15:04:05"),
+				},
+				Reference: o,
+			})
+			return true
+		})
+		return rows
+	})
+	t.SetBorder(true).
+		SetTitle(" [::b]Objects[::-] [darkgray]/ search, 1-8 sort, enter jump to, esc back ")
+
+	return t
+}
@@ -139,6 +139,35 @@ func (t *Tree) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.
 	})
 }
 
+// SelectObject makes the node of the object with the given ID the current
+// node, expanding its ancestors. It returns false if there is no such node.
+func (t *Tree) SelectObject(id string) bool {
+	path := findNode(t.GetRoot(), id)
+	if path == nil {
+		return false
+	}
+	for _, n := range path[:len(path)-1] {
+		n.Expand()
+	}
+	t.SetCurrentNode(path[len(path)-1])
+	t.updateScrollers()
+	return true
+}
+
+// findNode returns the path from n to the node of the object with the given
+// ID, or nil if there is none.
+func findNode(n *tview.TreeNode, id string) []*tview.TreeNode {
+	if o, ok := n.GetReference().(*model.Object); ok && o.Id == id {
+		return []*tview.TreeNode{n}
+	}
+	for _, c := range n.GetChildren() {
+		if path := findNode(c, id); path != nil {
+			return append([]*tview.TreeNode{n}, path...)
+		}
+	}
+	return nil
+}
+
 func (t *Tree) updateScrollers() {
 	offset := t.GetScrollOffset()
 	line := t.GetCurrentLine()
@@ -6,7 +6,9 @@ package uxp
 import (
 	"fmt"
 	"io"
+	"os"
 
+	"dario.cat/mergo"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/yaml"
 
@@ -24,10 +26,19 @@ const (
 	errParseInstallParameters = "unable to parse install parameters"
 	errCreateImagePullSecret  = "failed to create image pull secret"
 	errSetChartValues         = "failed to set chart values"
+	errReadValuesFileFmt      = "unable to read values file %s"
+	errMergeValuesFileFmt     = "unable to merge values file %s"
 )
 
 // AfterApply sets default values in command after assignment and validation.
 func (c *installCmd) AfterApply(insCtx *install.Context) error {
+	if c.Version != "" && c.VersionFlag != "" && c.Version != c.VersionFlag {
+		return errors.Errorf("conflicting versions %s and --version=%s", c.Version, c.VersionFlag)
+	}
+	if c.Version == "" {
+		c.Version = c.VersionFlag
+	}
+
 	repo := uxp.RepoURL
 
 	filter := uxp.StableVersionFilter
@@ -79,11 +90,36 @@ func (c *installCmd) AfterApply(insCtx *install.Context) error {
 		}
 	}
 
+	// Values files are merged in order, so that later files take precedence.
+	// Values set with --set take precedence over all files.
+	for _, f := range c.Values {
+		v, err := readValuesFile(f)
+		if err != nil {
+			return err
+		}
+		if err := mergo.Merge(&values, v, mergo.WithOverride); err != nil {
+			return errors.Wrapf(err, errMergeValuesFileFmt, f)
+		}
+	}
+
 	c.parser = helm.NewParser(values, c.Set)
 
 	return nil
 }
 
+// readValuesFile reads a YAML file of helm values.
+func readValuesFile(path string) (map[string]any, error) {
+	b, err := os.ReadFile(path) //nolint:gosec // Reading a user-provided file is intended.
+	if err != nil {
+		return nil, errors.Wrapf(err, errReadValuesFileFmt, path)
+	}
+	values := map[string]any{}
+	if err := yaml.Unmarshal(b, &values); err != nil {
+		return nil, errors.Wrapf(err, errReadValuesFileFmt, path)
+	}
+	return values, nil
+}
+
 // installCmd installs UXP.
 type installCmd struct {
 	install.CommonParams
@@ -94,13 +130,25 @@ type installCmd struct {
 
 	Version version `arg:"" help:"UXP version to install. Should be >= 2.0.0-up.0, use the helm chart to install uxp v1." optional:""`
 
-	Unstable     bool `help:"Allow installing unstable versions."`
-	DisableWebUI bool `help:"Disable the UXP web UI."                                                  optional:""`
-	ClusterAdmin bool `help:"Install UXP with cluster admin permissions. NOT FOR PRODUCTION PURPOSES." optional:""`
+	VersionFlag  version  `help:"UXP version to install. Alternative to the version argument."                                                  name:"version"`
+	Values       []string `help:"YAML file with helm values for the UXP chart. Can be repeated, later files take precedence over earlier ones." type:"existingfile"`
+	Unstable     bool     `help:"Allow installing unstable versions."`
+	DisableWebUI bool     `help:"Disable the UXP web UI."                                                                                       optional:""`
+	ClusterAdmin bool     `help:"Install UXP with cluster admin permissions. NOT FOR PRODUCTION PURPOSES."                                      optional:""`
 }
 
 // Run executes the install command.
 func (c *installCmd) Run(p upterm.Printer) error {
+	// If UXP is already installed, check the version. If it's the requested
+	// one there is nothing to do, otherwise it has to be upgraded instead.
+	if v, err := c.mgr.GetCurrentVersion(); err == nil {
+		if c.Version != "" && v != string(c.Version) {
+			return errors.Errorf("existing cluster has wrong UXP version installed: got %s, want %s; use `up uxp upgrade` to change it", v, c.Version)
+		}
+		p.PrintInfo(fmt.Sprintf("UXP %s is already installed", v))
+		return nil
+	}
+
 	if err := p.WrapWithSuccessSpinner(
 		"Installing UXP",
 		func() error {
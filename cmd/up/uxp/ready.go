@@ -0,0 +1,72 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package uxp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// waitForDeploymentsReady waits for all deployments in a namespace to finish
+// rolling out. A timeout of zero waits until the context is done. If the
+// timeout expires, the returned error names a deployment that isn't ready.
+func waitForDeploymentsReady(ctx context.Context, kube kubernetes.Interface, namespace string, interval, timeout time.Duration) error {
+	pollCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		pollCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var last string
+	err := wait.PollUntilContextCancel(pollCtx, interval, true, func(ctx context.Context) (done bool, err error) {
+		l, err := kube.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return false, err
+		}
+		for i := range l.Items {
+			if reason := deploymentNotReady(&l.Items[i]); reason != "" {
+				last = reason
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	switch {
+	case err == nil:
+		return nil
+	case timeout > 0 && errors.Is(pollCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil:
+		return errors.Errorf("timed out after %s waiting for UXP to be ready: %s", timeout, last)
+	default:
+		return errors.Wrap(err, "waiting for UXP to be ready")
+	}
+}
+
+// deploymentNotReady returns why a deployment hasn't finished rolling out, or
+// an empty string if it has.
+func deploymentNotReady(d *appsv1.Deployment) string {
+	want := int32(1)
+	if d.Spec.Replicas != nil {
+		want = *d.Spec.Replicas
+	}
+	switch {
+	case d.Status.ObservedGeneration < d.Generation:
+		return fmt.Sprintf("deployment %q has not observed its latest spec", d.Name)
+	case d.Status.UpdatedReplicas < want:
+		return fmt.Sprintf("deployment %q has %d of %d replicas updated", d.Name, d.Status.UpdatedReplicas, want)
+	case d.Status.Replicas > d.Status.UpdatedReplicas:
+		return fmt.Sprintf("deployment %q has %d old replicas pending termination", d.Name, d.Status.Replicas-d.Status.UpdatedReplicas)
+	case d.Status.AvailableReplicas < want:
+		return fmt.Sprintf("deployment %q has %d of %d replicas available", d.Name, d.Status.AvailableReplicas, want)
+	}
+	return ""
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package uxp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func TestDeploymentNotReady(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		d      *appsv1.Deployment
+		want   bool
+	}{
+		"Ready": {
+			reason: "A deployment with all replicas updated and available should be ready.",
+			d:      deployment(1, 1, appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1}),
+		},
+		"NotObserved": {
+			reason: "A deployment whose latest spec hasn't been observed should not be ready.",
+			d:      deployment(2, 1, appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1}),
+			want:   true,
+		},
+		"NotUpdated": {
+			reason: "A deployment with replicas of the old spec should not be ready.",
+			d:      deployment(1, 2, appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 2, UpdatedReplicas: 1, AvailableReplicas: 2}),
+			want:   true,
+		},
+		"OldReplicas": {
+			reason: "A deployment with old replicas pending termination should not be ready.",
+			d:      deployment(1, 1, appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 2, UpdatedReplicas: 1, AvailableReplicas: 1}),
+			want:   true,
+		},
+		"NotAvailable": {
+			reason: "A deployment with unavailable replicas should not be ready.",
+			d:      deployment(1, 1, appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 1, UpdatedReplicas: 1}),
+			want:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := deploymentNotReady(tc.d)
+			if (got != "") != tc.want {
+				t.Errorf("\n%s\ndeploymentNotReady(...): got %q, want not ready: %t", tc.reason, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWaitForDeploymentsReady(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		d       *appsv1.Deployment
+		wantErr bool
+	}{
+		"Ready": {
+			reason: "Waiting should succeed if all deployments are ready.",
+			d:      deployment(1, 1, appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1}),
+		},
+		"TimedOut": {
+			reason:  "Waiting should fail if a deployment doesn't become ready within the timeout.",
+			d:       deployment(1, 1, appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 1, UpdatedReplicas: 1}),
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kube := fake.NewClientset(tc.d)
+			err := waitForDeploymentsReady(context.Background(), kube, tc.d.Namespace, time.Millisecond, 50*time.Millisecond)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("\n%s\nwaitForDeploymentsReady(...): unexpected error: %v", tc.reason, err)
+			}
+		})
+	}
+}
+
+func deployment(generation int64, replicas int32, status appsv1.DeploymentStatus) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "crossplane",
+			Namespace:  "crossplane-system",
+			Generation: generation,
+		},
+		Spec:   appsv1.DeploymentSpec{Replicas: ptr.To(replicas)},
+		Status: status,
+	}
+}
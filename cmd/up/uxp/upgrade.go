@@ -4,8 +4,10 @@
 package uxp
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/yaml"
@@ -20,6 +22,10 @@ import (
 
 const (
 	errParseUpgradeParameters = "unable to parse upgrade parameters"
+
+	// readyInterval is how often to check whether UXP is ready after an
+	// upgrade.
+	readyInterval = 2 * time.Second
 )
 
 // AfterApply sets default values in command after assignment and validation.
@@ -36,9 +42,7 @@ func (c *upgradeCmd) AfterApply(insCtx *install.Context) error {
 		*repo,
 		uxp.ChartNamespace,
 		helm.WithChart(c.Bundle),
-		helm.RollbackOnError(c.Rollback),
 		helm.Force(c.Force),
-		helm.Wait(),
 		helm.CreateNamespace(true),
 		helm.WithVersionFilter(filter),
 	)
@@ -82,14 +86,20 @@ type upgradeCmd struct {
 
 	Version version `arg:"" help:"UXP version to upgrade. Should be >= 2.0.0-up.0, use the helm chart to install uxp v1." optional:""`
 
-	Rollback bool `help:"Rollback to previously installed version on failed upgrade."`
-	Force    bool `help:"Force upgrade even if versions are incompatible."`
-	Unstable bool `help:"Allow installing unstable versions."`
+	Rollback bool          `default:"true"                                          help:"Roll back to the previous revision if the upgrade fails or UXP doesn't become ready within the timeout. Use --no-rollback to keep a failed upgrade for debugging." negatable:""`
+	Timeout  time.Duration `default:"10m"                                           help:"How long to wait for UXP to become ready after upgrading."`
+	Force    bool          `help:"Force upgrade even if versions are incompatible."`
+	Unstable bool          `help:"Allow installing unstable versions."`
 }
 
 // Run executes the upgrade command.
-func (c *upgradeCmd) Run(p upterm.Printer) error {
-	if err := p.WrapWithSuccessSpinner(
+func (c *upgradeCmd) Run(ctx context.Context, p upterm.Printer) error {
+	prevVer, err := c.mgr.GetCurrentVersion()
+	if err != nil {
+		return err
+	}
+
+	upErr := p.WrapWithSuccessSpinner(
 		"Upgrading UXP",
 		func() error {
 			params, err := c.parser.Parse()
@@ -98,8 +108,23 @@ func (c *upgradeCmd) Run(p upterm.Printer) error {
 			}
 			return c.mgr.Upgrade(string(c.Version), params)
 		},
-	); err != nil {
-		return err
+	)
+	if upErr == nil {
+		upErr = p.WrapWithSuccessSpinner(
+			"Waiting for UXP to be ready",
+			func() error {
+				return waitForDeploymentsReady(ctx, c.kClient, uxp.ChartNamespace, readyInterval, c.Timeout)
+			},
+		)
+	}
+	if upErr != nil {
+		if !c.Rollback {
+			return upErr
+		}
+		if err := p.WrapWithSuccessSpinner(fmt.Sprintf("Rolling back UXP to %s", prevVer), c.mgr.Rollback); err != nil {
+			return errors.Wrapf(err, "upgrade failed and rollback to %s failed: %v", prevVer, upErr)
+		}
+		return errors.Wrapf(upErr, "upgrade failed, rolled back to %s", prevVer)
 	}
 
 	curVer, err := c.mgr.GetCurrentVersion()
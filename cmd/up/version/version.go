@@ -5,8 +5,10 @@ package version
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"runtime"
 
 	"github.com/alecthomas/kong"
@@ -29,6 +31,11 @@ const (
 	errGetSpacesVersion     = "unable to get spaces version. Is your kubecontext pointed at a Space?"
 )
 
+const (
+	outputDefault = "default"
+	outputJSON    = "json"
+)
+
 const (
 	versionUnknown  = "unknown"
 	versionTemplate = `{{with .Client -}}
@@ -36,6 +43,7 @@ Client:
   Version:	{{.Version}}
   Go Version:	{{.GoVersion}}
   Git Commit: 	{{.GitCommit}}
+  Build Date:	{{.BuildDate}}
   OS/Arch:	{{.OS}}/{{.Arch}}
 {{- end}}
 
@@ -49,6 +57,7 @@ Server:
 // ClientVersion is the version of the client.
 type ClientVersion struct {
 	Arch      string `json:"arch,omitempty"      yaml:"arch,omitempty"`
+	BuildDate string `json:"buildDate,omitempty" yaml:"buildDate,omitempty"`
 	GitCommit string `json:"gitCommit,omitempty" yaml:"gitCommit,omitempty"`
 	GoVersion string `json:"goVersion,omitempty" yaml:"goVersion,omitempty"`
 	OS        string `json:"os,omitempty"        yaml:"os,omitempty"`
@@ -71,7 +80,8 @@ type Info struct {
 type Cmd struct {
 	upbound.RequiresContext
 
-	Client bool `env:"" help:"If true, shows client version only (no server required)." json:"client,omitempty"`
+	Client bool   `env:""            help:"If true, shows client version only (no server required)." json:"client,omitempty"`
+	Output string `default:"default" enum:"default,json"                                             help:"Output format. Can be: default, json. Overrides --format." short:"o"`
 }
 
 // BeforeApply sets default values and parses flags.
@@ -99,6 +109,7 @@ func (c *Cmd) BuildVersionInfo(ctx context.Context, kongCtx *kong.Context, upCtx
 		OS:        runtime.GOOS,
 		GoVersion: runtime.Version(),
 		GitCommit: version.GitCommit(),
+		BuildDate: version.BuildDate(),
 	}
 
 	if c.Client {
@@ -123,23 +134,30 @@ func (c *Cmd) BuildVersionInfo(ctx context.Context, kongCtx *kong.Context, upCtx
 		return v
 	}
 
-	v.Server = &ServerVersion{}
-	v.Server.CrossplaneVersion, err = FetchCrossplaneVersion(ctx, *clientset)
-	if err != nil {
+	s := &ServerVersion{}
+	var xpErr, spacesErr error
+	s.CrossplaneVersion, xpErr = FetchCrossplaneVersion(ctx, *clientset)
+	if xpErr != nil {
 		fmt.Fprintln(kongCtx.Stderr, errGetCrossplaneVersion) //nolint:errcheck // Debug logging.
 	}
-	if v.Server.CrossplaneVersion == "" {
-		v.Server.CrossplaneVersion = versionUnknown
+	if s.CrossplaneVersion == "" {
+		s.CrossplaneVersion = versionUnknown
 	}
 
-	v.Server.SpacesControllerVersion, err = FetchSpacesVersion(ctx, context, *clientset)
-	if err != nil {
+	s.SpacesControllerVersion, spacesErr = FetchSpacesVersion(ctx, context, *clientset)
+	if spacesErr != nil {
 		fmt.Fprintln(kongCtx.Stderr, errGetSpacesVersion) //nolint:errcheck // Debug logging.
 	}
-	if v.Server.SpacesControllerVersion == "" {
-		v.Server.SpacesControllerVersion = versionUnknown
+	if s.SpacesControllerVersion == "" {
+		s.SpacesControllerVersion = versionUnknown
 	}
 
+	// Omit the server if neither version could be fetched, e.g. because the
+	// cluster isn't reachable.
+	if xpErr != nil && spacesErr != nil {
+		return v
+	}
+	v.Server = s
 	return v
 }
 
@@ -155,5 +173,15 @@ func (c *Cmd) Run(ctx context.Context, kongCtx *kong.Context, upCtx *upbound.Con
 		))
 	}
 
+	if c.Output == outputJSON {
+		return printJSON(kongCtx.Stdout, v)
+	}
 	return printer.PrintObjectTemplate(v, versionTemplate)
 }
+
+// printJSON prints the version info as indented JSON.
+func printJSON(w io.Writer, v Info) error {
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(v)
+}
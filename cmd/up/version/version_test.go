@@ -0,0 +1,79 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package version
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPrintJSON(t *testing.T) {
+	client := ClientVersion{
+		Arch:      "arm64",
+		BuildDate: "2025-01-02T03:04:05Z",
+		GitCommit: "abc1234",
+		GoVersion: "go1.24.0",
+		OS:        "darwin",
+		Version:   "v0.40.0",
+	}
+	wantClient := map[string]any{
+		"arch":      "arm64",
+		"buildDate": "2025-01-02T03:04:05Z",
+		"gitCommit": "abc1234",
+		"goVersion": "go1.24.0",
+		"os":        "darwin",
+		"version":   "v0.40.0",
+	}
+
+	cases := map[string]struct {
+		reason string
+		info   Info
+		want   map[string]any
+	}{
+		"ClientOnly": {
+			reason: "Server fields should be omitted when not connected to a server.",
+			info:   Info{Client: client},
+			want: map[string]any{
+				"client": wantClient,
+			},
+		},
+		"WithServer": {
+			reason: "Server versions should be included when connected to a server.",
+			info: Info{
+				Client: client,
+				Server: &ServerVersion{
+					CrossplaneVersion:       "v1.20.0",
+					SpacesControllerVersion: "1.14.0",
+				},
+			},
+			want: map[string]any{
+				"client": wantClient,
+				"server": map[string]any{
+					"crossplaneVersion":       "v1.20.0",
+					"spacesControllerVersion": "1.14.0",
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := printJSON(&buf, tc.info); err != nil {
+				t.Fatalf("\n%s\nprintJSON(...): unexpected error: %v", tc.reason, err)
+			}
+
+			got := map[string]any{}
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("\n%s\nprintJSON(...): invalid JSON: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nprintJSON(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
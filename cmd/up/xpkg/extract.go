@@ -0,0 +1,130 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package xpkg
+
+import (
+	"context"
+	"path"
+	"sort"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/spf13/afero"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up/internal/filesystem"
+	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/upterm"
+	"github.com/upbound/up/internal/xpkg"
+	xpkgmarshaler "github.com/upbound/up/internal/xpkg/dep/marshaler/xpkg"
+
+	_ "embed"
+)
+
+//go:embed help/extract.md
+var extractHelp string
+
+// Help returns help for the extract command.
+func (c *extractCmd) Help() string {
+	return extractHelp
+}
+
+// AfterApply sets default values in command after assignment and validation.
+func (c *extractCmd) AfterApply() error {
+	c.fs = afero.NewOsFs()
+	c.platform = hostPlatform()
+	if c.Platform != "" {
+		p, err := v1.ParsePlatform(c.Platform)
+		if err != nil {
+			return errors.Wrap(err, "invalid platform")
+		}
+		c.platform = *p
+	}
+	return nil
+}
+
+// extractCmd extracts the contents of a package into a directory.
+type extractCmd struct {
+	upbound.RequiresContext
+
+	fs       afero.Fs
+	platform v1.Platform
+
+	Package  string `arg:""                                                                                                                                 help:"Path to a package file, or a reference to a package in a registry."`
+	Dir      string `help:"Directory to extract the package into."                                                                                          placeholder:"PATH"                                                        required:"" type:"path"`
+	Platform string `help:"Platform to extract from a multi-platform package, such as linux/arm64. Defaults to linux and the architecture of this machine." placeholder:"OS/ARCH"`
+}
+
+// Run executes the extract command.
+func (c *extractCmd) Run(ctx context.Context, p upterm.Printer, upCtx *upbound.Context) error {
+	img, err := fetchPackage(ctx, upCtx, c.Package, c.platform)
+	if err != nil {
+		return err
+	}
+
+	files, err := extractPackage(img, afero.NewBasePathFs(c.fs, c.Dir))
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		p.Printfln("Extracted %s", f)
+	}
+	p.Printfln("Package extracted to %s", c.Dir)
+	return nil
+}
+
+// extractPackage writes the package YAML stream, examples, and Helm chart of a
+// package to the given filesystem at the paths they have in the package, and
+// each language's schemas to a schema.<language> directory. It returns what
+// was written, sorted.
+func extractPackage(img v1.Image, fs afero.Fs) ([]string, error) {
+	// Images read from a file have their layer annotations in their config,
+	// but parsing schemas relies on them being in the manifest.
+	img, err := xpkg.AnnotateImage(img)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to annotate package")
+	}
+
+	m, err := xpkgmarshaler.NewMarshaler()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create package marshaler")
+	}
+	pkg, err := m.FromImage(xpkg.Image{Image: img})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse package")
+	}
+	contents, err := xpkg.ReadContents(img)
+	if err != nil {
+		return nil, err
+	}
+
+	var written []string
+	for name, b := range map[string][]byte{
+		xpkg.StreamFile:        contents.Package,
+		xpkg.XpkgExamplesFile:  contents.Examples,
+		xpkg.XpkgHelmChartFile: contents.HelmChart,
+	} {
+		if len(b) == 0 {
+			continue
+		}
+		if err := fs.MkdirAll(path.Dir(name), 0o755); err != nil {
+			return nil, errors.Wrapf(err, "failed to create directory for %s", name)
+		}
+		if err := afero.WriteFile(fs, name, b, 0o644); err != nil {
+			return nil, errors.Wrapf(err, "failed to write %s", name)
+		}
+		written = append(written, name)
+	}
+
+	for lang, schemaFS := range pkg.Schema {
+		dir := xpkg.SchemaAnnotationPrefix + lang
+		if err := filesystem.CopyFilesBetweenFs(schemaFS, afero.NewBasePathFs(fs, dir)); err != nil {
+			return nil, errors.Wrapf(err, "failed to write %s schemas", lang)
+		}
+		written = append(written, dir+"/")
+	}
+
+	sort.Strings(written)
+	return written, nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package xpkg
+
+import (
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/spf13/afero"
+
+	"github.com/upbound/up/internal/xpkg"
+)
+
+func TestExtractPackage(t *testing.T) {
+	type file struct {
+		name       string
+		annotation string
+		content    string
+	}
+
+	stream := "apiVersion: meta.pkg.crossplane.io/v1\nkind: Configuration\nmetadata:\n  name: my-project\n"
+
+	cases := map[string]struct {
+		reason string
+		files  []file
+		want   map[string]string
+	}{
+		"PackageOnly": {
+			reason: "Only the package YAML stream should be written for a package with nothing else.",
+			files: []file{
+				{name: xpkg.StreamFile, annotation: xpkg.PackageAnnotation, content: stream},
+			},
+			want: map[string]string{
+				xpkg.StreamFile: stream,
+			},
+		},
+		"ExamplesAndSchemas": {
+			reason: "Examples should be written at their path in the package, and schemas to a directory per language.",
+			files: []file{
+				{name: xpkg.StreamFile, annotation: xpkg.PackageAnnotation, content: stream},
+				{name: xpkg.XpkgExamplesFile, annotation: xpkg.ExamplesAnnotation, content: "kind: XNetwork\n"},
+				{name: "models/__init__.py", annotation: xpkg.SchemaAnnotationPrefix + "python", content: "# schemas\n"},
+			},
+			want: map[string]string{
+				xpkg.StreamFile:                    stream,
+				xpkg.XpkgExamplesFile:              "kind: XNetwork\n",
+				"schema.python/models/__init__.py": "# schemas\n",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg := v1.Config{Labels: map[string]string{}}
+			img := empty.Image
+			for _, f := range tc.files {
+				l, err := xpkg.Layer(strings.NewReader(f.content), f.name, f.annotation, int64(len(f.content)), xpkg.StreamFileMode, &cfg)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if img, err = mutate.AppendLayers(img, l); err != nil {
+					t.Fatal(err)
+				}
+			}
+			img, err := mutate.Config(img, cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			out := afero.NewMemMapFs()
+			if _, err := extractPackage(img, afero.NewBasePathFs(out, "/out")); err != nil {
+				t.Fatalf("\n%s\nextractPackage(...): unexpected error: %v", tc.reason, err)
+			}
+
+			got := map[string]string{}
+			_ = afero.Walk(out, "/out", func(p string, info fs.FileInfo, err error) error {
+				if err != nil || info.IsDir() {
+					return err
+				}
+				b, err := afero.ReadFile(out, p)
+				got[strings.TrimPrefix(p, "/out/")] = string(b)
+				return err
+			})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nextractPackage(...): -want files, +got files:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package xpkg
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	goruntime "runtime"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	apimachyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/upterm"
+	"github.com/upbound/up/internal/xpkg"
+
+	_ "embed"
+)
+
+//go:embed inspect.tmpl
+var inspectTemplate string
+
+//go:embed help/inspect.md
+var inspectHelp string
+
+// Help returns help for the inspect command.
+func (c *inspectCmd) Help() string {
+	return inspectHelp
+}
+
+// inspectCmd inspects the contents of a package.
+type inspectCmd struct {
+	upbound.RequiresContext
+
+	Package string `arg:"" help:"Path to a package file, or a reference to a package in a registry."`
+}
+
+// packageInspection is the summary of a package printed by inspectCmd.
+type packageInspection struct {
+	Source      string            `json:"source"`
+	Digest      string            `json:"digest"`
+	Meta        *packageMeta      `json:"meta,omitempty"`
+	Objects     int               `json:"objects"`
+	Examples    bool              `json:"examples"`
+	HelmChart   bool              `json:"helmChart"`
+	Auth        bool              `json:"auth"`
+	Schemas     []string          `json:"schemas"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Layers      []packageLayer    `json:"layers"`
+}
+
+// packageMeta identifies the package metadata object in a package.
+type packageMeta struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+}
+
+// packageLayer is a layer of a package image.
+type packageLayer struct {
+	Digest     string `json:"digest"`
+	Size       int64  `json:"size"`
+	Annotation string `json:"annotation,omitempty"`
+}
+
+// Run executes the inspect command.
+func (c *inspectCmd) Run(ctx context.Context, p upterm.Printer, upCtx *upbound.Context) error {
+	img, err := fetchPackage(ctx, upCtx, c.Package, hostPlatform())
+	if err != nil {
+		return err
+	}
+	in, err := inspectPackage(img)
+	if err != nil {
+		return err
+	}
+	in.Source = c.Package
+	return p.PrintObjectTemplate(in, inspectTemplate)
+}
+
+// fetchPackage reads a package from a file if one exists at the given path,
+// and otherwise pulls it from a registry. The image for the given platform is
+// pulled if the package is a multi-platform index.
+func fetchPackage(ctx context.Context, upCtx *upbound.Context, pkg string, platform v1.Platform) (v1.Image, error) {
+	if _, err := os.Stat(pkg); err == nil {
+		img, err := tarball.ImageFromPath(filepath.Clean(pkg), nil)
+		return img, errors.Wrap(err, "failed to read package file")
+	}
+
+	ref, err := name.ParseReference(pkg, name.WithDefaultRegistry(upCtx.RegistryEndpoint.Hostname()))
+	if err != nil {
+		return nil, errors.Wrap(err, errInvalidTag)
+	}
+	img, err := remote.Image(ref,
+		remote.WithContext(ctx),
+		remote.WithAuthFromKeychain(upCtx.RegistryKeychain()),
+		remote.WithTransport(upCtx.Transport()),
+		remote.WithPlatform(platform),
+	)
+	return img, errors.Wrap(err, errFetchPackage)
+}
+
+// hostPlatform returns the platform of packages that run on this machine.
+// Packages are always built for Linux, so only the architecture is taken from
+// the host.
+func hostPlatform() v1.Platform {
+	return v1.Platform{OS: "linux", Architecture: goruntime.GOARCH}
+}
+
+// inspectPackage summarizes the contents of a package image.
+func inspectPackage(img v1.Image) (*packageInspection, error) {
+	contents, err := xpkg.ReadContents(img)
+	if err != nil {
+		return nil, err
+	}
+	d, err := img.Digest()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get package digest")
+	}
+	cfgFile, err := img.ConfigFile()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get package config")
+	}
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, errors.Wrap(err, errGetManifest)
+	}
+
+	in := &packageInspection{
+		Digest:      d.String(),
+		Examples:    len(contents.Examples) != 0,
+		HelmChart:   len(contents.HelmChart) != 0,
+		Auth:        contents.IncludesAuth,
+		Schemas:     contents.Schemas(),
+		Annotations: packageAnnotations(manifest, cfgFile.Config),
+		Layers:      make([]packageLayer, 0, len(manifest.Layers)),
+	}
+	if in.Schemas == nil {
+		in.Schemas = []string{}
+	}
+	if in.Meta, in.Objects, err = parsePackageStream(contents.Package); err != nil {
+		return nil, err
+	}
+
+	for _, l := range manifest.Layers {
+		a, ok := cfgFile.Config.Labels[xpkg.Label(l.Digest.String())]
+		if !ok {
+			a = l.Annotations[xpkg.AnnotationKey]
+		}
+		in.Layers = append(in.Layers, packageLayer{
+			Digest:     l.Digest.String(),
+			Size:       l.Size,
+			Annotation: a,
+		})
+	}
+
+	return in, nil
+}
+
+// packageAnnotations returns the annotations of a package's manifest. Images
+// read from a file don't have manifest annotations, so the labels that are
+// copied to annotations when the package is pushed are included too.
+func packageAnnotations(manifest *v1.Manifest, cfg v1.Config) map[string]string {
+	annotations := make(map[string]string)
+	for k, v := range cfg.Labels {
+		if key, ok := strings.CutPrefix(k, xpkg.ManifestAnnotationLabelPrefix); ok {
+			annotations[key] = v
+		}
+	}
+	for k, v := range manifest.Annotations {
+		annotations[k] = v
+	}
+	return annotations
+}
+
+// parsePackageStream returns the package metadata object in a package YAML
+// stream, and the number of other objects in it.
+func parsePackageStream(b []byte) (*packageMeta, int, error) {
+	var (
+		meta    *packageMeta
+		objects int
+	)
+	yr := apimachyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(b)))
+	for {
+		doc, err := yr.Read()
+		if errors.Is(err, io.EOF) {
+			return meta, objects, nil
+		}
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "failed to read package stream")
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		var obj struct {
+			APIVersion string `json:"apiVersion"`
+			Kind       string `json:"kind"`
+			Metadata   struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+		}
+		if err := yaml.Unmarshal(doc, &obj); err != nil {
+			return nil, 0, errors.Wrap(err, "failed to parse package stream")
+		}
+		gv, err := schema.ParseGroupVersion(obj.APIVersion)
+		if err == nil && meta == nil && strings.HasPrefix(gv.Group, "meta.pkg.") {
+			meta = &packageMeta{APIVersion: obj.APIVersion, Kind: obj.Kind, Name: obj.Metadata.Name}
+			continue
+		}
+		objects++
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package xpkg
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+
+	"github.com/upbound/up/internal/xpkg"
+)
+
+func TestParsePackageStream(t *testing.T) {
+	type want struct {
+		meta    *packageMeta
+		objects int
+		err     bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		stream string
+		want   want
+	}{
+		"Configuration": {
+			reason: "The package metadata object should be identified and the other objects counted.",
+			stream: `---
+apiVersion: meta.pkg.crossplane.io/v1
+kind: Configuration
+metadata:
+  name: my-project
+---
+apiVersion: apiextensions.crossplane.io/v1
+kind: CompositeResourceDefinition
+metadata:
+  name: xnetworks.example.com
+---
+apiVersion: apiextensions.crossplane.io/v1
+kind: Composition
+metadata:
+  name: xnetworks.example.com
+`,
+			want: want{
+				meta:    &packageMeta{APIVersion: "meta.pkg.crossplane.io/v1", Kind: "Configuration", Name: "my-project"},
+				objects: 2,
+			},
+		},
+		"UpboundMeta": {
+			reason: "Upbound package metadata objects should be identified.",
+			stream: `apiVersion: meta.pkg.upbound.io/v1alpha1
+kind: Controller
+metadata:
+  name: my-controller
+`,
+			want: want{
+				meta: &packageMeta{APIVersion: "meta.pkg.upbound.io/v1alpha1", Kind: "Controller", Name: "my-controller"},
+			},
+		},
+		"NoMeta": {
+			reason: "A stream without a package metadata object should only count objects.",
+			stream: `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: buckets.s3.aws.upbound.io
+`,
+			want: want{
+				objects: 1,
+			},
+		},
+		"Invalid": {
+			reason: "An invalid stream should return an error.",
+			stream: "apiVersion: [",
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			meta, objects, err := parsePackageStream([]byte(tc.stream))
+			if (err != nil) != tc.want.err {
+				t.Fatalf("\n%s\nparsePackageStream(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.meta, meta); diff != "" {
+				t.Errorf("\n%s\nparsePackageStream(...): -want meta, +got meta:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.objects, objects); diff != "" {
+				t.Errorf("\n%s\nparsePackageStream(...): -want objects, +got objects:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestInspectPackage(t *testing.T) {
+	stream := "apiVersion: meta.pkg.crossplane.io/v1\nkind: Configuration\nmetadata:\n  name: my-project\n"
+	schemas := "schemas"
+
+	cfg := v1.Config{Labels: map[string]string{
+		xpkg.ManifestAnnotationLabel("org.opencontainers.image.source"): "https://github.com/example/my-project",
+	}}
+	pkgLayer, err := xpkg.Layer(strings.NewReader(stream), xpkg.StreamFile, xpkg.PackageAnnotation, int64(len(stream)), xpkg.StreamFileMode, &cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schemaLayer, err := xpkg.Layer(strings.NewReader(schemas), "models/schemas.txt", xpkg.SchemaAnnotationPrefix+"python", int64(len(schemas)), xpkg.StreamFileMode, &cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := mutate.AppendLayers(empty.Image, pkgLayer, schemaLayer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if img, err = mutate.Config(img, cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := inspectPackage(img)
+	if err != nil {
+		t.Fatalf("inspectPackage(...): unexpected error: %v", err)
+	}
+
+	want := &packageInspection{
+		Meta:        &packageMeta{APIVersion: "meta.pkg.crossplane.io/v1", Kind: "Configuration", Name: "my-project"},
+		Schemas:     []string{"python"},
+		Annotations: map[string]string{"org.opencontainers.image.source": "https://github.com/example/my-project"},
+	}
+	// Digests and sizes depend on how the layers are compressed.
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(packageInspection{}, "Digest", "Layers")); diff != "" {
+		t.Errorf("inspectPackage(...): -want, +got:\n%s", diff)
+	}
+
+	annotations := make([]string, 0, len(got.Layers))
+	for _, l := range got.Layers {
+		annotations = append(annotations, l.Annotation)
+	}
+	if diff := cmp.Diff([]string{xpkg.PackageAnnotation, "schema.python"}, annotations); diff != "" {
+		t.Errorf("inspectPackage(...): -want layer annotations, +got layer annotations:\n%s", diff)
+	}
+}
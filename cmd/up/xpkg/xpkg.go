@@ -23,6 +23,8 @@ type Cmd struct {
 	Push      pushCmd      `cmd:"" help:"Push a package."`
 	Batch     batchCmd     `cmd:"" help:"Batch build and push a family of service-scoped provider packages."                                             maturity:"alpha"`
 	Append    appendCmd    `cmd:"" help:"Append additional files to an xpkg."                                                                            maturity:"alpha"`
+	Inspect   inspectCmd   `cmd:"" help:"Print a summary of the contents of a package."`
+	Extract   extractCmd   `cmd:"" help:"Extract the contents of a package into a directory."`
 }
 
 //go:embed help/xpkg.md
@@ -0,0 +1,61 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package xpls
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up/internal/upterm"
+	"github.com/upbound/up/internal/xpls"
+)
+
+var diagnosticFieldNames = []string{"FILE", "LINE", "COLUMN", "SEVERITY", "MESSAGE"}
+
+// diagnosticsCmd prints the language server's diagnostics for files without
+// starting a server.
+type diagnosticsCmd struct {
+	Path string `arg:"" default:"." help:"File or directory to diagnose." type:"path"`
+}
+
+//go:embed help/diagnostics.md
+var diagnosticsHelp string
+
+// Help returns help.
+func (c *diagnosticsCmd) Help() string {
+	return diagnosticsHelp
+}
+
+// Run prints diagnostics, returning an error if any of them are errors.
+func (c *diagnosticsCmd) Run(ctx context.Context, p upterm.Printer) error {
+	diags, err := xpls.Diagnose(ctx, c.Path)
+	if err != nil {
+		return errors.Wrap(err, "cannot diagnose files")
+	}
+	if err := p.PrintObject(diags, diagnosticFieldNames, extractDiagnosticFields); err != nil {
+		return err
+	}
+
+	var n int
+	for _, d := range diags {
+		if d.IsError() {
+			n++
+		}
+	}
+	if n > 0 {
+		return errors.Errorf("found %d error(s)", n)
+	}
+	return nil
+}
+
+func extractDiagnosticFields(obj any) []string {
+	d, ok := obj.(xpls.Diagnostic)
+	if !ok {
+		return []string{"unknown", "", "", "", ""}
+	}
+	return []string{d.Path, fmt.Sprint(d.Line), fmt.Sprint(d.Column), d.Severity, d.Message}
+}
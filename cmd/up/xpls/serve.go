@@ -5,16 +5,22 @@ package xpls
 
 import (
 	"context"
+	"time"
 
 	"github.com/sourcegraph/jsonrpc2"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 
+	"github.com/upbound/up/internal/xpkg/dep/cache"
+	"github.com/upbound/up/internal/xpkg/dep/manager"
 	"github.com/upbound/up/internal/xpls"
 	"github.com/upbound/up/internal/xpls/handler"
 )
 
+// watchInterval is how often the dependency cache is checked for changes.
+const watchInterval = "100ms"
+
 // serveCmd starts the language server.
 type serveCmd struct {
 	// TODO(@tnthornton) cache dir doesn't seem to be the responsibility of the
@@ -27,12 +33,33 @@ type serveCmd struct {
 
 // Run runs the language server.
 func (c *serveCmd) Run(ctx context.Context) error {
-	// cache directory resolution should occur at this level.
-
 	// TODO(hasheddan): move to AfterApply.
 	zl := zap.New(zap.UseDevMode(c.Verbose))
+	log := logging.NewLogrLogger(zl.WithName("xpls"))
+
+	interval, err := time.ParseDuration(watchInterval)
+	if err != nil {
+		return err
+	}
+	cch, err := cache.NewLocal(c.Cache,
+		cache.WithLogger(log),
+		cache.WithWatchInterval(&interval),
+	)
+	if err != nil {
+		return err
+	}
+	m, err := manager.New(
+		manager.WithCache(cch),
+		manager.WithLogger(log),
+		manager.WithWatchInterval(&interval),
+	)
+	if err != nil {
+		return err
+	}
+
 	h, err := handler.New(
-		handler.WithLogger(logging.NewLogrLogger(zl.WithName("xpls"))),
+		handler.WithLogger(log),
+		handler.WithDepManager(m),
 	)
 	if err != nil {
 		return err
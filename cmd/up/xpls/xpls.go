@@ -5,5 +5,6 @@ package xpls
 
 // Cmd --.
 type Cmd struct {
-	Serve serveCmd `cmd:"" help:"run a server for Crossplane definitions using the Language Server Protocol."`
+	Serve       serveCmd       `cmd:"" help:"run a server for Crossplane definitions using the Language Server Protocol."`
+	Diagnostics diagnosticsCmd `cmd:"" help:"Print diagnostics for files without starting a server."`
 }
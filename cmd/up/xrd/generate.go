@@ -7,7 +7,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/alecthomas/kong"
@@ -17,6 +20,7 @@ import (
 	"github.com/spf13/afero"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -31,6 +35,7 @@ import (
 	"github.com/upbound/up/internal/schemas/manager"
 	"github.com/upbound/up/internal/schemas/runner"
 	"github.com/upbound/up/internal/upterm"
+	ixrd "github.com/upbound/up/internal/xrd"
 	"github.com/upbound/up/internal/yaml"
 
 	_ "embed"
@@ -73,11 +78,13 @@ type parsedXRD struct {
 }
 
 type generateCmd struct {
-	File     string `arg:""                                                                                      help:"Path to the file containing the Composite Resource (XR) or Composite Resource Claim (XRC)."`
-	CacheDir string `default:"~/.up/cache/"                                                                      env:"CACHE_DIR"                                                                                   help:"Directory used for caching dependency images."                                                                                    type:"path"`
-	Path     string `help:"Path to the output file where the Composite Resource Definition (XRD) will be saved." optional:""`
-	Plural   string `help:"Optional custom plural form for the Composite Resource Definition (XRD)."             optional:""`
-	Output   string `default:"file"                                                                              enum:"file,yaml,json"                                                                             help:"Output format for the results: 'file' to save to a file, 'yaml' to print XRD in YAML format, 'json' to print XRD in JSON format." short:"o"`
+	File     string `arg:""                                                                                                                                                                                           help:"Path to the file containing the Composite Resource (XR) or Composite Resource Claim (XRC)."`
+	CacheDir string `default:"~/.up/cache/"                                                                                                                                                                           env:"CACHE_DIR"                                                                                                               help:"Directory used for caching dependency images."                                                                                    type:"path"`
+	Path     string `help:"Path to the output file where the Composite Resource Definition (XRD) will be saved."                                                                                                      optional:""`
+	Plural   string `help:"Optional custom plural form for the Composite Resource Definition (XRD)."                                                                                                                  optional:""`
+	Output   string `default:"file"                                                                                                                                                                                   enum:"file,yaml,json"                                                                                                         help:"Output format for the results: 'file' to save to a file, 'yaml' to print XRD in YAML format, 'json' to print XRD in JSON format." short:"o"`
+	Validate bool   `default:"true"                                                                                                                                                                                   help:"Validate the generated XRD against Crossplane's XRD schema before saving or printing it. Use --validate=false to skip."`
+	Split    bool   `help:"Save the XRD to definition.yaml and each version's schema to schemas/<version>.yaml in the output directory, merging new versions into an existing XRD. --path sets the output directory."`
 
 	Input string `default:"xr" enum:"xr,rgd,ResourceGraphDefinition,SimpleSchema" help:"Input format: xr (default), rgd, ResourceGraphDefinition, or SimpleSchema."`
 
@@ -96,6 +103,10 @@ type generateCmd struct {
 func (c *generateCmd) AfterApply(kongCtx *kong.Context) error {
 	ctx := context.Background()
 
+	if c.Split && c.Output != outputFile {
+		return errors.New("--split can only be used with --output=file")
+	}
+
 	// Read the project file.
 	projFilePath, err := filepath.Abs(c.ProjectFile)
 	if err != nil {
@@ -152,6 +163,13 @@ func (c *generateCmd) Run(ctx context.Context, p upterm.Printer) error {
 		return err
 	}
 
+	// Split output is validated after merging into any existing XRD.
+	if c.Validate && !c.Split {
+		if err := validateXRD(ctx, xrd); err != nil {
+			return err
+		}
+	}
+
 	var pluralName string
 	switch x := xrd.(type) {
 	case *v1.CompositeResourceDefinition:
@@ -167,6 +185,10 @@ func (c *generateCmd) Run(ctx context.Context, p upterm.Printer) error {
 
 	switch c.Output {
 	case outputFile:
+		if c.Split {
+			return c.writeSplit(ctx, p, xrd, pluralName)
+		}
+
 		// Determine the file path
 		filePath := c.Path
 		if filePath == "" {
@@ -219,6 +241,111 @@ func (c *generateCmd) Run(ctx context.Context, p upterm.Printer) error {
 	return nil
 }
 
+// writeSplit saves the XRD split into a definition and per-version schema
+// files. If an XRD already exists in the output directory the generated
+// versions are merged into it.
+func (c *generateCmd) writeSplit(ctx context.Context, p upterm.Printer, xrd any, pluralName string) error {
+	dir := c.Path
+	if dir == "" {
+		dir = pluralName
+	}
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(xrd)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert XRD to unstructured")
+	}
+	merged := &unstructured.Unstructured{Object: obj}
+
+	defPath := path.Join(dir, splitDefinitionFile)
+	exists, err := afero.Exists(c.apisFS, defPath)
+	if err != nil {
+		return errors.Wrap(err, "failed to check if file exists")
+	}
+	if exists {
+		bs, err := afero.ReadFile(c.apisFS, defPath)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read existing XRD %s", filesystem.FullPath(c.apisFS, defPath))
+		}
+		js, err := yaml.YAMLToJSON(bs)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse existing XRD %s", filesystem.FullPath(c.apisFS, defPath))
+		}
+		existing := &unstructured.Unstructured{}
+		if err := existing.UnmarshalJSON(js); err != nil {
+			return errors.Wrapf(err, "failed to parse existing XRD %s", filesystem.FullPath(c.apisFS, defPath))
+		}
+		added, updated, err := mergeXRDVersions(existing, merged)
+		if err != nil {
+			return errors.Wrapf(err, "cannot merge into existing XRD %s", filesystem.FullPath(c.apisFS, defPath))
+		}
+		for _, v := range added {
+			p.Printfln("Adding version %s to existing CompositeResourceDefinition (XRD)", v)
+		}
+		for _, v := range updated {
+			p.Printfln("Updating schema of version %s in existing CompositeResourceDefinition (XRD)", v)
+		}
+		merged = existing
+	}
+
+	if c.Validate {
+		typed, err := typedXRD(merged)
+		if err != nil {
+			return err
+		}
+		if err := validateXRD(ctx, typed); err != nil {
+			return err
+		}
+	}
+
+	files, err := splitXRDFiles(dir, merged)
+	if err != nil {
+		return err
+	}
+	for _, fp := range slices.Sorted(maps.Keys(files)) {
+		if err := c.apisFS.MkdirAll(path.Dir(fp), 0o755); err != nil {
+			return errors.Wrap(err, "failed to create directories for the specified output path")
+		}
+		if err := afero.WriteFile(c.apisFS, fp, files[fp], 0o644); err != nil {
+			return errors.Wrapf(err, "failed to write %s", filesystem.FullPath(c.apisFS, fp))
+		}
+	}
+
+	if err := c.sm.Add(ctx, manager.NewFSSource(c.apisFS)); err != nil {
+		return errors.Wrap(err, "failed to generate language schemas")
+	}
+
+	p.Printfln("Successfully created CompositeResourceDefinition (XRD) and saved to %s", filesystem.FullPath(c.apisFS, dir))
+	return nil
+}
+
+// validateXRD validates the XRD the way Crossplane does when it creates the
+// XRD's CRDs, returning an error that lists every invalid field.
+func validateXRD(ctx context.Context, xrd any) error {
+	var x *v1.CompositeResourceDefinition
+	switch t := xrd.(type) {
+	case *v1.CompositeResourceDefinition:
+		x = t
+	case *v2.CompositeResourceDefinition:
+		x = ixrd.ConvertV2ToV1(t)
+	default:
+		return errors.Errorf("cannot validate XRD of type %T", xrd)
+	}
+
+	errs, err := crd.ValidateXRD(ctx, x)
+	if err != nil {
+		return errors.Wrap(err, "failed to validate CompositeResourceDefinition (XRD)")
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+
+	msgs := make([]string, 0, len(errs))
+	for _, e := range errs {
+		msgs = append(msgs, "  "+e.Error())
+	}
+	return errors.Errorf("invalid CompositeResourceDefinition (XRD), use --validate=false to skip validation:\n%s", strings.Join(msgs, "\n"))
+}
+
 func (c *generateCmd) newXRD(yamlData []byte) (any, error) {
 	var xrd any
 	var err error
@@ -1642,3 +1642,57 @@ func jsonSchemaPropsToRawExtension(schema *extv1.JSONSchemaProps) runtime.RawExt
 	}
 	return runtime.RawExtension{Raw: schemaBytes}
 }
+
+// TestValidateXRD tests the validateXRD function.
+func TestValidateXRD(t *testing.T) {
+	inputYAML := `
+apiVersion: aws.u5d.io/v1
+kind: XEKS
+metadata:
+  name: test
+spec:
+  parameters:
+    id: test
+`
+
+	cases := map[string]struct {
+		mutate  func(xrd *v2.CompositeResourceDefinition)
+		wantErr string
+	}{
+		"Valid": {},
+		"NameMismatch": {
+			mutate: func(xrd *v2.CompositeResourceDefinition) {
+				xrd.Name = "wrong.aws.u5d.io"
+			},
+			wantErr: "metadata.name",
+		},
+		"NoReferenceableVersion": {
+			mutate: func(xrd *v2.CompositeResourceDefinition) {
+				xrd.Spec.Versions[0].Referenceable = false
+			},
+			wantErr: "spec.versions",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			xrd, err := newXRDv2([]byte(inputYAML), "")
+			if err != nil {
+				t.Fatalf("newXRDv2() error = %v", err)
+			}
+			if tc.mutate != nil {
+				tc.mutate(xrd)
+			}
+
+			err = validateXRD(t.Context(), xrd)
+			switch {
+			case tc.wantErr == "" && err != nil:
+				t.Errorf("validateXRD() unexpected error: %v", err)
+			case tc.wantErr != "" && err == nil:
+				t.Errorf("validateXRD() expected an error mentioning %q", tc.wantErr)
+			case tc.wantErr != "" && !strings.Contains(err.Error(), tc.wantErr):
+				t.Errorf("validateXRD() error %q does not mention %q", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package xrd
+
+import (
+	"fmt"
+	"path"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	v1 "github.com/crossplane/crossplane/v2/apis/apiextensions/v1"
+	v2 "github.com/crossplane/crossplane/v2/apis/apiextensions/v2"
+
+	"github.com/upbound/up/internal/yaml"
+)
+
+const (
+	// splitDefinitionFile is the name of the file holding the XRD when the
+	// output is split.
+	splitDefinitionFile = "definition.yaml"
+	// splitSchemasDir is the directory holding the per-version schema files
+	// when the output is split.
+	splitSchemasDir = "schemas"
+)
+
+// mergeXRDVersions merges the versions of the generated XRD into an existing
+// XRD. Versions that already exist have their schema replaced and keep their
+// other settings. New versions are appended and served, but aren't
+// referenceable if the existing XRD already has a referenceable version, so
+// that adding a version doesn't change which version Crossplane composes. It
+// returns the names of the added and updated versions.
+func mergeXRDVersions(existing, generated *unstructured.Unstructured) (added, updated []string, err error) {
+	for _, f := range [][]string{{"spec", "group"}, {"spec", "names", "kind"}} {
+		e, _, _ := unstructured.NestedString(existing.Object, f...)
+		g, _, _ := unstructured.NestedString(generated.Object, f...)
+		if e != g {
+			return nil, nil, errors.Errorf("existing XRD has %s %q, but the generated XRD has %q", f[len(f)-1], e, g)
+		}
+	}
+
+	existingVersions, _, err := unstructured.NestedSlice(existing.Object, "spec", "versions")
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to read versions of existing XRD")
+	}
+	generatedVersions, _, err := unstructured.NestedSlice(generated.Object, "spec", "versions")
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to read versions of generated XRD")
+	}
+
+	hasReferenceable := false
+	for _, v := range existingVersions {
+		if vm, ok := v.(map[string]any); ok && vm["referenceable"] == true {
+			hasReferenceable = true
+		}
+	}
+
+	for _, gv := range generatedVersions {
+		gvm, ok := gv.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := gvm["name"].(string)
+
+		found := false
+		for _, ev := range existingVersions {
+			evm, ok := ev.(map[string]any)
+			if !ok || evm["name"] != name {
+				continue
+			}
+			evm["schema"] = gvm["schema"]
+			found = true
+			break
+		}
+		if found {
+			updated = append(updated, name)
+			continue
+		}
+
+		if hasReferenceable {
+			gvm["referenceable"] = false
+		}
+		existingVersions = append(existingVersions, gvm)
+		added = append(added, name)
+	}
+
+	if err := unstructured.SetNestedSlice(existing.Object, existingVersions, "spec", "versions"); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to set versions of merged XRD")
+	}
+	return added, updated, nil
+}
+
+// typedXRD converts an unstructured XRD to its typed API version.
+func typedXRD(u *unstructured.Unstructured) (any, error) {
+	var xrd any
+	switch u.GetAPIVersion() {
+	case v1.CompositeResourceDefinitionGroupVersionKind.GroupVersion().String():
+		xrd = &v1.CompositeResourceDefinition{}
+	case v2.CompositeResourceDefinitionGroupVersionKind.GroupVersion().String():
+		xrd = &v2.CompositeResourceDefinition{}
+	default:
+		return nil, errors.Errorf("unsupported XRD apiVersion %q", u.GetAPIVersion())
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, xrd); err != nil {
+		return nil, errors.Wrap(err, "failed to convert XRD from unstructured")
+	}
+	return xrd, nil
+}
+
+// splitXRDFiles returns the files of a split XRD, keyed by their path within
+// dir. The XRD itself is written to definition.yaml and remains the source of
+// truth. The OpenAPI schema of each of its versions is also written to
+// schemas/<version>.yaml, so large schemas can be reviewed and diffed per
+// version.
+func splitXRDFiles(dir string, xrd *unstructured.Unstructured) (map[string][]byte, error) {
+	def, err := yaml.Marshal(xrd, yaml.RemoveField("status"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal XRD to YAML")
+	}
+	files := map[string][]byte{
+		path.Join(dir, splitDefinitionFile): def,
+	}
+
+	versions, _, err := unstructured.NestedSlice(xrd.Object, "spec", "versions")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read versions of XRD")
+	}
+	for _, v := range versions {
+		vm, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := vm["name"].(string)
+		s, found, err := unstructured.NestedMap(vm, "schema", "openAPIV3Schema")
+		if err != nil || !found {
+			continue
+		}
+		bs, err := yaml.Marshal(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal schema of version %q to YAML", name)
+		}
+		files[path.Join(dir, splitSchemasDir, fmt.Sprintf("%s.yaml", name))] = bs
+	}
+
+	return files, nil
+}
@@ -0,0 +1,223 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package xrd
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+func testXRD(t *testing.T, y string) *unstructured.Unstructured {
+	t.Helper()
+	u := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal([]byte(y), &u.Object); err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
+
+// TestMergeXRDVersions tests the mergeXRDVersions function.
+func TestMergeXRDVersions(t *testing.T) {
+	existingYAML := `
+apiVersion: apiextensions.crossplane.io/v2
+kind: CompositeResourceDefinition
+metadata:
+  name: networks.example.org
+spec:
+  group: example.org
+  names:
+    kind: Network
+    plural: networks
+  versions:
+  - name: v1alpha1
+    referenceable: true
+    served: true
+    schema:
+      openAPIV3Schema:
+        description: old
+        type: object
+`
+
+	type want struct {
+		versions []any
+		added    []string
+		updated  []string
+		err      bool
+	}
+
+	cases := map[string]struct {
+		generatedYAML string
+		want          want
+	}{
+		"NewVersion": {
+			generatedYAML: `
+apiVersion: apiextensions.crossplane.io/v2
+kind: CompositeResourceDefinition
+metadata:
+  name: networks.example.org
+spec:
+  group: example.org
+  names:
+    kind: Network
+    plural: networks
+  versions:
+  - name: v1beta1
+    referenceable: true
+    served: true
+    schema:
+      openAPIV3Schema:
+        description: new
+        type: object
+`,
+			want: want{
+				versions: []any{
+					map[string]any{
+						"name":          "v1alpha1",
+						"referenceable": true,
+						"served":        true,
+						"schema":        map[string]any{"openAPIV3Schema": map[string]any{"description": "old", "type": "object"}},
+					},
+					map[string]any{
+						"name":          "v1beta1",
+						"referenceable": false,
+						"served":        true,
+						"schema":        map[string]any{"openAPIV3Schema": map[string]any{"description": "new", "type": "object"}},
+					},
+				},
+				added: []string{"v1beta1"},
+			},
+		},
+		"ExistingVersion": {
+			generatedYAML: `
+apiVersion: apiextensions.crossplane.io/v2
+kind: CompositeResourceDefinition
+metadata:
+  name: networks.example.org
+spec:
+  group: example.org
+  names:
+    kind: Network
+    plural: networks
+  versions:
+  - name: v1alpha1
+    referenceable: true
+    served: true
+    schema:
+      openAPIV3Schema:
+        description: new
+        type: object
+`,
+			want: want{
+				versions: []any{
+					map[string]any{
+						"name":          "v1alpha1",
+						"referenceable": true,
+						"served":        true,
+						"schema":        map[string]any{"openAPIV3Schema": map[string]any{"description": "new", "type": "object"}},
+					},
+				},
+				updated: []string{"v1alpha1"},
+			},
+		},
+		"DifferentKind": {
+			generatedYAML: `
+apiVersion: apiextensions.crossplane.io/v2
+kind: CompositeResourceDefinition
+metadata:
+  name: subnets.example.org
+spec:
+  group: example.org
+  names:
+    kind: Subnet
+    plural: subnets
+  versions:
+  - name: v1alpha1
+    referenceable: true
+    served: true
+`,
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			existing := testXRD(t, existingYAML)
+			added, updated, err := mergeXRDVersions(existing, testXRD(t, tc.generatedYAML))
+
+			if (err != nil) != tc.want.err {
+				t.Fatalf("mergeXRDVersions() error = %v, want error: %t", err, tc.want.err)
+			}
+			if tc.want.err {
+				return
+			}
+			if diff := cmp.Diff(tc.want.added, added); diff != "" {
+				t.Errorf("mergeXRDVersions() added -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("mergeXRDVersions() updated -want, +got:\n%s", diff)
+			}
+			versions, _, _ := unstructured.NestedSlice(existing.Object, "spec", "versions")
+			if diff := cmp.Diff(tc.want.versions, versions); diff != "" {
+				t.Errorf("mergeXRDVersions() versions -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestSplitXRDFiles tests the splitXRDFiles function.
+func TestSplitXRDFiles(t *testing.T) {
+	xrd := testXRD(t, `
+apiVersion: apiextensions.crossplane.io/v2
+kind: CompositeResourceDefinition
+metadata:
+  name: networks.example.org
+spec:
+  group: example.org
+  names:
+    kind: Network
+    plural: networks
+  versions:
+  - name: v1alpha1
+    referenceable: false
+    served: true
+    schema:
+      openAPIV3Schema:
+        type: object
+  - name: v1beta1
+    referenceable: true
+    served: true
+    schema:
+      openAPIV3Schema:
+        type: string
+status:
+  conditions: []
+`)
+
+	files, err := splitXRDFiles("networks", xrd)
+	if err != nil {
+		t.Fatalf("splitXRDFiles() error = %v", err)
+	}
+
+	paths := slices.Sorted(maps.Keys(files))
+	want := []string{"networks/definition.yaml", "networks/schemas/v1alpha1.yaml", "networks/schemas/v1beta1.yaml"}
+	if diff := cmp.Diff(want, paths); diff != "" {
+		t.Errorf("splitXRDFiles() paths -want, +got:\n%s", diff)
+	}
+
+	if got := string(files["networks/schemas/v1beta1.yaml"]); got != "type: string\n" {
+		t.Errorf("splitXRDFiles() schema of v1beta1 = %q, want %q", got, "type: string\n")
+	}
+
+	def := testXRD(t, string(files["networks/definition.yaml"]))
+	if _, found := def.Object["status"]; found {
+		t.Errorf("splitXRDFiles() definition should not contain status")
+	}
+}
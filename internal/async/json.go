@@ -0,0 +1,112 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package async
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// JSONEventKind identifies what a JSONEvent describes.
+type JSONEventKind string
+
+const (
+	// JSONEventKindStage is an event for a sub-operation reported via an
+	// EventChannel, such as a build step.
+	JSONEventKindStage JSONEventKind = "stage"
+	// JSONEventKindTest is an event for the lifecycle of a single test.
+	JSONEventKindTest JSONEventKind = "test"
+)
+
+// JSONEvent is a single event written by a JSONSink.
+type JSONEvent struct {
+	// Time is when the event was written.
+	Time time.Time `json:"time"`
+	// Kind identifies what the event describes.
+	Kind JSONEventKind `json:"kind"`
+	// Text is a description of the event, or the name of the test for test
+	// events.
+	Text string `json:"text"`
+	// Status is the updated status of the operation.
+	Status EventStatus `json:"status"`
+	// Error is the error message for failure events.
+	Error string `json:"error,omitempty"`
+}
+
+// JSONSink writes events to a writer as newline-delimited JSON. It is safe for
+// concurrent use. All methods are no-ops on a nil sink, so callers can emit
+// events unconditionally.
+type JSONSink struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+	now func() time.Time
+}
+
+// NewJSONSink returns a sink that writes events to w.
+func NewJSONSink(w io.Writer) *JSONSink {
+	return &JSONSink{
+		enc: json.NewEncoder(w),
+		now: time.Now,
+	}
+}
+
+// Write writes an event to the sink, setting its time.
+func (s *JSONSink) Write(e JSONEvent) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e.Time = s.now()
+	// There's nothing useful to do if we can't write an event; the events are
+	// informational and shouldn't fail the operation producing them.
+	_ = s.enc.Encode(e)
+}
+
+// WriteTest writes a lifecycle event for the named test. The error, if any, is
+// included in the event.
+func (s *JSONSink) WriteTest(name string, status EventStatus, err error) {
+	e := JSONEvent{
+		Kind:   JSONEventKindTest,
+		Text:   name,
+		Status: status,
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	s.Write(e)
+}
+
+// Wrap wraps an asynchronous function so that every event it sends is written
+// to the sink as a stage event before being forwarded to the caller's channel.
+func (s *JSONSink) Wrap(fn func(ch EventChannel) error) func(ch EventChannel) error {
+	if s == nil {
+		return fn
+	}
+	return func(ch EventChannel) error {
+		tee := make(EventChannel, 10)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for e := range tee {
+				s.Write(JSONEvent{
+					Kind:   JSONEventKindStage,
+					Text:   e.Text,
+					Status: e.Status,
+				})
+				ch.SendEvent(e.Text, e.Status)
+			}
+		}()
+
+		err := fn(tee)
+		close(tee)
+		// Make sure all events have been forwarded before returning, since the
+		// caller may close ch once we return.
+		<-done
+		return err
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package async
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestJSONSinkWrap(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	sink := NewJSONSink(&buf)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	sink.now = func() time.Time { return now }
+
+	// Collect events forwarded to the caller's channel, as a spinner printer
+	// would.
+	ch := make(EventChannel, 10)
+	err := sink.Wrap(func(ch EventChannel) error {
+		ch.SendEvent("Building functions", EventStatusStarted)
+		ch.SendEvent("Building functions", EventStatusSuccess)
+		return nil
+	})(ch)
+	close(ch)
+	assert.NilError(t, err)
+
+	var forwarded []Event
+	for e := range ch {
+		forwarded = append(forwarded, e)
+	}
+	assert.DeepEqual(t, forwarded, []Event{
+		{Text: "Building functions", Status: EventStatusStarted},
+		{Text: "Building functions", Status: EventStatusSuccess},
+	})
+
+	sink.WriteTest("my-test", EventStatusFailure, errors.New("boom"))
+
+	var got []JSONEvent
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var e JSONEvent
+		assert.NilError(t, dec.Decode(&e))
+		got = append(got, e)
+	}
+	assert.DeepEqual(t, got, []JSONEvent{
+		{Time: now, Kind: JSONEventKindStage, Text: "Building functions", Status: EventStatusStarted},
+		{Time: now, Kind: JSONEventKindStage, Text: "Building functions", Status: EventStatusSuccess},
+		{Time: now, Kind: JSONEventKindTest, Text: "my-test", Status: EventStatusFailure, Error: "boom"},
+	})
+}
+
+func TestJSONSinkNil(t *testing.T) {
+	t.Parallel()
+
+	var sink *JSONSink
+	sink.WriteTest("my-test", EventStatusStarted, nil)
+
+	called := false
+	err := sink.Wrap(func(_ EventChannel) error {
+		called = true
+		return nil
+	})(nil)
+	assert.NilError(t, err)
+	assert.Assert(t, called)
+}
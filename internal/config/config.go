@@ -129,6 +129,10 @@ const (
 
 // Config is format for the up configuration file.
 type Config struct {
+	// SchemaVersion is the version of the config file's schema. Older
+	// config files are migrated to the current version when they're loaded.
+	SchemaVersion int `json:"schemaVersion,omitempty"`
+
 	Upbound Upbound `json:"upbound"`
 }
 
@@ -339,11 +343,3 @@ func GetValidUserExposedConfigurationFlags() (map[string]ConfigurationFlag, erro
 func GetValidConfigurationFlags() []string {
 	return slices.Collect(maps.Keys(validConfigurationFlags))
 }
-
-func (c *Config) applyDefaults() {
-	for _, p := range c.Upbound.Profiles {
-		if p.Domain == "" {
-			p.Domain = DefaultDomain
-		}
-	}
-}
@@ -0,0 +1,60 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package config
+
+import (
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// CurrentSchemaVersion is the schema version of config files written by this
+// version of up.
+const CurrentSchemaVersion = 1
+
+// A migration upgrades a config from one schema version to the next.
+type migration func(c *Config) error
+
+// migrations upgrade configs to the current schema version. The migration at
+// index i upgrades a config from schema version i to i+1, so there must be
+// exactly CurrentSchemaVersion migrations. Migrations must not lose data.
+//
+//nolint:gochecknoglobals // This is effectively a constant.
+var migrations = []migration{
+	migrateV0ToV1,
+}
+
+// migrate upgrades the config to the current schema version, returning whether
+// it was changed. Configs written by a newer version of up are left as they
+// are.
+func (c *Config) migrate() (bool, error) {
+	if c.SchemaVersion >= CurrentSchemaVersion {
+		return false, nil
+	}
+	for v := c.SchemaVersion; v < CurrentSchemaVersion; v++ {
+		if err := migrations[v](c); err != nil {
+			return false, errors.Wrapf(err, "cannot migrate config from schema version %d to %d", v, v+1)
+		}
+		c.SchemaVersion = v + 1
+	}
+	return true, nil
+}
+
+// migrateV0ToV1 moves each profile's deprecated account to its organization,
+// and sets the default domain for profiles without one. An account that
+// differs from the profile's organization is kept.
+func migrateV0ToV1(c *Config) error {
+	for name, p := range c.Upbound.Profiles {
+		account := p.Account //nolint:staticcheck // Migrating from the deprecated field.
+		if p.Organization == "" {
+			p.Organization = account
+		}
+		if account == p.Organization {
+			p.Account = "" //nolint:staticcheck // Migrating from the deprecated field.
+		}
+		if p.Domain == "" {
+			p.Domain = DefaultDomain
+		}
+		c.Upbound.Profiles[name] = p
+	}
+	return nil
+}
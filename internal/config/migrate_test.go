@@ -0,0 +1,106 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package config
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/upbound/up/internal/profile"
+)
+
+func TestMigrate(t *testing.T) {
+	type want struct {
+		migrated bool
+		conf     *Config
+	}
+
+	cases := map[string]struct {
+		reason string
+		conf   *Config
+		want   want
+	}{
+		"Current": {
+			reason: "A config at the current schema version should not be changed.",
+			conf: &Config{
+				SchemaVersion: CurrentSchemaVersion,
+				Upbound: Upbound{
+					Profiles: map[string]profile.Profile{
+						"default": {Account: "my-org"},
+					},
+				},
+			},
+			want: want{
+				conf: &Config{
+					SchemaVersion: CurrentSchemaVersion,
+					Upbound: Upbound{
+						Profiles: map[string]profile.Profile{
+							"default": {Account: "my-org"},
+						},
+					},
+				},
+			},
+		},
+		"Newer": {
+			reason: "A config written by a newer version of up should not be changed.",
+			conf: &Config{
+				SchemaVersion: CurrentSchemaVersion + 1,
+			},
+			want: want{
+				conf: &Config{
+					SchemaVersion: CurrentSchemaVersion + 1,
+				},
+			},
+		},
+		"V0": {
+			reason: "A v0 config should move accounts to organizations and default domains, keeping accounts that differ from the organization.",
+			conf: &Config{
+				Upbound: Upbound{
+					Default: "moved",
+					Profiles: map[string]profile.Profile{
+						"moved":     {ID: "a", Account: "my-org"},
+						"same":      {ID: "b", Account: "my-org", Organization: "my-org", Domain: "https://example.com"},
+						"different": {ID: "c", Account: "old-org", Organization: "my-org"},
+					},
+				},
+			},
+			want: want{
+				migrated: true,
+				conf: &Config{
+					SchemaVersion: 1,
+					Upbound: Upbound{
+						Default: "moved",
+						Profiles: map[string]profile.Profile{
+							"moved":     {ID: "a", Organization: "my-org", Domain: DefaultDomain},
+							"same":      {ID: "b", Organization: "my-org", Domain: "https://example.com"},
+							"different": {ID: "c", Account: "old-org", Organization: "my-org", Domain: DefaultDomain},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			migrated, err := tc.conf.migrate()
+			if err != nil {
+				t.Fatalf("\n%s\nmigrate(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.migrated, migrated); diff != "" {
+				t.Errorf("\n%s\nmigrate(...): -want migrated, +got migrated:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.conf, tc.conf); diff != "" {
+				t.Errorf("\n%s\nmigrate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestMigrationsCoverSchemaVersions(t *testing.T) {
+	if len(migrations) != CurrentSchemaVersion {
+		t.Errorf("migrations: want %d migrations, one per schema version, got %d", CurrentSchemaVersion, len(migrations))
+	}
+}
@@ -4,15 +4,40 @@
 package config
 
 import (
+	"bytes"
 	"encoding/json"
 	"io"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/spf13/afero"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+)
+
+const (
+	// lockTimeout is how long to wait for another up command to release the
+	// config lock.
+	lockTimeout = 10 * time.Second
+	// lockRetryInterval is how often to try to take the config lock.
+	lockRetryInterval = 50 * time.Millisecond
+	// lockStaleAfter is the age after which a config lock is assumed to have
+	// been left behind by an up command that exited without releasing it.
+	lockStaleAfter = time.Minute
 )
 
-// Source is a source for interacting with a Config.
+// ErrConfigModified is returned when updating a config that was modified by
+// another up command after it was read. Nothing is written, so the command can
+// safely be re-run to apply its changes on top of the other command's.
+var ErrConfigModified = errors.New("config file was modified by another up command while this one was running; no changes were written, re-run this command to apply them")
+
+// Source is a source for interacting with a Config. Commands read the config,
+// modify it and update it without holding a lock in between, so UpdateConfig
+// may return ErrConfigModified if another command updated the config first.
+// Commands surface that error rather than retrying, since the changes they
+// made may depend on the config they read.
 type Source interface {
 	Initialize() error
 	GetConfig() (*Config, error)
@@ -29,7 +54,8 @@ type Source interface {
 // example).
 func NewFSSource(modifiers ...FSSourceModifier) *FSSource {
 	src := &FSSource{
-		fs: afero.NewOsFs(),
+		fs:  afero.NewOsFs(),
+		log: logging.NewNopLogger(),
 	}
 	for _, m := range modifiers {
 		m(src)
@@ -55,10 +81,22 @@ func WithFS(fs afero.Fs) FSSourceModifier {
 	}
 }
 
+// WithLogger sets the logger used by the filesystem source.
+func WithLogger(l logging.Logger) FSSourceModifier {
+	return func(f *FSSource) {
+		f.log = l
+	}
+}
+
 // FSSource provides a filesystem source for interacting with a Config.
 type FSSource struct {
 	fs   afero.Fs
 	path string
+	log  logging.Logger
+
+	// read is the content of the config file when it was last read or
+	// written by this source, or nil if it hasn't been.
+	read []byte
 }
 
 // Initialize creates a config in the filesystem if one does not exist. If path
@@ -98,38 +136,137 @@ func (src *FSSource) GetConfig() (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	src.read = b
 	conf := &Config{}
 	if len(b) == 0 {
+		conf.SchemaVersion = CurrentSchemaVersion
 		return conf, nil
 	}
 	if err := json.Unmarshal(b, conf); err != nil {
 		return nil, err
 	}
 
-	conf.applyDefaults()
+	// Rewrite the config once after migrating it, so later loads don't have
+	// to migrate it again. This is best-effort: the config may be read-only,
+	// or another up command may be updating it, and commands work with the
+	// migrated config either way.
+	migrated, err := conf.migrate()
+	if err != nil {
+		return nil, err
+	}
+	if migrated {
+		if err := src.UpdateConfig(conf); err != nil {
+			src.log.Debug("Cannot write migrated config", "path", src.path, "error", err)
+		}
+	}
 
 	return conf, nil
 }
 
-// UpdateConfig updates the Config in the filesystem.
+// UpdateConfig updates the Config in the filesystem. The config file is
+// locked while it's updated, and is replaced atomically so that it's never
+// left partially written. Both the lock and the replacement are files next to
+// the config file, so updating it requires write access to its directory, or
+// to the directory of the file it points to if it's a symlink. The
+// lock only covers the update, not the read that preceded it: if the config
+// file was modified by another up command since this source read it,
+// ErrConfigModified is returned rather than overwriting that command's
+// changes.
 func (src *FSSource) UpdateConfig(c *Config) error {
-	f, err := src.fs.OpenFile(src.path, os.O_RDWR|os.O_TRUNC, 0o600)
+	b, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	unlock, err := src.lock()
 	if err != nil {
 		return err
 	}
+	defer unlock()
+
+	if src.read != nil {
+		cur, err := afero.ReadFile(src.fs, src.path)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if !bytes.Equal(cur, src.read) {
+			return ErrConfigModified
+		}
+	}
+	if err := src.write(b); err != nil {
+		return err
+	}
+	src.read = b
+	return nil
+}
+
+// write atomically replaces the config file's content by writing it to a
+// temporary file in the same directory and renaming it over the config file.
+// If the config file is a symlink, the file it points to is replaced instead,
+// so that the symlink is kept.
+func (src *FSSource) write(b []byte) error {
+	path := src.target()
+	f, err := afero.TempFile(src.fs, filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return errors.Wrap(err, "cannot create temporary config file")
+	}
+	tmp := f.Name()
 	// NOTE(hasheddan): We both defer and explicitly call Close() to ensure that
 	// we close the file in the case that we encounter an error before write,
 	// and that we return an error in the case that we write and then fail to
 	// close the file (i.e. write buffer is not flushed). In the latter case the
 	// deferred Close() will error (see https://golang.org/pkg/os/#File.Close),
 	// but we do not check it.
-	defer f.Close() //nolint:errcheck // Can't do anything useful with this error.
-	b, err := json.Marshal(c)
-	if err != nil {
+	defer f.Close()          //nolint:errcheck // Can't do anything useful with this error.
+	defer src.fs.Remove(tmp) //nolint:errcheck // The file no longer exists once it's been renamed.
+	if _, err := f.Write(b); err != nil {
 		return err
 	}
-	if _, err := f.Write(b); err != nil {
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
 		return err
 	}
-	return f.Close()
+	return errors.Wrap(src.fs.Rename(tmp, path), "cannot replace config file")
+}
+
+// target returns the path of the file the config path points to, following
+// any symlinks. Only the OS filesystem supports symlinks, so the config path
+// is returned as is for any other filesystem, or if it can't be resolved.
+func (src *FSSource) target() string {
+	if _, ok := src.fs.(*afero.OsFs); !ok {
+		return src.path
+	}
+	p, err := filepath.EvalSymlinks(src.path)
+	if err != nil {
+		return src.path
+	}
+	return p
+}
+
+// lock takes an exclusive lock on the config file, returning a function that
+// releases it. The lock is a file next to the config file, so that it works
+// with any filesystem. A lock older than lockStaleAfter is broken, since the
+// up command that took it must have exited without releasing it.
+func (src *FSSource) lock() (func(), error) {
+	p := src.path + ".lock"
+	deadline := time.Now().Add(lockTimeout)
+	for {
+		f, err := src.fs.OpenFile(p, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err == nil {
+			_ = f.Close()
+			return func() { _ = src.fs.Remove(p) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, errors.Wrapf(err, "cannot lock config file; up needs write access to %s", filepath.Dir(src.path))
+		}
+		if fi, err := src.fs.Stat(p); err == nil && time.Since(fi.ModTime()) > lockStaleAfter {
+			_ = src.fs.Remove(p)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, errors.Errorf("timed out waiting for another up command to release the config lock; if none is running, remove %s", p)
+		}
+		time.Sleep(lockRetryInterval)
+	}
 }
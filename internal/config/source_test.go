@@ -5,13 +5,20 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/spf13/afero"
 
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/upbound/up/internal/profile"
 )
 
 var (
@@ -71,6 +78,7 @@ func TestInitialize(t *testing.T) {
 
 func TestGetConfig(t *testing.T) {
 	testConf := &Config{
+		SchemaVersion: CurrentSchemaVersion,
 		Upbound: Upbound{
 			Default: "test",
 		},
@@ -82,13 +90,13 @@ func TestGetConfig(t *testing.T) {
 		err       error
 	}{
 		"SuccessfulEmptyConfig": {
-			reason: "An empty file should return an empty config.",
+			reason: "An empty file should return an empty config at the current schema version.",
 			modifiers: []FSSourceModifier{
 				func(f *FSSource) {
 					f.fs = afero.NewMemMapFs()
 				},
 			},
-			want: &Config{},
+			want: &Config{SchemaVersion: CurrentSchemaVersion},
 		},
 		"Successful": {
 			reason: "If we are able to get config we should it.",
@@ -120,7 +128,94 @@ func TestGetConfig(t *testing.T) {
 	}
 }
 
+func TestGetConfigMigrates(t *testing.T) {
+	const path = "/.up/config.json"
+
+	// A config written before schema versions were introduced.
+	v0 := `{"upbound":{"default":"old","profiles":{` +
+		`"old":{"id":"someone","type":"user","session":"s3cr3t","account":"my-org","base":{"key":"value"}},` +
+		`"new":{"id":"robot","type":"robot","organization":"other-org","domain":"https://example.com"}},` +
+		`"configuration":{"telemetry.disabled":"true"}}}`
+
+	want := &Config{
+		SchemaVersion: CurrentSchemaVersion,
+		Upbound: Upbound{
+			Default: "old",
+			Profiles: map[string]profile.Profile{
+				"old": {
+					ID:           "someone",
+					Type:         profile.TypeCloud,
+					TokenType:    profile.TokenTypeUser,
+					Session:      "s3cr3t",
+					Organization: "my-org",
+					Domain:       DefaultDomain,
+					BaseConfig:   map[string]string{"key": "value"},
+				},
+				"new": {
+					ID:           "robot",
+					Type:         profile.TypeCloud,
+					TokenType:    profile.TokenTypeRobot,
+					Organization: "other-org",
+					Domain:       "https://example.com",
+				},
+			},
+			Configuration: map[string]string{"telemetry.disabled": "true"},
+		},
+	}
+
+	fs := afero.NewMemMapFs()
+	if err := afero.WriteFile(fs, path, []byte(v0), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	src := NewFSSource(WithFS(fs), WithPath(path))
+
+	got, err := src.GetConfig()
+	if err != nil {
+		t.Fatalf("GetConfig(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetConfig(...): -want, +got:\n%s", diff)
+	}
+
+	// The migrated config should have been written back to the file.
+	b, err := afero.ReadFile(fs, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	written := &Config{}
+	if err := json.Unmarshal(b, written); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, written); diff != "" {
+		t.Errorf("GetConfig(...): -want written, +got written:\n%s", diff)
+	}
+
+	// A config that can't be written should still be migrated, without
+	// failing the read.
+	ro := afero.NewMemMapFs()
+	if err := afero.WriteFile(ro, path, []byte(v0), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	src = NewFSSource(WithFS(afero.NewReadOnlyFs(ro)), WithPath(path))
+
+	got, err = src.GetConfig()
+	if err != nil {
+		t.Fatalf("GetConfig(...): unexpected error for read-only config: %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetConfig(...): read-only config: -want, +got:\n%s", diff)
+	}
+	b, err = afero.ReadFile(ro, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(v0, string(b)); diff != "" {
+		t.Errorf("GetConfig(...): read-only config: -want file, +got file:\n%s", diff)
+	}
+}
+
 func TestUpdateConfig(t *testing.T) {
+	const path = "/.up/config.json"
 	testConf := &Config{
 		Upbound: Upbound{
 			Default: "test",
@@ -130,24 +225,70 @@ func TestUpdateConfig(t *testing.T) {
 		reason    string
 		modifiers []FSSourceModifier
 		conf      *Config
+		want      string
 		err       error
 	}{
 		"EmptyConfig": {
 			reason: "Updating with empty config should not cause an error.",
 			modifiers: []FSSourceModifier{
 				func(f *FSSource) {
+					f.path = path
 					f.fs = afero.NewMemMapFs()
 				},
 			},
+			want: "null",
 		},
 		"PopulatedConfig": {
 			reason: "Updating with populated config should not cause an error.",
 			modifiers: []FSSourceModifier{
 				func(f *FSSource) {
+					f.path = path
+					f.fs = afero.NewMemMapFs()
+				},
+			},
+			conf: testConf,
+			want: `{"upbound":{"default":"test"}}`,
+		},
+		"UnmodifiedSinceRead": {
+			reason: "Updating a config that hasn't changed since it was read should replace it.",
+			modifiers: []FSSourceModifier{
+				func(f *FSSource) {
+					f.path = path
+					f.fs = afero.NewMemMapFs()
+					_ = afero.WriteFile(f.fs, path, []byte(`{}`), 0o600)
+					f.read = []byte(`{}`)
+				},
+			},
+			conf: testConf,
+			want: `{"upbound":{"default":"test"}}`,
+		},
+		"ModifiedSinceRead": {
+			reason: "Updating a config that was modified after it was read should return an error rather than overwrite the changes.",
+			modifiers: []FSSourceModifier{
+				func(f *FSSource) {
+					f.path = path
 					f.fs = afero.NewMemMapFs()
+					_ = afero.WriteFile(f.fs, path, []byte(`{"upbound":{"default":"other"}}`), 0o600)
+					f.read = []byte(`{}`)
+				},
+			},
+			conf: testConf,
+			want: `{"upbound":{"default":"other"}}`,
+			err:  ErrConfigModified,
+		},
+		"ReadOnlyDirectory": {
+			reason: "Updating a config in a directory that can't be written to should return an error explaining why.",
+			modifiers: []FSSourceModifier{
+				func(f *FSSource) {
+					f.path = path
+					base := afero.NewMemMapFs()
+					_ = afero.WriteFile(base, path, []byte(`{}`), 0o600)
+					f.fs = afero.NewReadOnlyFs(base)
 				},
 			},
 			conf: testConf,
+			want: `{}`,
+			err:  errors.Wrapf(syscall.EPERM, "cannot lock config file; up needs write access to %s", filepath.Dir(path)),
 		},
 	}
 	for name, tc := range cases {
@@ -157,6 +298,114 @@ func TestUpdateConfig(t *testing.T) {
 			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("\n%s\nUpdateConfig(...): -want error, +got error:\n%s", tc.reason, diff)
 			}
+			b, err := afero.ReadFile(src.fs, path)
+			if err != nil {
+				t.Fatalf("\n%s\nReadFile(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, string(b)); diff != "" {
+				t.Errorf("\n%s\nUpdateConfig(...): -want file, +got file:\n%s", tc.reason, diff)
+			}
+			if _, err := src.fs.Stat(path + ".lock"); !os.IsNotExist(err) {
+				t.Errorf("\n%s\nUpdateConfig(...): config lock was not released", tc.reason)
+			}
 		})
 	}
 }
+
+func TestUpdateConfigSymlink(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "dotfiles", "config.json")
+	path := filepath.Join(dir, "config.json")
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(target, []byte(`{}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(target, path); err != nil {
+		t.Fatal(err)
+	}
+
+	src := NewFSSource(WithPath(path))
+	if _, err := src.GetConfig(); err != nil {
+		t.Fatalf("GetConfig(...): %v", err)
+	}
+	if err := src.UpdateConfig(&Config{Upbound: Upbound{Default: "default"}}); err != nil {
+		t.Fatalf("UpdateConfig(...): %v", err)
+	}
+
+	fi, err := os.Lstat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode()&os.ModeSymlink == 0 {
+		t.Errorf("UpdateConfig(...): replaced the config symlink with a %s", fi.Mode().Type())
+	}
+	b, err := os.ReadFile(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(`{"upbound":{"default":"default"}}`, string(b)); diff != "" {
+		t.Errorf("UpdateConfig(...): -want symlink target, +got symlink target:\n%s", diff)
+	}
+}
+
+func TestUpdateConfigConcurrent(t *testing.T) {
+	const updaters = 10
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := NewFSSource(WithPath(path)).Initialize(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Each updater adds a profile using its own source, as separate up
+	// commands would, retrying if another updater modified the config first.
+	// Every updater reads the config before any of them updates it, so that
+	// their read-modify-write cycles overlap.
+	var wg, read sync.WaitGroup
+	wg.Add(updaters)
+	read.Add(updaters)
+	errs := make(chan error, updaters)
+	for i := range updaters {
+		go func() {
+			defer wg.Done()
+			for first := true; ; first = false {
+				src := NewFSSource(WithPath(path))
+				conf, err := src.GetConfig()
+				if first {
+					read.Done()
+					read.Wait()
+				}
+				if err != nil {
+					errs <- err
+					return
+				}
+				if err := conf.AddOrUpdateUpboundProfile(fmt.Sprintf("profile-%d", i), profile.Profile{Type: profile.TypeCloud, Organization: "my-org"}); err != nil {
+					errs <- err
+					return
+				}
+				err = src.UpdateConfig(conf)
+				if errors.Is(err, ErrConfigModified) {
+					continue
+				}
+				errs <- err
+				return
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("UpdateConfig(...): %v", err)
+		}
+	}
+
+	conf, err := NewFSSource(WithPath(path)).GetConfig()
+	if err != nil {
+		t.Fatalf("GetConfig(...): %v", err)
+	}
+	if diff := cmp.Diff(updaters, len(conf.Upbound.Profiles)); diff != "" {
+		t.Errorf("GetConfig(...): an update was lost: -want profiles, +got profiles:\n%s", diff)
+	}
+}
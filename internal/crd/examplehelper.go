@@ -5,6 +5,7 @@ package crd
 
 import (
 	"fmt"
+	"math"
 	"regexp"
 	"slices"
 	"sort"
@@ -21,9 +22,27 @@ const array = "array"
 
 var RootRequiredFields = []string{"apiVersion", "kind", "spec", "metadata"}
 
+// Placeholder marks values in generated examples that users need to replace.
+const Placeholder = "CHANGEME"
+
+// ExampleOption configures GenerateExample.
+type ExampleOption func(*parser)
+
+// WithPlaceholders fills string fields that have neither a default nor an enum
+// with Placeholder rather than a sample value. Other fields keep a sample value
+// of their type, so that the example remains valid against the schema.
+func WithPlaceholders() ExampleOption {
+	return func(p *parser) {
+		p.placeholders = true
+	}
+}
+
 // GenerateExample creates an example manifest for a given CRD, optionally minimizing fields and skipping random value generation.
-func GenerateExample(crd apiextensionsv1.CustomResourceDefinition, minimal, skipRandom bool) (map[string]interface{}, error) {
+func GenerateExample(crd apiextensionsv1.CustomResourceDefinition, minimal, skipRandom bool, opts ...ExampleOption) (map[string]interface{}, error) {
 	parser := newParser(crd.Spec.Group, crd.Spec.Names.Kind, minimal, skipRandom)
+	for _, o := range opts {
+		o(parser)
+	}
 
 	version, err := GetCRDVersion(crd)
 	if err != nil {
@@ -59,6 +78,7 @@ type parser struct {
 	kind         string
 	onlyRequired bool
 	skipRandom   bool
+	placeholders bool
 }
 
 // newParser creates a new parser.
@@ -108,7 +128,7 @@ func (p *parser) parseProperties(version string, properties map[string]apiextens
 				value = []interface{}{subProperties}
 			} else {
 				// generate sample value based on type
-				value = outputValueType(prop, p.skipRandom)
+				value = p.sampleValue(prop)
 			}
 			result[k] = value
 
@@ -134,6 +154,58 @@ func (p *parser) parseProperties(version string, properties map[string]apiextens
 	return result, nil
 }
 
+// sampleValue returns the value to use for a simple property.
+func (p *parser) sampleValue(v apiextensionsv1.JSONSchemaProps) interface{} {
+	if p.placeholders {
+		return placeholderValue(v)
+	}
+	return outputValueType(v, p.skipRandom)
+}
+
+// placeholderValue returns the default or first enum value of the given
+// property, Placeholder for strings, or a sample value of its type.
+func placeholderValue(v apiextensionsv1.JSONSchemaProps) interface{} {
+	if v.Default != nil {
+		var defaultValue interface{}
+		if err := yaml.Unmarshal(v.Default.Raw, &defaultValue); err == nil {
+			return defaultValue
+		}
+	}
+
+	if len(v.Enum) > 0 {
+		var enumValue interface{}
+		if err := yaml.Unmarshal(v.Enum[0].Raw, &enumValue); err == nil {
+			return enumValue
+		}
+	}
+
+	switch v.Type {
+	case "string":
+		return Placeholder
+	case "integer":
+		if v.Minimum != nil {
+			return int64(math.Ceil(*v.Minimum))
+		}
+		return int64(1)
+	case "number":
+		if v.Minimum != nil {
+			return *v.Minimum
+		}
+		return float64(1)
+	case "boolean":
+		return true
+	case "object":
+		return map[string]interface{}{}
+	case array:
+		if v.Items != nil && v.Items.Schema != nil {
+			return []interface{}{placeholderValue(*v.Items.Schema)}
+		}
+		return []interface{}{}
+	}
+
+	return nil
+}
+
 // outputValueType generates an output value based on the given type.
 func outputValueType(v apiextensionsv1.JSONSchemaProps, skipRandom bool) interface{} { //nolint:gocyclo
 	if v.Default != nil {
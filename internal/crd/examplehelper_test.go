@@ -9,6 +9,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
 
 	_ "embed"
@@ -265,3 +266,87 @@ func TestOutputValueType(t *testing.T) {
 		})
 	}
 }
+
+func TestPlaceholderValue(t *testing.T) {
+	cases := map[string]struct {
+		input apiextensionsv1.JSONSchemaProps
+		want  interface{}
+	}{
+		"DefaultValueProvided": {
+			input: apiextensionsv1.JSONSchemaProps{
+				Type:    "string",
+				Default: &apiextensionsv1.JSON{Raw: []byte(`"default_value"`)},
+			},
+			want: "default_value",
+		},
+		"ExampleIgnored": {
+			input: apiextensionsv1.JSONSchemaProps{
+				Type:    "string",
+				Example: &apiextensionsv1.JSON{Raw: []byte(`"example_value"`)},
+			},
+			want: "CHANGEME",
+		},
+		"EnumProvided": {
+			input: apiextensionsv1.JSONSchemaProps{
+				Type: "string",
+				Enum: []apiextensionsv1.JSON{
+					{Raw: []byte(`"enum_value"`)},
+				},
+			},
+			want: "enum_value",
+		},
+		"TypeStringWithPattern": {
+			input: apiextensionsv1.JSONSchemaProps{
+				Type:    "string",
+				Pattern: "^[a-z]+$",
+			},
+			want: "CHANGEME",
+		},
+		"TypeInteger": {
+			input: apiextensionsv1.JSONSchemaProps{
+				Type: "integer",
+			},
+			want: int64(1),
+		},
+		"TypeIntegerWithMinimum": {
+			input: apiextensionsv1.JSONSchemaProps{
+				Type:    "integer",
+				Minimum: ptr.To(2.5),
+			},
+			want: int64(3),
+		},
+		"TypeNumber": {
+			input: apiextensionsv1.JSONSchemaProps{
+				Type: "number",
+			},
+			want: float64(1),
+		},
+		"TypeObject": {
+			input: apiextensionsv1.JSONSchemaProps{
+				Type: "object",
+			},
+			want: map[string]interface{}{},
+		},
+		"TypeArray": {
+			input: apiextensionsv1.JSONSchemaProps{
+				Type: "array",
+				Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+					Schema: &apiextensionsv1.JSONSchemaProps{
+						Type: "boolean",
+					},
+				},
+			},
+			want: []interface{}{true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := placeholderValue(tc.input)
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("placeholderValue(): -got, +want:\n%s", diff)
+			}
+		})
+	}
+}
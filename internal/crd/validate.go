@@ -0,0 +1,67 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package crd
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/validation"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	xpv1 "github.com/crossplane/crossplane/v2/apis/apiextensions/v1"
+)
+
+// ValidateXRD validates the CRDs Crossplane derives from the XRD the way the
+// API server would validate them when Crossplane creates them. It returns the
+// field-level validation errors, whose paths match the XRD's spec.versions
+// layout. Errors shared by the composite and claim CRDs are only reported
+// once. An error is returned if the CRDs can't be derived at all, e.g.
+// because a version's schema isn't valid JSON.
+func ValidateXRD(ctx context.Context, xrd *xpv1.CompositeResourceDefinition) (field.ErrorList, error) {
+	xrd = xrd.DeepCopy()
+	// The owner references of the derived CRDs must have a UID.
+	if xrd.UID == "" {
+		xrd.UID = types.UID("validation")
+	}
+
+	claimCRD, xrCRD, err := createCRDFromXRD(*xrd)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs field.ErrorList
+	seen := map[string]bool{}
+	for _, crd := range []*apiextensionsv1.CustomResourceDefinition{xrCRD, claimCRD} {
+		if crd == nil {
+			continue
+		}
+		apiextensionsv1.SetObjectDefaults_CustomResourceDefinition(crd)
+
+		internal := &apiextensions.CustomResourceDefinition{}
+		if err := apiextensionsv1.Convert_v1_CustomResourceDefinition_To_apiextensions_CustomResourceDefinition(crd, internal, nil); err != nil {
+			return nil, errors.Wrapf(err, "cannot convert CRD %q for validation", crd.GetName())
+		}
+		// The claim CRD shares the XRD's schemas, so report their errors once.
+		for _, e := range validation.ValidateCustomResourceDefinition(ctx, internal) {
+			// The derived CRDs have no status, which isn't the XRD's fault.
+			if seen[e.Error()] || strings.HasPrefix(e.Field, "status.") {
+				continue
+			}
+			seen[e.Error()] = true
+			// Schemas shared by all versions are validated at
+			// spec.validation, which doesn't exist in an XRD.
+			if rest, ok := strings.CutPrefix(e.Field, "spec.validation"); ok {
+				e.Field = "spec.versions[*].schema" + rest
+			}
+			errs = append(errs, e)
+		}
+	}
+
+	return errs, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package crd
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"gotest.tools/v3/assert"
+
+	xpv1 "github.com/crossplane/crossplane/v2/apis/apiextensions/v1"
+
+	"github.com/upbound/up/internal/yaml"
+)
+
+func TestValidateXRD(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		xrdBytes []byte
+		mutate   func(xrd *xpv1.CompositeResourceDefinition)
+
+		expectedFields []string
+		expectedErr    bool
+	}{
+		"ValidClaimableXRD": {
+			xrdBytes: claimableXRDBytes,
+		},
+		"ValidUnclaimableXRD": {
+			xrdBytes: unclaimableXRDBytes,
+		},
+		"InvalidSchemaType": {
+			xrdBytes: unclaimableXRDBytes,
+			mutate: func(xrd *xpv1.CompositeResourceDefinition) {
+				xrd.Spec.Versions[0].Schema.OpenAPIV3Schema.Raw = []byte(`{"type":"object","properties":{"spec":{"type":"object","properties":{"size":{"type":"bogus"}}}}}`)
+			},
+			expectedFields: []string{"spec.versions[*].schema.openAPIV3Schema.properties[spec].properties[size].type"},
+		},
+		"NameMismatch": {
+			xrdBytes: unclaimableXRDBytes,
+			mutate: func(xrd *xpv1.CompositeResourceDefinition) {
+				xrd.Name = "wrong.example.org"
+			},
+			expectedFields: []string{"metadata.name"},
+		},
+		"InvalidSchemaJSON": {
+			xrdBytes: unclaimableXRDBytes,
+			mutate: func(xrd *xpv1.CompositeResourceDefinition) {
+				xrd.Spec.Versions[0].Schema.OpenAPIV3Schema.Raw = []byte(`{`)
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var xrd xpv1.CompositeResourceDefinition
+			assert.NilError(t, yaml.Unmarshal(tc.xrdBytes, &xrd))
+			if tc.mutate != nil {
+				tc.mutate(&xrd)
+			}
+
+			errs, err := ValidateXRD(t.Context(), &xrd)
+			if tc.expectedErr {
+				assert.Assert(t, err != nil)
+				return
+			}
+			assert.NilError(t, err)
+
+			fields := make([]string, 0, len(errs))
+			for _, e := range errs {
+				fields = append(fields, e.Field)
+			}
+			assert.DeepEqual(t, fields, tc.expectedFields, cmpopts.EquateEmpty())
+		})
+	}
+}
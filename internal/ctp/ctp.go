@@ -6,7 +6,9 @@ package ctp
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/fs"
@@ -28,6 +30,8 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/rest"
@@ -98,6 +102,8 @@ type spacesConfig struct {
 	annotations                 map[string]string
 	crossplane                  *spacesv1beta1.CrossplaneSpec
 	crossplaneVersionConstraint string
+	crossplaneChannel           spacesv1beta1.CrossplaneUpgradeChannel
+	readyTimeout                time.Duration
 }
 
 // localConfig holds local-specific configuration options for creating dev
@@ -110,13 +116,19 @@ type localConfig struct {
 	clusterAdmin      bool
 	skipPrometheus    bool
 	helmValues        map[string]any
+	kindImage         string
+	clusterName       string
 }
 
-// defaultCrossplaneSpec returns the default Crossplane configuration.
-func defaultCrossplaneSpec() *spacesv1beta1.CrossplaneSpec {
+// defaultCrossplaneSpec returns the default Crossplane configuration, which
+// auto-upgrades on the given channel. The channel defaults to Rapid.
+func defaultCrossplaneSpec(channel spacesv1beta1.CrossplaneUpgradeChannel) *spacesv1beta1.CrossplaneSpec {
+	if channel == "" {
+		channel = spacesv1beta1.CrossplaneUpgradeRapid
+	}
 	return &spacesv1beta1.CrossplaneSpec{
 		AutoUpgradeSpec: &spacesv1beta1.CrossplaneAutoUpgradeSpec{
-			Channel: ptr.To(spacesv1beta1.CrossplaneUpgradeRapid),
+			Channel: ptr.To(channel),
 		},
 	}
 }
@@ -161,6 +173,35 @@ func WithSpacesCrossplaneVersionConstraint(v string) EnsureDevControlPlaneOption
 	}
 }
 
+// WithSpacesCrossplaneChannel sets the auto-upgrade channel to use for a spaces
+// control plane. It can't be combined with a version constraint, since a
+// control plane with a pinned version doesn't auto-upgrade. The default is
+// Rapid.
+func WithSpacesCrossplaneChannel(ch spacesv1beta1.CrossplaneUpgradeChannel) EnsureDevControlPlaneOption {
+	return func(cfg *ensureDevControlPlaneConfig) {
+		cfg.spacesConfig.crossplaneChannel = ch
+	}
+}
+
+// WithSpacesClass sets the class of the Spaces dev control plane, which
+// determines its size. An empty class keeps the default.
+func WithSpacesClass(class string) EnsureDevControlPlaneOption {
+	return func(cfg *ensureDevControlPlaneConfig) {
+		if class != "" {
+			cfg.spacesConfig.class = class
+		}
+	}
+}
+
+// WithSpacesReadyTimeout sets how long to wait for a newly created Spaces
+// control plane to become ready. A timeout of zero waits until the context is
+// done.
+func WithSpacesReadyTimeout(d time.Duration) EnsureDevControlPlaneOption {
+	return func(cfg *ensureDevControlPlaneConfig) {
+		cfg.spacesConfig.readyTimeout = d
+	}
+}
+
 // WithSpacesGroup sets the name of the spaces group in which to create the
 // control plane.
 func WithSpacesGroup(g string) EnsureDevControlPlaneOption {
@@ -225,6 +266,55 @@ func WithLocalHelmValues(vals map[string]any) EnsureDevControlPlaneOption {
 	}
 }
 
+// WithLocalKindImage sets the kind node image to use when creating a local dev
+// control plane, which determines the control plane's Kubernetes version. When
+// unset, kind's default node image is used. The image has no effect if the
+// kind cluster already exists.
+func WithLocalKindImage(image string) EnsureDevControlPlaneOption {
+	return func(cfg *ensureDevControlPlaneConfig) {
+		cfg.localConfig.kindImage = image
+	}
+}
+
+// WithLocalClusterName sets the name of the kind cluster to use for a local dev
+// control plane, instead of deriving it from the control plane name. If a
+// cluster with the name exists it is reused, so repeated runs can skip cluster
+// creation and, if Crossplane is already at the desired version, its
+// installation.
+func WithLocalClusterName(name string) EnsureDevControlPlaneOption {
+	return func(cfg *ensureDevControlPlaneConfig) {
+		cfg.localConfig.clusterName = name
+	}
+}
+
+// ValidateKindImage returns an error if image is not a valid kind node image
+// reference. An empty image is valid, and means kind's default node image.
+func ValidateKindImage(image string) error {
+	if image == "" {
+		return nil
+	}
+	if _, err := name.ParseReference(image); err != nil {
+		return errors.Wrapf(err, "invalid kind node image %q", image)
+	}
+	return nil
+}
+
+// ValidateCrossplaneChannel returns an error if ch is not a Crossplane
+// auto-upgrade channel supported by Spaces. An empty channel is valid, and
+// means the default channel.
+func ValidateCrossplaneChannel(ch string) error {
+	switch spacesv1beta1.CrossplaneUpgradeChannel(ch) {
+	case "",
+		spacesv1beta1.CrossplaneUpgradeNone,
+		spacesv1beta1.CrossplaneUpgradePatch,
+		spacesv1beta1.CrossplaneUpgradeStable,
+		spacesv1beta1.CrossplaneUpgradeRapid:
+		return nil
+	default:
+		return errors.Errorf("invalid crossplane channel %q: must be one of None, Patch, Stable or Rapid", ch)
+	}
+}
+
 // DevControlPlane is a control plane used for local development. It may run in
 // a variety of ways.
 //
@@ -294,11 +384,16 @@ func (s *spacesDevControlPlane) Kubeconfig() clientcmd.ClientConfig {
 
 // Teardown tears down the control plane, deleting any resources it may use.
 func (s *spacesDevControlPlane) Teardown(ctx context.Context, force bool) error {
-	nn := types.NamespacedName{Name: s.name, Namespace: s.group}
+	return DeleteControlPlane(ctx, s.spaceClient, types.NamespacedName{Name: s.name, Namespace: s.group}, force)
+}
+
+// DeleteControlPlane deletes a control plane in a space. It refuses to delete
+// a control plane that is not a development control plane unless force is set.
+func DeleteControlPlane(ctx context.Context, cl client.Client, nn types.NamespacedName, force bool) error {
 	var ctp spacesv1beta1.ControlPlane
 
 	// Fetch the control plane to delete
-	err := s.spaceClient.Get(ctx, nn, &ctp)
+	err := cl.Get(ctx, nn, &ctp)
 	if err != nil {
 		if kerrors.IsNotFound(err) {
 			return errors.New("control plane does not exist, nothing to delete")
@@ -307,12 +402,12 @@ func (s *spacesDevControlPlane) Teardown(ctx context.Context, force bool) error
 	}
 
 	// Never delete a production control plane unless force is set
-	if !force && !isDevControlPlane(&ctp) {
-		return errors.New("control plane exists but is not a development control plane")
+	if !force && !IsDevControlPlane(&ctp) {
+		return errNotDevControlPlane
 	}
 
 	// Delete the control plane
-	if err := s.spaceClient.Delete(ctx, &ctp); err != nil {
+	if err := cl.Delete(ctx, &ctp); err != nil {
 		return errors.Wrap(err, "failed to delete control plane")
 	}
 
@@ -543,6 +638,11 @@ func ensureLocalDevControlPlane(ctx context.Context, upCtx *upbound.Context, cfg
 	evText := "Creating local development control plane"
 	cfg.eventChan.SendEvent(evText, async.EventStatusStarted)
 
+	if err := ValidateKindImage(cfg.localConfig.kindImage); err != nil {
+		cfg.eventChan.SendEvent(evText, async.EventStatusFailure)
+		return nil, err
+	}
+
 	// Check that we have a working Docker-compatible runtime, since everything
 	// else will fail if we don't.
 	if err := docker.Check(ctx); err != nil {
@@ -562,14 +662,17 @@ func ensureLocalDevControlPlane(ctx context.Context, upCtx *upbound.Context, cfg
 		}
 	}
 
-	// kind creates a docker container named <name>-control-plane, and uses the
-	// name as the container's hostname. Hostnames can be at most 63
-	// characters. Truncate the name if needed.
-	nameLen := len(cfg.name)
-	nameLen = min(nameLen, 63-len("-control-plane"))
-	cfg.name = cfg.name[:nameLen]
+	if n := cfg.localConfig.clusterName; n != "" {
+		if len(n) > maxKindClusterNameLen {
+			cfg.eventChan.SendEvent(evText, async.EventStatusFailure)
+			return nil, errors.Errorf("kind cluster name %q is too long; it must be at most %d characters", n, maxKindClusterNameLen)
+		}
+		cfg.name = n
+	} else {
+		cfg.name = kindClusterName(cfg.name)
+	}
 
-	kubeconfig, actualPortMapping, err := ensureKindCluster(ctx, cfg.name, cfg.localConfig.portMapping, cfg.localConfig.ingress)
+	kubeconfig, actualPortMapping, err := ensureKindCluster(ctx, cfg.name, cfg.localConfig.kindImage, cfg.localConfig.portMapping, cfg.localConfig.ingress)
 	if err != nil {
 		cfg.eventChan.SendEvent(evText, async.EventStatusFailure)
 		return nil, err
@@ -674,6 +777,25 @@ func ensureLocalDevControlPlane(ctx context.Context, upCtx *upbound.Context, cfg
 	}, nil
 }
 
+// maxKindClusterNameLen is the longest kind cluster name we can use. kind
+// creates a docker container named <name>-control-plane, and uses the name as
+// the container's hostname. Hostnames can be at most 63 characters.
+const maxKindClusterNameLen = 63 - len("-control-plane")
+
+// kindClusterName returns the kind cluster name to use for a control plane
+// name. Names that are too long for kind are truncated, and suffixed with a
+// hash of the full name so that different names sharing a long prefix don't
+// map to the same cluster. The result is the same every time for a given name,
+// so the cluster is reused across runs.
+func kindClusterName(name string) string {
+	if len(name) <= maxKindClusterNameLen {
+		return name
+	}
+	sum := sha256.Sum256([]byte(name))
+	suffix := "-" + hex.EncodeToString(sum[:])[:8]
+	return name[:maxKindClusterNameLen-len(suffix)] + suffix
+}
+
 // getExistingClusterPortMapping retrieves the actual port mapping from an existing cluster.
 func getExistingClusterPortMapping(ctx context.Context, name string, defaultMapping string) string {
 	containerName := name + "-control-plane"
@@ -750,7 +872,7 @@ nodeRegistration:
 	}
 }
 
-func ensureKindCluster(ctx context.Context, name string, portMapping string, ingressEnabled bool) (clientcmd.ClientConfig, string, error) {
+func ensureKindCluster(ctx context.Context, name, image, portMapping string, ingressEnabled bool) (clientcmd.ClientConfig, string, error) {
 	provider := kind.NewProvider()
 	var actualPortMapping string
 
@@ -777,7 +899,7 @@ func ensureKindCluster(ctx context.Context, name string, portMapping string, ing
 		}
 	} else {
 		// Create new cluster
-		actualPortMapping, err = createNewKindCluster(ctx, provider, name, portMapping, ingressEnabled, kubeconfigFile.Name())
+		actualPortMapping, err = createNewKindCluster(ctx, provider, name, image, portMapping, ingressEnabled, kubeconfigFile.Name())
 		if err != nil {
 			return nil, "", err
 		}
@@ -797,7 +919,11 @@ func ensureKindCluster(ctx context.Context, name string, portMapping string, ing
 }
 
 // createNewKindCluster creates a new kind cluster with the specified configuration.
-func createNewKindCluster(ctx context.Context, provider *kind.Provider, name string, portMapping string, ingressEnabled bool, kubeconfigPath string) (string, error) {
+func createNewKindCluster(ctx context.Context, provider *kind.Provider, name, image, portMapping string, ingressEnabled bool, kubeconfigPath string) (string, error) {
+	if image == "" {
+		image = defaults.Image
+	}
+
 	extraPortMappings, err := createPortMappings(portMapping, ingressEnabled)
 	if err != nil {
 		return "", err
@@ -813,9 +939,7 @@ func createNewKindCluster(ctx context.Context, provider *kind.Provider, name str
 	if err := provider.Create(
 		name,
 		kind.CreateWithRawConfig(cfgBytes),
-		// TODO(adamwg): Do we want to customize our base image? Or set a
-		// specific version depending on the Crossplane version?
-		kind.CreateWithNodeImage(defaults.Image),
+		kind.CreateWithNodeImage(image),
 		// Removes kind cluster information output.
 		kind.CreateWithDisplayUsage(false),
 		// Removes 'Thanks for using kind! 😊'
@@ -824,7 +948,7 @@ func createNewKindCluster(ctx context.Context, provider *kind.Provider, name str
 		// user's normal kubeconfig.
 		kind.CreateWithKubeconfigPath(kubeconfigPath),
 	); err != nil {
-		return "", errors.Wrap(err, "failed to create kind cluster")
+		return "", errors.Wrapf(err, "failed to create kind cluster %q with node image %q", name, image)
 	}
 
 	// For new clusters with ingress, retrieve the actual port that was assigned
@@ -1152,6 +1276,10 @@ func ensureSpacesDevControlPlane(ctx context.Context, upCtx *upbound.Context, cf
 		return nil, errors.Wrap(err, "failed to determine crossplane version for dev control plane")
 	}
 
+	if classes := availableClasses(ctx, spaceClient); len(classes) > 0 && !slices.Contains(classes, cfg.spacesConfig.class) {
+		return nil, errors.Errorf("control plane class %q is not available in this space; available classes are: %s", cfg.spacesConfig.class, strings.Join(classes, ", "))
+	}
+
 	group := cfg.spacesConfig.group
 	if group == "" {
 		ns, _, err := kubeconfig.Namespace()
@@ -1170,7 +1298,7 @@ func ensureSpacesDevControlPlane(ctx context.Context, upCtx *upbound.Context, cf
 	switch {
 	case err == nil:
 		// Make sure it's a dev control plane and not being deleted.
-		if !isDevControlPlane(&ctp) && !cfg.spacesConfig.allowProd {
+		if !IsDevControlPlane(&ctp) && !cfg.spacesConfig.allowProd {
 			return nil, errNotDevControlPlane
 		}
 		if ctp.DeletionTimestamp != nil {
@@ -1182,6 +1310,9 @@ func ensureSpacesDevControlPlane(ctx context.Context, upCtx *upbound.Context, cf
 				"existing control plane has a different Crossplane spec than expected",
 			)
 		}
+		if !matchesClass(ctp.Spec.Class, cfg.spacesConfig.class) {
+			return nil, errors.Errorf("existing control plane has class %q, but class %q was requested; delete the control plane or use a different name", ctp.Spec.Class, cfg.spacesConfig.class)
+		}
 
 	case kerrors.IsNotFound(err):
 		// Create a control plane.
@@ -1197,7 +1328,7 @@ func ensureSpacesDevControlPlane(ctx context.Context, upCtx *upbound.Context, cf
 			},
 		}
 
-		if err := createSpacesControlPlane(ctx, spaceClient, cfg.eventChan, ctp); err != nil {
+		if err := createSpacesControlPlane(ctx, spaceClient, cfg.eventChan, ctp, cfg.spacesConfig.readyTimeout); err != nil {
 			return nil, err
 		}
 
@@ -1238,7 +1369,9 @@ func ensureSpacesDevControlPlane(ctx context.Context, upCtx *upbound.Context, cf
 	}, nil
 }
 
-func isDevControlPlane(ctp *spacesv1beta1.ControlPlane) bool {
+// IsDevControlPlane returns true if the control plane was created as a
+// development control plane by the project and test commands.
+func IsDevControlPlane(ctp *spacesv1beta1.ControlPlane) bool {
 	if ctp.Annotations != nil && ctp.Annotations[devControlPlaneAnnotation] == "true" {
 		return true
 	}
@@ -1246,7 +1379,7 @@ func isDevControlPlane(ctp *spacesv1beta1.ControlPlane) bool {
 	return false
 }
 
-func createSpacesControlPlane(ctx context.Context, cl client.Client, ch async.EventChannel, ctp spacesv1beta1.ControlPlane) error {
+func createSpacesControlPlane(ctx context.Context, cl client.Client, ch async.EventChannel, ctp spacesv1beta1.ControlPlane, readyTimeout time.Duration) error {
 	evText := "Creating development control plane in Spaces"
 	ch.SendEvent(evText, async.EventStatusStarted)
 
@@ -1286,17 +1419,9 @@ func createSpacesControlPlane(ctx context.Context, cl client.Client, ch async.Ev
 		Name:      ctp.Name,
 		Namespace: ctp.Namespace,
 	}
-	if err := wait.PollUntilContextCancel(ctx, time.Second, true, func(ctx context.Context) (done bool, err error) {
-		err = cl.Get(ctx, nn, &ctp)
-		if err != nil {
-			return false, err
-		}
-
-		cond := ctp.Status.GetCondition(commonv1.TypeReady)
-		return cond.Status == corev1.ConditionTrue, nil
-	}); err != nil {
+	if err := WaitForControlPlaneReady(ctx, cl, nn, readyTimeout); err != nil {
 		ch.SendEvent(evText, async.EventStatusFailure)
-		return errors.Wrap(err, "waiting for control plane to be ready")
+		return err
 	}
 
 	ch.SendEvent(evText, async.EventStatusSuccess)
@@ -1304,6 +1429,57 @@ func createSpacesControlPlane(ctx context.Context, cl client.Client, ch async.Ev
 	return nil
 }
 
+// WaitForControlPlaneReady waits for a Spaces control plane to become ready. A
+// timeout of zero waits until the context is done. If the timeout expires, the
+// returned error includes the last observed Ready condition.
+func WaitForControlPlaneReady(ctx context.Context, cl client.Client, nn types.NamespacedName, timeout time.Duration) error {
+	return waitForControlPlaneReady(ctx, cl, nn, time.Second, timeout)
+}
+
+func waitForControlPlaneReady(ctx context.Context, cl client.Client, nn types.NamespacedName, interval, timeout time.Duration) error {
+	pollCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		pollCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var last commonv1.Condition
+	err := wait.PollUntilContextCancel(pollCtx, interval, true, func(ctx context.Context) (done bool, err error) {
+		var ctp spacesv1beta1.ControlPlane
+		if err := cl.Get(ctx, nn, &ctp); err != nil {
+			return false, err
+		}
+
+		last = ctp.Status.GetCondition(commonv1.TypeReady)
+		return last.Status == corev1.ConditionTrue, nil
+	})
+	switch {
+	case err == nil:
+		return nil
+	case timeout > 0 && errors.Is(pollCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil:
+		return errors.Errorf("timed out after %s waiting for control plane %q to be ready: %s", timeout, nn.Name, describeReadyCondition(last))
+	default:
+		return errors.Wrap(err, "waiting for control plane to be ready")
+	}
+}
+
+// describeReadyCondition returns a human-readable description of a Ready
+// condition, for use in error messages.
+func describeReadyCondition(c commonv1.Condition) string {
+	if c.Status == "" {
+		return "no Ready condition was reported"
+	}
+	desc := fmt.Sprintf("last observed Ready condition was %s", c.Status)
+	if c.Reason != "" {
+		desc += fmt.Sprintf(" (%s)", c.Reason)
+	}
+	if c.Message != "" {
+		desc += ": " + c.Message
+	}
+	return desc
+}
+
 func matchesCrossplaneSpec(existing, desired spacesv1beta1.CrossplaneSpec) bool {
 	// Spaces applies defaults to the CrossplaneSpec, so we can't compare the
 	// full structs. Ignore the version and state unless they're set in our
@@ -1316,18 +1492,80 @@ func matchesCrossplaneSpec(existing, desired spacesv1beta1.CrossplaneSpec) bool
 		existing.State = nil
 	}
 
+	// Spaces defaults the upgrade channel, so compare the effective channels
+	// rather than the auto-upgrade specs.
+	if upgradeChannel(existing.AutoUpgradeSpec) != upgradeChannel(desired.AutoUpgradeSpec) {
+		return false
+	}
+	existing.AutoUpgradeSpec = nil
+	desired.AutoUpgradeSpec = nil
+
 	return cmp.Equal(existing, desired)
 }
 
+// upgradeChannel returns the auto-upgrade channel Spaces uses for the given
+// spec. The channel defaults to Stable when not set.
+func upgradeChannel(spec *spacesv1beta1.CrossplaneAutoUpgradeSpec) spacesv1beta1.CrossplaneUpgradeChannel {
+	if spec == nil || spec.Channel == nil {
+		return spacesv1beta1.CrossplaneUpgradeStable
+	}
+	return *spec.Channel
+}
+
+// matchesClass returns true if an existing control plane's class is the desired
+// class. Control planes without a class use the default class.
+func matchesClass(existing, desired string) bool {
+	if existing == "" {
+		existing = spacesv1beta1.ClassDefault
+	}
+	if desired == "" {
+		desired = spacesv1beta1.ClassDefault
+	}
+	return existing == desired
+}
+
+// availableClasses returns the control plane classes a space accepts, as
+// advertised by an enum on the class field of the ControlPlane CRD's schema.
+// It returns nil if the classes can't be discovered, for example because the
+// schema doesn't restrict the class or the caller can't read CRDs.
+func availableClasses(ctx context.Context, cl client.Client) []string {
+	crd := &unstructured.Unstructured{}
+	crd.SetGroupVersionKind(schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"})
+	if err := cl.Get(ctx, types.NamespacedName{Name: "controlplanes.spaces.upbound.io"}, crd); err != nil {
+		return nil
+	}
+
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for _, v := range versions {
+		vm, ok := v.(map[string]any)
+		if !ok || vm["name"] != spacesv1beta1.Version {
+			continue
+		}
+		enum, _, _ := unstructured.NestedSlice(vm, "schema", "openAPIV3Schema", "properties", "spec", "properties", "class", "enum")
+		classes := make([]string, 0, len(enum))
+		for _, e := range enum {
+			if c, ok := e.(string); ok {
+				classes = append(classes, c)
+			}
+		}
+		return classes
+	}
+	return nil
+}
+
 func determineCrossplaneVersion(ctx context.Context, cl client.Client, cfg *ensureDevControlPlaneConfig) (*spacesv1beta1.CrossplaneSpec, error) {
 	// Caller already configured the crossplane spec - don't override it.
 	if cfg.spacesConfig.crossplane != nil {
 		return cfg.spacesConfig.crossplane, nil
 	}
 
+	if cfg.spacesConfig.crossplaneVersionConstraint != "" && cfg.spacesConfig.crossplaneChannel != "" {
+		return nil, errors.New("cannot set both a crossplane version constraint and an upgrade channel")
+	}
+
 	// Caller did not configure crossplane version at all - use the default.
 	if cfg.spacesConfig.crossplaneVersionConstraint == "" {
-		return defaultCrossplaneSpec(), nil
+		return defaultCrossplaneSpec(cfg.spacesConfig.crossplaneChannel), nil
 	}
 
 	c, err := semver.NewConstraint(cfg.spacesConfig.crossplaneVersionConstraint)
@@ -4,14 +4,22 @@
 package ctp
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"gotest.tools/v3/assert"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	commonv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 
 	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
 )
@@ -55,6 +63,37 @@ func TestMatchesCrossplaneSpec(t *testing.T) {
 			},
 			want: false,
 		},
+		"EqualDefaultedChannel": {
+			existing: spacesv1beta1.CrossplaneSpec{
+				AutoUpgradeSpec: &spacesv1beta1.CrossplaneAutoUpgradeSpec{
+					Channel: ptr.To(spacesv1beta1.CrossplaneUpgradeStable),
+				},
+			},
+			desired: spacesv1beta1.CrossplaneSpec{},
+			want:    true,
+		},
+		"DifferentDefaultedChannel": {
+			existing: spacesv1beta1.CrossplaneSpec{},
+			desired: spacesv1beta1.CrossplaneSpec{
+				AutoUpgradeSpec: &spacesv1beta1.CrossplaneAutoUpgradeSpec{
+					Channel: ptr.To(spacesv1beta1.CrossplaneUpgradeRapid),
+				},
+			},
+			want: false,
+		},
+		"DifferentNonRapidChannels": {
+			existing: spacesv1beta1.CrossplaneSpec{
+				AutoUpgradeSpec: &spacesv1beta1.CrossplaneAutoUpgradeSpec{
+					Channel: ptr.To(spacesv1beta1.CrossplaneUpgradePatch),
+				},
+			},
+			desired: spacesv1beta1.CrossplaneSpec{
+				AutoUpgradeSpec: &spacesv1beta1.CrossplaneAutoUpgradeSpec{
+					Channel: ptr.To(spacesv1beta1.CrossplaneUpgradeStable),
+				},
+			},
+			want: false,
+		},
 		"EqualVersions": {
 			existing: spacesv1beta1.CrossplaneSpec{
 				AutoUpgradeSpec: &spacesv1beta1.CrossplaneAutoUpgradeSpec{
@@ -191,7 +230,28 @@ func TestDetermineCrossplaneVersion(t *testing.T) {
 					crossplaneVersionConstraint: "",
 				},
 			},
-			expected: defaultCrossplaneSpec(),
+			expected: defaultCrossplaneSpec(""),
+		},
+		"Channel": {
+			cfg: &ensureDevControlPlaneConfig{
+				spacesConfig: spacesConfig{
+					crossplaneChannel: spacesv1beta1.CrossplaneUpgradeStable,
+				},
+			},
+			expected: &spacesv1beta1.CrossplaneSpec{
+				AutoUpgradeSpec: &spacesv1beta1.CrossplaneAutoUpgradeSpec{
+					Channel: ptr.To(spacesv1beta1.CrossplaneUpgradeStable),
+				},
+			},
+		},
+		"ChannelAndConstraint": {
+			cfg: &ensureDevControlPlaneConfig{
+				spacesConfig: spacesConfig{
+					crossplaneVersionConstraint: ">=1.18.0",
+					crossplaneChannel:           spacesv1beta1.CrossplaneUpgradeStable,
+				},
+			},
+			expectError: true,
 		},
 		"InvalidConstraint": {
 			cfg: &ensureDevControlPlaneConfig{
@@ -347,3 +407,197 @@ func TestDetermineCrossplaneVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestWaitForControlPlaneReady(t *testing.T) {
+	t.Parallel()
+
+	nn := types.NamespacedName{Namespace: "default", Name: "ctp"}
+	creating := commonv1.Creating()
+	creating.Message = "waiting for crossplane"
+
+	tcs := map[string]struct {
+		// readyAfter is the number of gets after which the control plane
+		// reports Ready. Zero means never.
+		readyAfter  int
+		unready     commonv1.Condition
+		timeout     time.Duration
+		expectError string
+		expectGets  int
+	}{
+		"ReadyImmediately": {
+			readyAfter: 1,
+			timeout:    time.Second,
+			expectGets: 1,
+		},
+		"ReadyAfterPolling": {
+			readyAfter: 3,
+			timeout:    time.Second,
+			expectGets: 3,
+		},
+		"TimeoutWithCondition": {
+			unready:     creating,
+			timeout:     50 * time.Millisecond,
+			expectError: `timed out after 50ms waiting for control plane "ctp" to be ready: last observed Ready condition was False (Creating): waiting for crossplane`,
+		},
+		"TimeoutWithoutCondition": {
+			timeout:     50 * time.Millisecond,
+			expectError: `timed out after 50ms waiting for control plane "ctp" to be ready: no Ready condition was reported`,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			assert.NilError(t, spacesv1beta1.AddToScheme(scheme))
+
+			ctp := &spacesv1beta1.ControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Namespace: nn.Namespace, Name: nn.Name},
+			}
+			if tc.unready.Type != "" {
+				ctp.SetConditions(tc.unready)
+			}
+
+			gets := 0
+			fc := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(ctp).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Get: func(ctx context.Context, cl client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+						gets++
+						if err := cl.Get(ctx, key, obj, opts...); err != nil {
+							return err
+						}
+						if tc.readyAfter > 0 && gets >= tc.readyAfter {
+							obj.(*spacesv1beta1.ControlPlane).SetConditions(commonv1.Available()) //nolint:forcetypeassert // Only control planes are fetched.
+						}
+						return nil
+					},
+				}).
+				Build()
+
+			err := waitForControlPlaneReady(t.Context(), fc, nn, time.Millisecond, tc.timeout)
+
+			if tc.expectError != "" {
+				assert.Error(t, err, tc.expectError)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, gets, tc.expectGets)
+		})
+	}
+}
+
+func TestValidateKindImage(t *testing.T) {
+	t.Parallel()
+
+	tcs := map[string]struct {
+		image       string
+		expectError bool
+	}{
+		"Empty": {
+			image: "",
+		},
+		"Tag": {
+			image: "kindest/node:v1.31.0",
+		},
+		"Digest": {
+			image: "kindest/node@sha256:53df588e04085fd41ae12de0c3fe4c72f7013bba32a20e7325357a1ac94ba865",
+		},
+		"Invalid": {
+			image:       "kindest/node:not a tag",
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateKindImage(tc.image)
+			if tc.expectError {
+				assert.Assert(t, err != nil)
+				return
+			}
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func TestKindClusterName(t *testing.T) {
+	t.Parallel()
+
+	long := "my-very-long-project-name-that-does-not-fit-in-a-hostname"
+	longer := long + "-2"
+
+	assert.Equal(t, kindClusterName("my-project"), "my-project")
+
+	got := kindClusterName(long)
+	assert.Equal(t, len(got), maxKindClusterNameLen)
+	assert.Equal(t, got, kindClusterName(long), "truncation must be deterministic")
+	assert.Assert(t, got != kindClusterName(longer), "names sharing a long prefix must not collide")
+}
+
+func TestMatchesClass(t *testing.T) {
+	t.Parallel()
+
+	assert.Assert(t, matchesClass("small", "small"))
+	assert.Assert(t, matchesClass("", spacesv1beta1.ClassDefault))
+	assert.Assert(t, !matchesClass("small", "large"))
+	assert.Assert(t, !matchesClass("", "small"))
+}
+
+func TestAvailableClasses(t *testing.T) {
+	t.Parallel()
+
+	crd := func(enum ...apiextensionsv1.JSON) *apiextensionsv1.CustomResourceDefinition {
+		return &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "controlplanes.spaces.upbound.io"},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{
+					Name: "v1beta1",
+					Schema: &apiextensionsv1.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+							Properties: map[string]apiextensionsv1.JSONSchemaProps{
+								"spec": {
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"class": {Type: "string", Enum: enum},
+									},
+								},
+							},
+						},
+					},
+				}},
+			},
+		}
+	}
+
+	tcs := map[string]struct {
+		objects  []runtime.Object
+		expected []string
+	}{
+		"Advertised": {
+			objects:  []runtime.Object{crd(apiextensionsv1.JSON{Raw: []byte(`"small"`)}, apiextensionsv1.JSON{Raw: []byte(`"large"`)})},
+			expected: []string{"small", "large"},
+		},
+		"NotRestricted": {
+			objects:  []runtime.Object{crd()},
+			expected: []string{},
+		},
+		"NoCRD": {},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			assert.NilError(t, apiextensionsv1.AddToScheme(scheme))
+			fc := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(tc.objects...).Build()
+
+			got := availableClasses(t.Context(), fc)
+			assert.DeepEqual(t, tc.expected, got)
+		})
+	}
+}
@@ -79,7 +79,7 @@ func findSpacesDevControlPlane(ctx context.Context, upCtx *upbound.Context, cfg
 	err = spaceClient.Get(ctx, nn, &ctp)
 	switch {
 	case err == nil:
-		if !isDevControlPlane(&ctp) && !cfg.spacesConfig.allowProd {
+		if !IsDevControlPlane(&ctp) && !cfg.spacesConfig.allowProd {
 			return nil, false, errNotDevControlPlane
 		}
 
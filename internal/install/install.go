@@ -18,6 +18,7 @@ type Manager interface {
 	GetCurrentVersion() (string, error)
 	Install(version string, parameters map[string]any, opts ...Option) error
 	Upgrade(version string, parameters map[string]any, opts ...UpgradeOption) error
+	Rollback() error
 	Uninstall() error
 }
 
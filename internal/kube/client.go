@@ -91,8 +91,7 @@ func VerifyKubeConfig() func(cfg *api.Config) error {
 // MergeIntoKubeConfig applies a control plane kubeconfig to an existing
 // kubeconfig file and sets it as the current context.
 func MergeIntoKubeConfig(mcpConf *api.Config, existingFilePath string, setDefaultContext bool, preCheck ...func(cfg *api.Config) error) error {
-	po := clientcmd.NewDefaultPathOptions()
-	po.LoadingRules.ExplicitPath = existingFilePath
+	po := PathOptions(existingFilePath)
 	conf, err := po.GetStartingConfig()
 	if err != nil {
 		return err
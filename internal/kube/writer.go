@@ -4,7 +4,11 @@
 package kube
 
 import (
+	"fmt"
 	"io/fs"
+	"maps"
+	"reflect"
+	"slices"
 
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
@@ -33,8 +37,11 @@ type ContextWriter interface {
 type FileWriter struct {
 	upCtx *upbound.Context
 	// fileOverride is the path to the existing kubeconfig to update. If empty
-	// the default loading rules are used.
+	// the kubeconfig is used.
 	fileOverride string
+	// kubeconfig is the path to the existing kubeconfig to update when there
+	// is no file override. If empty the default loading rules are used.
+	kubeconfig string
 	// kubeContext overrides the name of the context to be merged into the
 	// kubeconfig. If empty the merged context retains its name.
 	kubeContext string
@@ -67,7 +74,7 @@ func (f *FileWriter) Write(config *clientcmdapi.Config) error {
 		return err
 	}
 
-	pathOptions := clientcmd.NewDefaultPathOptions()
+	pathOptions := PathOptions(f.kubeconfig)
 	if f.fileOverride != "" {
 		pathOptions = &clientcmd.PathOptions{
 			GlobalFile:   f.fileOverride,
@@ -83,9 +90,24 @@ func (f *FileWriter) Write(config *clientcmdapi.Config) error {
 	return nil
 }
 
+// Preview returns the changes that Write would make to the existing kubeconfig
+// for the given config, without writing anything.
+func (f *FileWriter) Preview(config *clientcmdapi.Config) ([]ConfigChange, error) {
+	outConfig, err := f.loadOutputKubeconfig()
+	if err != nil {
+		return nil, err
+	}
+
+	updatedConf, _, err := f.mergeConfigs(outConfig, config)
+	if err != nil {
+		return nil, err
+	}
+	return DiffConfigs(outConfig, updatedConf), nil
+}
+
 // loadOutputKubeconfig loads the Kubeconfig that will be overwritten by the
-// action, either loading it from the file override or defaulting back to the
-// current kubeconfig.
+// action, either loading it from the file override or the kubeconfig, or
+// defaulting back to the current kubeconfig.
 func (f *FileWriter) loadOutputKubeconfig() (config *clientcmdapi.Config, err error) {
 	if f.fileOverride != "" {
 		config, err = clientcmd.LoadFromFile(f.fileOverride)
@@ -97,6 +119,10 @@ func (f *FileWriter) loadOutputKubeconfig() (config *clientcmdapi.Config, err er
 		return config, nil
 	}
 
+	if f.kubeconfig != "" {
+		return PathOptions(f.kubeconfig).GetStartingConfig()
+	}
+
 	raw, err := f.upCtx.Kubecfg.RawConfig()
 	if err != nil {
 		return nil, err
@@ -152,6 +178,50 @@ func (f *FileWriter) mergeConfigs(outConfig *clientcmdapi.Config, inConfig *clie
 	return outConfig, previousContextName, nil
 }
 
+// ConfigChange describes a change to an entry of a kubeconfig.
+type ConfigChange struct {
+	// Kind is the kind of the changed entry: "context", "cluster", "user", or
+	// "current-context".
+	Kind string
+	// Name is the name of the changed entry. For the current context it's the
+	// name of the new current context.
+	Name string
+	// Action is either "added" or "modified".
+	Action string
+}
+
+// String returns a human-readable description of the change.
+func (c ConfigChange) String() string {
+	return fmt.Sprintf("%s %s %q", c.Action, c.Kind, c.Name)
+}
+
+// DiffConfigs returns the contexts, clusters, and users that were added or
+// modified between two kubeconfigs, followed by the current context if it
+// changed. Removed entries are not reported.
+func DiffConfigs(before, after *clientcmdapi.Config) []ConfigChange {
+	changes := diffEntries("context", before.Contexts, after.Contexts)
+	changes = append(changes, diffEntries("cluster", before.Clusters, after.Clusters)...)
+	changes = append(changes, diffEntries("user", before.AuthInfos, after.AuthInfos)...)
+	if before.CurrentContext != after.CurrentContext {
+		changes = append(changes, ConfigChange{Kind: "current-context", Name: after.CurrentContext, Action: "modified"})
+	}
+	return changes
+}
+
+func diffEntries[T any](kind string, before, after map[string]T) []ConfigChange {
+	var changes []ConfigChange
+	for _, name := range slices.Sorted(maps.Keys(after)) {
+		prev, ok := before[name]
+		switch {
+		case !ok:
+			changes = append(changes, ConfigChange{Kind: kind, Name: name, Action: "added"})
+		case !reflect.DeepEqual(prev, after[name]):
+			changes = append(changes, ConfigChange{Kind: kind, Name: name, Action: "modified"})
+		}
+	}
+	return changes
+}
+
 func copyContext(config *clientcmdapi.Config, name string) (*clientcmdapi.Context, *clientcmdapi.Cluster, *clientcmdapi.AuthInfo, error) {
 	ctx, ok := config.Contexts[name]
 	if !ok {
@@ -171,10 +241,22 @@ func copyContext(config *clientcmdapi.Config, name string) (*clientcmdapi.Contex
 	return ctx.DeepCopy(), cluster.DeepCopy(), authInfo.DeepCopy(), nil
 }
 
+// FileWriterOption configures a FileWriter.
+type FileWriterOption func(*FileWriter)
+
+// WithKubeconfig sets the path of the kubeconfig that the file writer reads
+// and updates when it has no file override. If empty, the default loading
+// rules are used.
+func WithKubeconfig(path string) FileWriterOption {
+	return func(f *FileWriter) {
+		f.kubeconfig = path
+	}
+}
+
 // NewFileWriter returns a new, ready-to-use, file writer. The zero value of the
 // file writer is not usable.
-func NewFileWriter(upCtx *upbound.Context, fileOverride string, kubeContext string) *FileWriter {
-	return &FileWriter{
+func NewFileWriter(upCtx *upbound.Context, fileOverride string, kubeContext string, opts ...FileWriterOption) *FileWriter {
+	f := &FileWriter{
 		upCtx:                upCtx,
 		fileOverride:         fileOverride,
 		kubeContext:          kubeContext,
@@ -182,6 +264,18 @@ func NewFileWriter(upCtx *upbound.Context, fileOverride string, kubeContext stri
 		writeLastContextFunc: WriteLastContext,
 		modifyConfigFunc:     clientcmd.ModifyConfig,
 	}
+	for _, o := range opts {
+		o(f)
+	}
+	return f
+}
+
+// PathOptions returns the options for loading and updating the kubeconfig at
+// the given path. If the path is empty, the default loading rules are used.
+func PathOptions(kubeconfig string) *clientcmd.PathOptions {
+	po := clientcmd.NewDefaultPathOptions()
+	po.LoadingRules.ExplicitPath = kubeconfig
+	return po
 }
 
 // NopWriter doesn't actually write a kubeconfig.
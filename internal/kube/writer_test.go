@@ -4,9 +4,11 @@
 package kube
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
@@ -254,3 +256,244 @@ func TestFileWriterWrite(t *testing.T) {
 		})
 	}
 }
+
+func TestFileWriterWriteTarget(t *testing.T) {
+	t.Parallel()
+
+	inConf := &clientcmdapi.Config{
+		CurrentContext: "upbound",
+		Contexts:       map[string]*clientcmdapi.Context{"upbound": {Cluster: "upbound", AuthInfo: "upbound"}},
+		Clusters:       map[string]*clientcmdapi.Cluster{"upbound": {Server: "https://ingress"}},
+		AuthInfos:      map[string]*clientcmdapi.AuthInfo{"upbound": {Token: "token"}},
+	}
+	// existing returns a kubeconfig containing a single context with the
+	// given name.
+	existing := func(name string) *clientcmdapi.Config {
+		c := clientcmdapi.NewConfig()
+		c.CurrentContext = name
+		c.Contexts[name] = &clientcmdapi.Context{Cluster: name, AuthInfo: name}
+		c.Clusters[name] = &clientcmdapi.Cluster{Server: "https://" + name}
+		c.AuthInfos[name] = &clientcmdapi.AuthInfo{Token: name}
+		return c
+	}
+
+	type args struct {
+		// fileOverride is the name of the file override, if any. Only the
+		// "override" file exists.
+		fileOverride string
+		kubeconfig   bool
+	}
+	type want struct {
+		file     string
+		contexts []string
+	}
+
+	tests := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Kubeconfig": {
+			reason: "Without a file override the kubeconfig should be read and updated.",
+			args: args{
+				kubeconfig: true,
+			},
+			want: want{
+				file:     "kubeconfig",
+				contexts: []string{"kubeconfig", "upbound"},
+			},
+		},
+		"FileOverride": {
+			reason: "A file override should be read and updated instead of the kubeconfig.",
+			args: args{
+				fileOverride: "override",
+				kubeconfig:   true,
+			},
+			want: want{
+				file:     "override",
+				contexts: []string{"override", "upbound"},
+			},
+		},
+		"MissingFileOverride": {
+			reason: "A file override that doesn't exist yet should be created rather than merged with the kubeconfig.",
+			args: args{
+				fileOverride: "new",
+				kubeconfig:   true,
+			},
+			want: want{
+				file:     "new",
+				contexts: []string{"upbound"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			path := func(name string) string { return filepath.Join(dir, name) }
+			for _, name := range []string{"kubeconfig", "override"} {
+				if err := clientcmd.WriteToFile(*existing(name), path(name)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var opts []FileWriterOption
+			if tt.args.kubeconfig {
+				opts = append(opts, WithKubeconfig(path("kubeconfig")))
+			}
+			var fileOverride string
+			if tt.args.fileOverride != "" {
+				fileOverride = path(tt.args.fileOverride)
+			}
+
+			// The default client config is never read, since either the
+			// kubeconfig or the file override is always set.
+			upCtx := &upbound.Context{Kubecfg: clientcmd.NewDefaultClientConfig(*existing("default"), nil)}
+			writer := NewFileWriter(upCtx, fileOverride, "upbound", opts...)
+			writer.verifyFunc = func(_ *clientcmdapi.Config) error { return nil }
+			writer.writeLastContextFunc = func(_ string) error { return nil }
+
+			var (
+				file     string
+				contexts []string
+			)
+			writer.modifyConfigFunc = func(ca clientcmd.ConfigAccess, newConfig clientcmdapi.Config, _ bool) error {
+				file = filepath.Base(ca.GetDefaultFilename())
+				for name := range newConfig.Contexts {
+					if name != "upbound"+UpboundPreviousContextSuffix {
+						contexts = append(contexts, name)
+					}
+				}
+				return nil
+			}
+
+			if err := writer.Write(inConf); err != nil {
+				t.Fatalf("\n%s\nWrite(...): unexpected error: %v", tt.reason, err)
+			}
+			if diff := cmp.Diff(tt.want.file, file); diff != "" {
+				t.Errorf("\n%s\nWrite(...): -want file, +got file:\n%s", tt.reason, diff)
+			}
+			if diff := cmp.Diff(tt.want.contexts, contexts, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("\n%s\nWrite(...): -want contexts, +got contexts:\n%s", tt.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFileWriterPreview(t *testing.T) {
+	t.Parallel()
+
+	outConf := &clientcmdapi.Config{
+		CurrentContext: "upbound",
+		Contexts:       map[string]*clientcmdapi.Context{"upbound": {Cluster: "upbound", AuthInfo: "upbound"}},
+		Clusters:       map[string]*clientcmdapi.Cluster{"upbound": {Server: "https://previous-ingress"}},
+		AuthInfos:      map[string]*clientcmdapi.AuthInfo{"upbound": {Token: "token"}},
+	}
+	inConf := &clientcmdapi.Config{
+		CurrentContext: "upbound",
+		Contexts:       map[string]*clientcmdapi.Context{"upbound": {Cluster: "upbound", AuthInfo: "upbound"}},
+		Clusters:       map[string]*clientcmdapi.Cluster{"upbound": {Server: "https://ingress"}},
+		AuthInfos:      map[string]*clientcmdapi.AuthInfo{"upbound": {Token: "token"}},
+	}
+
+	upCtx := &upbound.Context{Kubecfg: clientcmd.NewDefaultClientConfig(*outConf, nil)}
+	writer := NewFileWriter(upCtx, "", "upbound")
+	writer.verifyFunc = func(_ *clientcmdapi.Config) error {
+		t.Error("Preview(...): unexpected call to verify")
+		return nil
+	}
+	writer.writeLastContextFunc = func(_ string) error {
+		t.Error("Preview(...): unexpected call to write last context")
+		return nil
+	}
+	writer.modifyConfigFunc = func(_ clientcmd.ConfigAccess, _ clientcmdapi.Config, _ bool) error {
+		t.Error("Preview(...): unexpected call to modify config")
+		return nil
+	}
+
+	got, err := writer.Preview(inConf)
+	if err != nil {
+		t.Fatalf("Preview(...): unexpected error: %v", err)
+	}
+	want := []ConfigChange{
+		{Kind: "context", Name: "upbound-previous", Action: "added"},
+		{Kind: "cluster", Name: "upbound", Action: "modified"},
+		{Kind: "cluster", Name: "upbound-previous", Action: "added"},
+		{Kind: "user", Name: "upbound-previous", Action: "added"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Preview(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestDiffConfigs(t *testing.T) {
+	t.Parallel()
+
+	before := &clientcmdapi.Config{
+		CurrentContext: "other",
+		Contexts: map[string]*clientcmdapi.Context{
+			"other":   {Cluster: "other", AuthInfo: "other"},
+			"upbound": {Cluster: "upbound", AuthInfo: "upbound"},
+		},
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"other":   {Server: "https://other"},
+			"upbound": {Server: "https://previous-ingress"},
+		},
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			"other":   {Token: "other"},
+			"upbound": {Token: "token"},
+		},
+	}
+
+	tests := map[string]struct {
+		reason string
+		after  *clientcmdapi.Config
+		want   []ConfigChange
+	}{
+		"NoChanges": {
+			reason: "Identical kubeconfigs should have no changes.",
+			after:  before.DeepCopy(),
+		},
+		"Changes": {
+			reason: "Added and modified entries should be reported in order, followed by the current context.",
+			after: &clientcmdapi.Config{
+				CurrentContext: "upbound",
+				Contexts: map[string]*clientcmdapi.Context{
+					"other":   {Cluster: "other", AuthInfo: "other"},
+					"upbound": {Cluster: "upbound", AuthInfo: "upbound"},
+					"new":     {Cluster: "new", AuthInfo: "new"},
+				},
+				Clusters: map[string]*clientcmdapi.Cluster{
+					"other":   {Server: "https://other"},
+					"upbound": {Server: "https://ingress"},
+					"new":     {Server: "https://new"},
+				},
+				AuthInfos: map[string]*clientcmdapi.AuthInfo{
+					"upbound": {Token: "new-token"},
+					"new":     {Token: "new"},
+				},
+			},
+			want: []ConfigChange{
+				{Kind: "context", Name: "new", Action: "added"},
+				{Kind: "cluster", Name: "new", Action: "added"},
+				{Kind: "cluster", Name: "upbound", Action: "modified"},
+				{Kind: "user", Name: "new", Action: "added"},
+				{Kind: "user", Name: "upbound", Action: "modified"},
+				{Kind: "current-context", Name: "upbound", Action: "modified"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := DiffConfigs(before, tt.after)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("\n%s\nDiffConfigs(...): -want, +got:\n%s", tt.reason, diff)
+			}
+		})
+	}
+}
@@ -61,6 +61,9 @@ type Builder interface {
 	// always include one image with the ConfigurationTag, which is the
 	// configuration package built from the APIs found in the project.
 	Build(ctx context.Context, upCtx *upbound.Context, project *v2alpha1.Project, projectFS afero.Fs, opts ...BuildOption) (ImageTagMap, error)
+	// BuildFunction builds a single embedded function of a project. It returns
+	// a map containing an image for each of the project's architectures.
+	BuildFunction(ctx context.Context, upCtx *upbound.Context, project *v2alpha1.Project, projectFS afero.Fs, fnName string, opts ...BuildOption) (ImageTagMap, error)
 }
 
 // BuilderOption configures a builder.
@@ -74,6 +77,14 @@ func BuildWithFunctionIdentifier(i functions.Identifier) BuilderOption {
 	}
 }
 
+// BuildWithFunctionCache sets a cache from which embedded functions whose
+// source hasn't changed are reused instead of being rebuilt.
+func BuildWithFunctionCache(c *FunctionCache) BuilderOption {
+	return func(b *realBuilder) {
+		b.functionCache = c
+	}
+}
+
 // BuildWithMaxConcurrency sets the maximum concurrency for building embedded
 // functions.
 func BuildWithMaxConcurrency(n uint) BuilderOption {
@@ -86,10 +97,11 @@ func BuildWithMaxConcurrency(n uint) BuilderOption {
 type BuildOption func(o *buildOptions)
 
 type buildOptions struct {
-	eventChan       async.EventChannel
-	imageLabels     map[string]string
-	depManager      *DependencyManager
-	projectBasePath string
+	eventChan        async.EventChannel
+	imageLabels      map[string]string
+	imageAnnotations map[string]string
+	depManager       *DependencyManager
+	projectBasePath  string
 }
 
 // BuildWithEventChannel provides a channel to which progress updates will be
@@ -109,6 +121,15 @@ func BuildWithImageLabels(labels map[string]string) BuildOption {
 	}
 }
 
+// BuildWithImageAnnotations provides annotations that will be added to the
+// manifests of all images after they are built. The annotations are stored as
+// image labels until the image is annotated with xpkg.AnnotateImage.
+func BuildWithImageAnnotations(annotations map[string]string) BuildOption {
+	return func(o *buildOptions) {
+		o.imageAnnotations = annotations
+	}
+}
+
 // BuildWithDependencyManager provides a dependency manager to use for
 // dependency resolution during build.
 func BuildWithDependencyManager(m *DependencyManager) BuildOption {
@@ -129,6 +150,7 @@ func BuildWithProjectBasePath(path string) BuildOption {
 type realBuilder struct {
 	functionIdentifier functions.Identifier
 	maxConcurrency     uint
+	functionCache      *FunctionCache
 }
 
 // Build implements the Builder interface.
@@ -138,6 +160,16 @@ func (b *realBuilder) Build(ctx context.Context, upCtx *upbound.Context, project
 		opt(os)
 	}
 
+	if err := validateImageMetadata(os); err != nil {
+		return nil, err
+	}
+
+	// Hide ignored files from everything we build.
+	projectFS, err := ignoreProjectFS(projectFS, os)
+	if err != nil {
+		return nil, err
+	}
+
 	// Scaffold a configuration based on the metadata in the project. Later
 	// we'll add any embedded functions we build to the dependencies.
 	cfg := &xpmetav1.Configuration{
@@ -298,8 +330,8 @@ func (b *realBuilder) Build(ctx context.Context, upCtx *upbound.Context, project
 		return nil, errors.Wrap(err, "failed to build package")
 	}
 
-	if os.imageLabels != nil {
-		img, err = addLabels(img, os.imageLabels)
+	if labels := imageLabels(os); len(labels) > 0 {
+		img, err = addLabels(img, labels)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed add labels to package")
 		}
@@ -329,6 +361,11 @@ func (b *realBuilder) buildFunctions(ctx context.Context, upCtx *upbound.Context
 	var (
 		imgMap = make(map[name.Tag]v1.Image)
 		imgMu  sync.Mutex
+
+		// missing collects the target platforms each function wasn't built
+		// for, so they can all be reported at once.
+		missing   []string
+		missingMu sync.Mutex
 	)
 
 	infos, err := afero.ReadDir(fromFS, "/")
@@ -365,11 +402,24 @@ func (b *realBuilder) buildFunctions(ctx context.Context, upCtx *upbound.Context
 			if basePath != "" {
 				fnBasePath = filepath.Join(basePath, project.Spec.Paths.Functions, fnName)
 			}
-			imgs, err := b.buildFunction(ctx, upCtx, fnFS, project, fnName, fnBasePath)
+			imgs, err := b.buildFunctionCached(ctx, upCtx, fnFS, project, fnName, fnBasePath)
 			if err != nil {
 				return errors.Wrapf(err, "failed to build function %q", fnName)
 			}
 
+			platforms, err := missingPlatforms(imgs, project.Spec.Architectures)
+			if err != nil {
+				return errors.Wrapf(err, "failed to check platforms of function %q", fnName)
+			}
+			if len(platforms) > 0 {
+				missingMu.Lock()
+				for _, p := range platforms {
+					missing = append(missing, fmt.Sprintf("%s (%s)", fnName, p))
+				}
+				missingMu.Unlock()
+				return nil
+			}
+
 			// Construct an index so we know the digest for the dependency. This
 			// index will be reproduced when we push the image.
 			idx, imgs, err := xpkg.BuildIndex(imgs...)
@@ -388,15 +438,9 @@ func (b *realBuilder) buildFunctions(ctx context.Context, upCtx *upbound.Context
 			}
 
 			for _, img := range imgs {
-				cfg, err := img.ConfigFile()
-				if err != nil {
-					return errors.Wrapf(err, "failed to get config for function image %q", fnName)
-				}
-
-				tag := fmt.Sprintf("%s:%s", fnRepo, cfg.Architecture)
-				imgTag, err := name.NewTag(tag)
+				imgTag, err := functionImageTag(fnRepo, img)
 				if err != nil {
-					return errors.Wrapf(err, "failed to construct tag for function image %q", fnName)
+					return errors.Wrapf(err, "failed to tag function image %q", fnName)
 				}
 				imgMu.Lock()
 				imgMap[imgTag] = img
@@ -411,10 +455,151 @@ func (b *realBuilder) buildFunctions(ctx context.Context, upCtx *upbound.Context
 	if err != nil {
 		return nil, nil, err
 	}
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		return nil, nil, errors.Errorf("embedded functions are missing images for the project's target platforms: %s", strings.Join(missing, ", "))
+	}
 
 	return imgMap, deps, nil
 }
 
+// missingPlatforms returns the platforms targeted by the given architectures
+// for which there is no image. Functions always target linux.
+func missingPlatforms(imgs []v1.Image, architectures []string) ([]string, error) {
+	have := make(map[string]bool, len(imgs))
+	for _, img := range imgs {
+		cfg, err := img.ConfigFile()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get image config")
+		}
+		have[cfg.OS+"/"+cfg.Architecture] = true
+	}
+
+	var missing []string
+	for _, arch := range architectures {
+		if p := "linux/" + arch; !have[p] {
+			missing = append(missing, p)
+		}
+	}
+	return missing, nil
+}
+
+// BuildFunction implements the Builder interface.
+func (b *realBuilder) BuildFunction(ctx context.Context, upCtx *upbound.Context, project *v2alpha1.Project, projectFS afero.Fs, fnName string, opts ...BuildOption) (ImageTagMap, error) {
+	os := &buildOptions{}
+	for _, opt := range opts {
+		opt(os)
+	}
+
+	projectFS, err := ignoreProjectFS(projectFS, os)
+	if err != nil {
+		return nil, err
+	}
+
+	functionsSource := afero.NewBasePathFs(projectFS, project.Spec.Paths.Functions)
+	exists, err := afero.DirExists(functionsSource, fnName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find function %q", fnName)
+	}
+	if !exists {
+		return nil, errors.Errorf("function %q not found in %q", fnName, project.Spec.Paths.Functions)
+	}
+
+	// Functions may depend on the schemas of the project's dependencies, so
+	// make sure they're in the cache.
+	if os.depManager != nil {
+		statusStage := "Checking dependencies"
+		os.eventChan.SendEvent(statusStage, async.EventStatusStarted)
+		if err := os.depManager.AddAll(ctx, project.Spec.DependsOn...); err != nil {
+			os.eventChan.SendEvent(statusStage, async.EventStatusFailure)
+			return nil, err
+		}
+		os.eventChan.SendEvent(statusStage, async.EventStatusSuccess)
+	}
+
+	statusStage := fmt.Sprintf("Building function %s", fnName)
+	os.eventChan.SendEvent(statusStage, async.EventStatusStarted)
+	fnBasePath := ""
+	if os.projectBasePath != "" {
+		fnBasePath = filepath.Join(os.projectBasePath, project.Spec.Paths.Functions, fnName)
+	}
+	imgs, err := b.buildFunctionCached(ctx, upCtx, afero.NewBasePathFs(functionsSource, fnName), project, fnName, fnBasePath)
+	if err != nil {
+		os.eventChan.SendEvent(statusStage, async.EventStatusFailure)
+		return nil, errors.Wrapf(err, "failed to build function %q", fnName)
+	}
+
+	fnRepo := fmt.Sprintf("%s_%s", project.Spec.Repository, fnName)
+	imgMap := make(ImageTagMap, len(imgs))
+	for _, img := range imgs {
+		imgTag, err := functionImageTag(fnRepo, img)
+		if err != nil {
+			os.eventChan.SendEvent(statusStage, async.EventStatusFailure)
+			return nil, errors.Wrapf(err, "failed to tag function image %q", fnName)
+		}
+		imgMap[imgTag] = img
+	}
+	os.eventChan.SendEvent(statusStage, async.EventStatusSuccess)
+
+	return imgMap, nil
+}
+
+// ignoreProjectFS returns a view of the project filesystem without the files
+// ignored by the project. The view isn't an afero.BasePathFs, so it resolves
+// the project's real path first if it hasn't been set.
+func ignoreProjectFS(projectFS afero.Fs, os *buildOptions) (afero.Fs, error) {
+	ignFS, err := newIgnoreFS(projectFS)
+	if err != nil {
+		return nil, err
+	}
+	if ignFS == projectFS {
+		return projectFS, nil
+	}
+	if bfs, ok := projectFS.(*afero.BasePathFs); ok && os.projectBasePath == "" {
+		os.projectBasePath = afero.FullBaseFsPath(bfs, ".")
+	}
+	return ignFS, nil
+}
+
+// functionImageTag returns the tag for a function image, which is the
+// function's repository tagged with the image's architecture.
+func functionImageTag(fnRepo string, img v1.Image) (name.Tag, error) {
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return name.Tag{}, errors.Wrap(err, "failed to get image config")
+	}
+	return name.NewTag(fmt.Sprintf("%s:%s", fnRepo, cfg.Architecture))
+}
+
+// buildFunctionCached builds images for a single function, reusing the images
+// from the function cache if the function's source hasn't changed.
+func (b *realBuilder) buildFunctionCached(ctx context.Context, upCtx *upbound.Context, fromFS afero.Fs, project *v2alpha1.Project, fnName string, basePath string) ([]v1.Image, error) {
+	if b.functionCache == nil {
+		return b.buildFunction(ctx, upCtx, fromFS, project, fnName, basePath)
+	}
+
+	key, err := functionCacheKey(fromFS, project, fnName)
+	if err != nil {
+		return nil, err
+	}
+	imgs, ok, err := b.functionCache.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return imgs, nil
+	}
+
+	imgs, err = b.buildFunction(ctx, upCtx, fromFS, project, fnName, basePath)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.functionCache.Put(key, imgs); err != nil {
+		return nil, err
+	}
+	return imgs, nil
+}
+
 // buildFunction builds images for a single function whose source resides in the
 // given filesystem. One image will be returned for each architecture specified
 // in the project.
@@ -594,6 +779,35 @@ func fnMetaFromProject(proj *v2alpha1.Project, fnName string) metav1.ObjectMeta
 	return *meta
 }
 
+// validateImageMetadata returns an error if a label or annotation would
+// overwrite one used by xpkg.AnnotateImage.
+func validateImageMetadata(os *buildOptions) error {
+	for k := range os.imageLabels {
+		if xpkg.IsReservedKey(k) {
+			return errors.Errorf("label %q is reserved", k)
+		}
+	}
+	for k := range os.imageAnnotations {
+		if xpkg.IsReservedKey(k) {
+			return errors.Errorf("annotation %q is reserved", k)
+		}
+	}
+	return nil
+}
+
+// imageLabels returns the labels to add to built images, including the labels
+// that xpkg.AnnotateImage turns into manifest annotations.
+func imageLabels(os *buildOptions) map[string]string {
+	labels := maps.Clone(os.imageLabels)
+	if labels == nil {
+		labels = make(map[string]string, len(os.imageAnnotations))
+	}
+	for k, v := range os.imageAnnotations {
+		labels[xpkg.ManifestAnnotationLabel(k)] = v
+	}
+	return labels
+}
+
 func addLabels(img v1.Image, labels map[string]string) (v1.Image, error) {
 	cfgFile, err := img.ConfigFile()
 	if err != nil {
@@ -0,0 +1,75 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package project
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/spf13/afero"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/xpkg/functions"
+	"github.com/upbound/up/pkg/apis/project/v2alpha1"
+)
+
+// skipArchIdentifier returns fake builders that don't build for one
+// architecture.
+type skipArchIdentifier struct {
+	skip string
+}
+
+func (i *skipArchIdentifier) Identify(fromFS afero.Fs, upCtx *upbound.Context, cfg []v2alpha1.ImageConfig) (functions.Builder, error) {
+	b, err := functions.FakeIdentifier.Identify(fromFS, upCtx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &skipArchBuilder{Builder: b, skip: i.skip}, nil
+}
+
+type skipArchBuilder struct {
+	functions.Builder
+
+	skip string
+}
+
+func (b *skipArchBuilder) Build(ctx context.Context, fromFS afero.Fs, architectures []string, osBasePath string) ([]v1.Image, error) {
+	architectures = slices.DeleteFunc(slices.Clone(architectures), func(arch string) bool {
+		return arch == b.skip
+	})
+	return b.Builder.Build(ctx, fromFS, architectures, osBasePath)
+}
+
+func TestBuildFunctionsMissingPlatforms(t *testing.T) {
+	proj := &v2alpha1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		Spec: &v2alpha1.ProjectSpec{
+			Repository:    "xpkg.upbound.io/example/example",
+			Architectures: []string{"amd64", "arm64"},
+		},
+	}
+
+	fnsFS := afero.NewMemMapFs()
+	assert.NilError(t, afero.WriteFile(fnsFS, "/fn1/main.py", []byte("print('hello')"), 0o644))
+	assert.NilError(t, afero.WriteFile(fnsFS, "/fn2/main.py", []byte("print('hello')"), 0o644))
+
+	b := &realBuilder{
+		functionIdentifier: &skipArchIdentifier{skip: "arm64"},
+		maxConcurrency:     1,
+	}
+
+	_, _, err := b.buildFunctions(t.Context(), nil, fnsFS, proj, "")
+	assert.Error(t, err, "embedded functions are missing images for the project's target platforms: fn1 (linux/arm64), fn2 (linux/arm64)")
+
+	// Every function is built for all the project's platforms.
+	b.functionIdentifier = functions.FakeIdentifier
+	imgMap, deps, err := b.buildFunctions(t.Context(), nil, fnsFS, proj, "")
+	assert.NilError(t, err)
+	assert.Equal(t, len(imgMap), 4)
+	assert.Equal(t, len(deps), 2)
+}
@@ -0,0 +1,177 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package project
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/spf13/afero"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up/pkg/apis/project/v2alpha1"
+)
+
+// sharedFunctionCacheDir is the directory of the shared function cache within
+// a build cache directory.
+const sharedFunctionCacheDir = "functions"
+
+// FunctionCache is an on-disk cache of built embedded function images, keyed
+// by a hash of the function's source and the project it is built for. It
+// allows functions whose source hasn't changed to be reused across builds.
+// It is safe for concurrent use.
+type FunctionCache struct {
+	dir string
+}
+
+// NewFunctionCache returns a function cache that stores images in the given
+// directory.
+func NewFunctionCache(dir string) *FunctionCache {
+	return &FunctionCache{dir: dir}
+}
+
+// SharedFunctionCache returns the function cache within a build cache
+// directory. It's shared by all commands that build embedded functions, so
+// that a function built by one command is reused by the others.
+func SharedFunctionCache(buildCacheDir string) *FunctionCache {
+	return NewFunctionCache(filepath.Join(buildCacheDir, sharedFunctionCacheDir))
+}
+
+// Get returns the cached images for the given key. It returns false if there
+// are no cached images for the key.
+func (c *FunctionCache) Get(key string) ([]v1.Image, bool, error) {
+	p, err := layout.FromPath(filepath.Join(c.dir, key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to open cached function")
+	}
+
+	idx, err := p.ImageIndex()
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to read cached function index")
+	}
+	m, err := idx.IndexManifest()
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to read cached function index manifest")
+	}
+
+	imgs := make([]v1.Image, 0, len(m.Manifests))
+	for _, desc := range m.Manifests {
+		img, err := idx.Image(desc.Digest)
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "failed to read cached function image %s", desc.Digest)
+		}
+		imgs = append(imgs, img)
+	}
+
+	return imgs, true, nil
+}
+
+// Put stores images in the cache under the given key. Images are written to a
+// temporary directory first so that concurrent readers never observe a
+// partially written entry.
+func (c *FunctionCache) Put(key string, imgs []v1.Image) error {
+	if err := os.MkdirAll(c.dir, 0o755); err != nil {
+		return errors.Wrap(err, "failed to create function cache directory")
+	}
+	tmp, err := os.MkdirTemp(c.dir, key+".tmp-")
+	if err != nil {
+		return errors.Wrap(err, "failed to create temporary cache directory")
+	}
+	defer os.RemoveAll(tmp) //nolint:errcheck // Best effort; the directory is gone after a successful rename.
+
+	p, err := layout.Write(tmp, empty.Index)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize cached function")
+	}
+	for _, img := range imgs {
+		if err := p.AppendImage(img); err != nil {
+			return errors.Wrap(err, "failed to write cached function image")
+		}
+	}
+
+	dst := filepath.Join(c.dir, key)
+	if err := os.Rename(tmp, dst); err != nil {
+		// Another build may have cached the same function concurrently, in
+		// which case the entry we wrote is redundant.
+		if _, serr := os.Stat(dst); serr == nil {
+			return nil
+		}
+		return errors.Wrap(err, "failed to store cached function")
+	}
+	return nil
+}
+
+// functionCacheKey returns a cache key for the function whose source lives in
+// fromFS. The key changes whenever the function's source or the project it is
+// built for changes. Symlinks in the function source are followed, so changes
+// to linked files such as generated schemas also change the key.
+func functionCacheKey(fromFS afero.Fs, project *v2alpha1.Project, fnName string) (string, error) {
+	h := sha256.New()
+
+	meta, err := json.Marshal(struct {
+		Name    string
+		Project *v2alpha1.Project
+	}{Name: fnName, Project: project})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal function metadata")
+	}
+	_, _ = h.Write(meta)
+
+	if err := hashTree(h, fromFS, "/"); err != nil {
+		return "", errors.Wrap(err, "failed to hash function source")
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashTree writes the path and content of every file under dir to h, in a
+// stable order.
+func hashTree(h io.Writer, fromFS afero.Fs, dir string) error {
+	infos, err := afero.ReadDir(fromFS, dir)
+	if err != nil {
+		return err
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name() < infos[j].Name() })
+
+	for _, info := range infos {
+		p := path.Join(dir, info.Name())
+		// ReadDir doesn't follow symlinks; Stat does.
+		st, err := fromFS.Stat(p)
+		if err != nil {
+			return err
+		}
+		if st.IsDir() {
+			if err := hashTree(h, fromFS, p); err != nil {
+				return err
+			}
+			continue
+		}
+
+		_, _ = io.WriteString(h, p+"\x00")
+		f, err := fromFS.Open(p)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(h, f)
+		_ = f.Close()
+		if err != nil {
+			return err
+		}
+		_, _ = io.WriteString(h, "\x00")
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package project
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/xpkg/functions"
+	"github.com/upbound/up/pkg/apis/project/v2alpha1"
+)
+
+// countingIdentifier counts the builds done by the fake function identifier.
+type countingIdentifier struct {
+	builds int
+}
+
+func (i *countingIdentifier) Identify(fromFS afero.Fs, upCtx *upbound.Context, cfg []v2alpha1.ImageConfig) (functions.Builder, error) {
+	i.builds++
+	return functions.FakeIdentifier.Identify(fromFS, upCtx, cfg)
+}
+
+func TestBuildFunctionCached(t *testing.T) {
+	proj := &v2alpha1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		Spec: &v2alpha1.ProjectSpec{
+			Repository:    "xpkg.upbound.io/example/example",
+			Architectures: []string{"amd64"},
+		},
+	}
+
+	fnFS := afero.NewMemMapFs()
+	assert.NilError(t, afero.WriteFile(fnFS, "/main.py", []byte("print('hello')"), 0o644))
+
+	id := &countingIdentifier{}
+	b := &realBuilder{
+		functionIdentifier: id,
+		maxConcurrency:     1,
+		functionCache:      NewFunctionCache(t.TempDir()),
+	}
+
+	first, err := b.buildFunctionCached(t.Context(), nil, fnFS, proj, "fn1", "")
+	assert.NilError(t, err)
+	assert.Equal(t, id.builds, 1)
+
+	// The source hasn't changed, so the second build should be a cache hit.
+	second, err := b.buildFunctionCached(t.Context(), nil, fnFS, proj, "fn1", "")
+	assert.NilError(t, err)
+	assert.Equal(t, id.builds, 1)
+	assert.Equal(t, len(second), len(first))
+	for i := range first {
+		want, err := first[i].Digest()
+		assert.NilError(t, err)
+		got, err := second[i].Digest()
+		assert.NilError(t, err)
+		assert.Equal(t, got, want)
+	}
+
+	// Changing the source should cause a rebuild.
+	assert.NilError(t, afero.WriteFile(fnFS, "/main.py", []byte("print('goodbye')"), 0o644))
+	_, err = b.buildFunctionCached(t.Context(), nil, fnFS, proj, "fn1", "")
+	assert.NilError(t, err)
+	assert.Equal(t, id.builds, 2)
+}
+
+func TestSharedFunctionCache(t *testing.T) {
+	proj := &v2alpha1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		Spec: &v2alpha1.ProjectSpec{
+			Repository:    "xpkg.upbound.io/example/example",
+			Architectures: []string{"amd64"},
+		},
+	}
+
+	fnFS := afero.NewMemMapFs()
+	assert.NilError(t, afero.WriteFile(fnFS, "/main.py", []byte("print('hello')"), 0o644))
+
+	// Separate builders, as constructed by different commands, should share
+	// functions through the build cache directory.
+	buildCacheDir := t.TempDir()
+	id := &countingIdentifier{}
+	newBuilder := func() *realBuilder {
+		return &realBuilder{
+			functionIdentifier: id,
+			maxConcurrency:     1,
+			functionCache:      SharedFunctionCache(buildCacheDir),
+		}
+	}
+
+	_, err := newBuilder().buildFunctionCached(t.Context(), nil, fnFS, proj, "fn1", "")
+	assert.NilError(t, err)
+	assert.Equal(t, id.builds, 1)
+
+	_, err = newBuilder().buildFunctionCached(t.Context(), nil, fnFS, proj, "fn1", "")
+	assert.NilError(t, err)
+	assert.Equal(t, id.builds, 1)
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package project
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up/internal/xpkg"
+)
+
+// annotationRefName is the OCI annotation that names a manifest in an image
+// layout.
+const annotationRefName = "org.opencontainers.image.ref.name"
+
+// WriteLayout writes the packages in an image map produced by the project
+// builder to OCI image layouts in dir, one per repository at
+// dir/<repository>. Function packages are written as multi-arch indexes built
+// the same way as when pushing, so the configuration's dependencies on its
+// functions match the digests of the indexes in the layouts. Each package is
+// named tag in its layout.
+func WriteLayout(dir string, imgMap ImageTagMap, repo, tag string) error {
+	cfgImage, fnImages, err := SortImages(imgMap, repo)
+	if err != nil {
+		return err
+	}
+
+	for fnRepo, images := range fnImages {
+		idx, _, err := xpkg.BuildIndex(images...)
+		if err != nil {
+			return errors.Wrapf(err, "failed to construct index for function %q", fnRepo)
+		}
+		lp, err := newLayout(dir, fnRepo)
+		if err != nil {
+			return err
+		}
+		if err := lp.AppendIndex(idx, layout.WithAnnotations(map[string]string{annotationRefName: tag})); err != nil {
+			return errors.Wrapf(err, "failed to write function %q", fnRepo)
+		}
+	}
+
+	cfgRepo, err := name.NewRepository(repo)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse repository")
+	}
+	cfgImage, err = xpkg.AnnotateImage(cfgImage)
+	if err != nil {
+		return err
+	}
+	lp, err := newLayout(dir, cfgRepo)
+	if err != nil {
+		return err
+	}
+	if err := lp.AppendImage(cfgImage, layout.WithAnnotations(map[string]string{annotationRefName: tag})); err != nil {
+		return errors.Wrap(err, "failed to write configuration")
+	}
+
+	return nil
+}
+
+// newLayout creates an empty OCI image layout for a repository in dir,
+// replacing any existing index.
+func newLayout(dir string, repo name.Repository) (layout.Path, error) {
+	p := filepath.Join(dir, filepath.FromSlash(repo.RepositoryStr()))
+	if err := os.MkdirAll(p, 0o755); err != nil {
+		return "", errors.Wrapf(err, "failed to create layout directory %q", p)
+	}
+	lp, err := layout.Write(p, empty.Index)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create layout %q", p)
+	}
+	return lp, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package project
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"gotest.tools/v3/assert"
+
+	"github.com/upbound/up/internal/xpkg"
+)
+
+func TestWriteLayout(t *testing.T) {
+	repo := "xpkg.upbound.io/example/example"
+	imgMap := ImageTagMap{
+		mustTag(t, repo+":"+ConfigurationTag):    randomImage(t, "amd64"),
+		mustTag(t, repo+"_fn:amd64"):             randomImage(t, "amd64"),
+		mustTag(t, repo+"_fn:arm64"):             randomImage(t, "arm64"),
+		mustTag(t, repo+"_other-fn:amd64"):       randomImage(t, "amd64"),
+		mustTag(t, repo+"_other-fn:arm64"):       randomImage(t, "arm64"),
+		mustTag(t, repo+"_single-arch-fn:amd64"): randomImage(t, "amd64"),
+	}
+
+	dir := t.TempDir()
+	assert.NilError(t, WriteLayout(dir, imgMap, repo, "v0.1.0"))
+
+	// The configuration is written as an image.
+	cfgIdx, err := layout.ImageIndexFromPath(filepath.Join(dir, "example", "example"))
+	assert.NilError(t, err)
+	cfgManifest, err := cfgIdx.IndexManifest()
+	assert.NilError(t, err)
+	assert.Equal(t, len(cfgManifest.Manifests), 1)
+	assert.Equal(t, cfgManifest.Manifests[0].Annotations[annotationRefName], "v0.1.0")
+	assert.Assert(t, cfgManifest.Manifests[0].MediaType.IsImage())
+
+	// Each function is written as an index containing all its architectures,
+	// matching the index that would be pushed.
+	for fn, archs := range map[string][]string{"fn": {"amd64", "arm64"}, "other-fn": {"amd64", "arm64"}, "single-arch-fn": {"amd64"}} {
+		fnRepo := repo + "_" + fn
+		lIdx, err := layout.ImageIndexFromPath(filepath.Join(dir, "example", "example_"+fn))
+		assert.NilError(t, err)
+		lManifest, err := lIdx.IndexManifest()
+		assert.NilError(t, err)
+		assert.Equal(t, len(lManifest.Manifests), 1)
+		assert.Equal(t, lManifest.Manifests[0].Annotations[annotationRefName], "v0.1.0")
+		assert.Assert(t, lManifest.Manifests[0].MediaType.IsIndex())
+
+		imgs := make([]v1.Image, 0, len(archs))
+		for _, arch := range archs {
+			imgs = append(imgs, imgMap[mustTag(t, fnRepo+":"+arch)])
+		}
+		want, _, err := xpkg.BuildIndex(imgs...)
+		assert.NilError(t, err)
+		wantDigest, err := want.Digest()
+		assert.NilError(t, err)
+		assert.Equal(t, lManifest.Manifests[0].Digest, wantDigest)
+
+		fnIdx, err := lIdx.ImageIndex(wantDigest)
+		assert.NilError(t, err)
+		fnManifest, err := fnIdx.IndexManifest()
+		assert.NilError(t, err)
+		assert.Equal(t, len(fnManifest.Manifests), len(archs))
+		for _, desc := range fnManifest.Manifests {
+			_, err := fnIdx.Image(desc.Digest)
+			assert.NilError(t, err)
+		}
+	}
+}
+
+func mustTag(t *testing.T, ref string) name.Tag {
+	t.Helper()
+
+	tag, err := name.NewTag(ref)
+	assert.NilError(t, err)
+	return tag
+}
+
+func randomImage(t *testing.T, arch string) v1.Image {
+	t.Helper()
+
+	img, err := random.Image(64, 1)
+	assert.NilError(t, err)
+	img, err = mutate.ConfigFile(img, &v1.ConfigFile{OS: "linux", Architecture: arch})
+	assert.NilError(t, err)
+	return img
+}
@@ -6,6 +6,7 @@ package project
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/spf13/afero"
 	"golang.org/x/sync/errgroup"
@@ -59,7 +60,7 @@ type ProcessedAPIDependency struct {
 func NewDependencyManager(upCtx *upbound.Context, proj *v2alpha1.Project, projFS afero.Fs, opts ...ManagerOption) (*DependencyManager, error) {
 	options := &managerOptions{
 		projFile: "upbound.yaml",
-		fetcher:  image.NewLocalFetcher(image.WithKeychain(upCtx.RegistryKeychain())),
+		fetcher:  image.NewLocalFetcher(image.WithKeychain(upCtx.RegistryKeychain()), image.WithTransport(upCtx.Transport())),
 		schemaRunner: runner.NewRealSchemaRunner(
 			runner.WithImageConfig(proj.Spec.ImageConfig),
 		),
@@ -76,6 +77,13 @@ func NewDependencyManager(upCtx *upbound.Context, proj *v2alpha1.Project, projFS
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create xpkg cache")
 	}
+	if options.cacheTTL > 0 {
+		// Pruning is opportunistic; a failure shouldn't prevent managing
+		// dependencies.
+		if err := pruneCache(cch, proj, options.cacheTTL); err != nil {
+			upCtx.Log.Debug("failed to prune xpkg cache", "error", err)
+		}
+	}
 
 	res := image.NewResolver(
 		image.WithImageConfig(proj.Spec.ImageConfig),
@@ -131,6 +139,7 @@ type managerOptions struct {
 	schemaGenerators []generator.Interface
 	schemaRunner     runner.SchemaRunner
 	gitAuthProvider  git.AuthProvider
+	cacheTTL         time.Duration
 }
 
 // ManagerOption configures the dependency manager.
@@ -186,6 +195,40 @@ func WithGitAuthProvider(p git.AuthProvider) ManagerOption {
 	}
 }
 
+// WithCacheTTL prunes entries that haven't been used within the given duration
+// from the xpkg cache when the manager is created. Entries used by the
+// project's dependencies are never pruned.
+func WithCacheTTL(ttl time.Duration) ManagerOption {
+	return func(opts *managerOptions) {
+		opts.cacheTTL = ttl
+	}
+}
+
+// CacheReferences returns the paths of the xpkg cache entries that the
+// project's dependencies may resolve to, including transitive dependencies.
+// These entries should not be pruned from the cache.
+func CacheReferences(cch *cache.Local, proj *v2alpha1.Project) (map[string]bool, error) {
+	if proj == nil || proj.Spec == nil {
+		return nil, nil
+	}
+	deps := make([]v1beta1.Dependency, 0, len(proj.Spec.DependsOn))
+	for _, d := range proj.Spec.DependsOn {
+		if c, ok := dmanager.ConvertToV1beta1(d); ok {
+			deps = append(deps, c)
+		}
+	}
+	return cch.Referenced(deps...)
+}
+
+func pruneCache(cch *cache.Local, proj *v2alpha1.Project, ttl time.Duration) error {
+	keep, err := CacheReferences(cch, proj)
+	if err != nil {
+		return err
+	}
+	_, err = cch.Prune(cache.PruneWithTTL(ttl), cache.PruneWithKeep(keep))
+	return err
+}
+
 // Add adds the given dependency to the project, caching and generating schemas
 // for it and all its transitive dependencies.
 func (m *DependencyManager) Add(ctx context.Context, d pkgmetav1.Dependency) error {
@@ -290,6 +333,24 @@ func (m *DependencyManager) GetParsedPackage(ctx context.Context, dep pkgmetav1.
 	return nil, errors.New("package not found in cache")
 }
 
+// CheckDependency checks that a dependency is in the dependency manager's cache
+// and that schemas for the cached version have been generated. Unlike Add, it
+// never fetches anything.
+func (m *DependencyManager) CheckDependency(ctx context.Context, dep pkgmetav1.Dependency) error {
+	pkg, err := m.GetParsedPackage(ctx, dep)
+	if err != nil {
+		return err
+	}
+	current, err := m.schemas.Current(ctx, smanager.NewXpkgSource(pkg))
+	if err != nil {
+		return errors.Wrap(err, "failed to check schemas")
+	}
+	if !current {
+		return errors.Errorf("schemas for %s are out of date", pkg.Name())
+	}
+	return nil
+}
+
 // AddAPIDependency adds a single API dependency to the project, fetching and generating
 // schemas for it.
 func (m *DependencyManager) AddAPIDependency(ctx context.Context, dep v2alpha1.APIDependencies) error {
@@ -0,0 +1,178 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package project
+
+import (
+	"bufio"
+	"bytes"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+	"github.com/spf13/afero"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// ignoreFileName is the name of the files listing paths, in gitignore syntax,
+// to exclude when building a project. Each directory of a project may have
+// one, and its patterns are relative to that directory.
+const ignoreFileName = ".upignore"
+
+// newIgnoreFS returns a filesystem that hides the paths of the given project
+// filesystem that are matched by the project's ignore files, along with the
+// ignore files themselves. It returns the given filesystem if the project has
+// no ignore files.
+func newIgnoreFS(projFS afero.Fs) (afero.Fs, error) {
+	ps, err := readIgnorePatterns(projFS, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read ignore files")
+	}
+	if len(ps) == 0 {
+		return projFS, nil
+	}
+	return &ignoreFS{Fs: projFS, m: gitignore.NewMatcher(ps)}, nil
+}
+
+// readIgnorePatterns reads the patterns from the ignore file in the given
+// directory and its subdirectories, skipping directories that are already
+// ignored. Patterns are returned in ascending order of priority.
+func readIgnorePatterns(fsys afero.Fs, dir []string, ps []gitignore.Pattern) ([]gitignore.Pattern, error) {
+	d := "/" + path.Join(dir...)
+
+	bs, err := afero.ReadFile(fsys, path.Join(d, ignoreFileName))
+	switch {
+	case err == nil:
+		s := bufio.NewScanner(bytes.NewReader(bs))
+		for s.Scan() {
+			line := strings.TrimSuffix(s.Text(), "\r")
+			if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
+				continue
+			}
+			ps = append(ps, gitignore.ParsePattern(line, dir))
+		}
+	case !errors.Is(err, fs.ErrNotExist):
+		return nil, errors.Wrapf(err, "failed to read %q", path.Join(d, ignoreFileName))
+	}
+
+	infos, err := afero.ReadDir(fsys, d)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read directory %q", d)
+	}
+	for _, info := range infos {
+		if !info.IsDir() {
+			continue
+		}
+		sub := append(slices.Clone(dir), info.Name())
+		if gitignore.NewMatcher(ps).Match(sub, true) {
+			continue
+		}
+		ps, err = readIgnorePatterns(fsys, sub, ps)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ps, nil
+}
+
+// ignoreFS hides ignored paths of the underlying filesystem from reads. Writes
+// are passed through unchanged.
+type ignoreFS struct {
+	afero.Fs
+
+	m gitignore.Matcher
+}
+
+// ignored returns true if the given path, or any of its parent directories, is
+// ignored.
+func (f *ignoreFS) ignored(name string, isDir bool) bool {
+	p := strings.Trim(path.Clean(filepath.ToSlash(name)), "/")
+	if p == "" || p == "." {
+		return false
+	}
+	parts := strings.Split(p, "/")
+	if !isDir && parts[len(parts)-1] == ignoreFileName {
+		return true
+	}
+	for i := 1; i < len(parts); i++ {
+		if f.m.Match(parts[:i], true) {
+			return true
+		}
+	}
+	return f.m.Match(parts, isDir)
+}
+
+func (f *ignoreFS) Name() string {
+	return "ignoreFS"
+}
+
+func (f *ignoreFS) Stat(name string) (fs.FileInfo, error) {
+	info, err := f.Fs.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	if f.ignored(name, info.IsDir()) {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrNotExist}
+	}
+	return info, nil
+}
+
+func (f *ignoreFS) Open(name string) (afero.File, error) {
+	if _, err := f.Stat(name); err != nil {
+		return nil, err
+	}
+	file, err := f.Fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return &ignoreFile{File: file, fs: f, name: name}, nil
+}
+
+func (f *ignoreFS) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	// Only existing files are hidden, so that ignored paths can still be
+	// created.
+	if flag&os.O_CREATE == 0 {
+		if _, err := f.Stat(name); err != nil {
+			return nil, err
+		}
+	}
+	file, err := f.Fs.OpenFile(name, flag, perm)
+	if err != nil {
+		return nil, err
+	}
+	return &ignoreFile{File: file, fs: f, name: name}, nil
+}
+
+// ignoreFile hides ignored paths from directory listings.
+type ignoreFile struct {
+	afero.File
+
+	fs   *ignoreFS
+	name string
+}
+
+func (f *ignoreFile) Readdir(count int) ([]fs.FileInfo, error) {
+	infos, err := f.File.Readdir(count)
+	kept := make([]fs.FileInfo, 0, len(infos))
+	for _, info := range infos {
+		if !f.fs.ignored(path.Join(filepath.ToSlash(f.name), info.Name()), info.IsDir()) {
+			kept = append(kept, info)
+		}
+	}
+	return kept, err
+}
+
+func (f *ignoreFile) Readdirnames(n int) ([]string, error) {
+	infos, err := f.Readdir(n)
+	names := make([]string, 0, len(infos))
+	for _, info := range infos {
+		names = append(names, info.Name())
+	}
+	return names, err
+}
@@ -0,0 +1,172 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package project
+
+import (
+	"fmt"
+	"io/fs"
+	"net/url"
+	"slices"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/spf13/afero"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/upbound/up/internal/upbound"
+	"github.com/upbound/up/internal/xpkg"
+	xpkgmarshaler "github.com/upbound/up/internal/xpkg/dep/marshaler/xpkg"
+	"github.com/upbound/up/internal/xpkg/functions"
+	"github.com/upbound/up/pkg/apis/project/v2alpha1"
+)
+
+const ignoreTestComposition = `apiVersion: apiextensions.crossplane.io/v1
+kind: Composition
+metadata:
+  name: %s
+spec:
+  compositeTypeRef:
+    apiVersion: example.org/v1alpha1
+    kind: XNetwork
+  mode: Pipeline
+`
+
+// ignoreTestProject returns a project filesystem with nested ignore files.
+func ignoreTestProject(t *testing.T) afero.Fs {
+	t.Helper()
+
+	files := map[string]string{
+		"/.upignore":                      "# Scratch space.\nscratch/\n*.swp\nfunctions/fn-wip/\n",
+		"/scratch/composition.yaml":       composition("scratch"),
+		"/apis/.upignore":                 "draft.yaml\n",
+		"/apis/xnetwork/composition.yaml": composition("xnetworks"),
+		"/apis/xnetwork/draft.yaml":       composition("draft"),
+		"/apis/xnetwork/.composition.swp": "swap",
+		"/functions/fn1/main.py":          "print('hello')",
+		"/functions/fn1/.upignore":        "*.log\n",
+		"/functions/fn1/build.log":        "log",
+		"/functions/fn-wip/main.py":       "print('wip')",
+	}
+
+	// Project paths are relative, so root the project for them to resolve.
+	projFS := afero.NewBasePathFs(afero.NewMemMapFs(), "/")
+	for p, content := range files {
+		assert.NilError(t, afero.WriteFile(projFS, p, []byte(content), 0o644))
+	}
+	return projFS
+}
+
+func composition(name string) string {
+	return fmt.Sprintf(ignoreTestComposition, name)
+}
+
+func TestIgnoreFS(t *testing.T) {
+	ignFS, err := newIgnoreFS(ignoreTestProject(t))
+	assert.NilError(t, err)
+
+	var got []string
+	err = afero.Walk(ignFS, "/", func(p string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			got = append(got, p)
+		}
+		return nil
+	})
+	assert.NilError(t, err)
+
+	want := []string{
+		"/apis/xnetwork/composition.yaml",
+		"/functions/fn1/main.py",
+	}
+	slices.Sort(got)
+	assert.DeepEqual(t, got, want)
+
+	// Ignored files can't be opened directly either.
+	_, err = ignFS.Open("/scratch/composition.yaml")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	_, err = ignFS.Stat("/functions/fn1/build.log")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestIgnoreFSNoIgnoreFiles(t *testing.T) {
+	projFS := afero.NewMemMapFs()
+	assert.NilError(t, afero.WriteFile(projFS, "/apis/composition.yaml", []byte(composition("xnetworks")), 0o644))
+
+	ignFS, err := newIgnoreFS(projFS)
+	assert.NilError(t, err)
+	assert.Equal(t, ignFS, projFS)
+}
+
+func TestBuildIgnoredFiles(t *testing.T) {
+	projFS := ignoreTestProject(t)
+	proj := &v2alpha1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		Spec: &v2alpha1.ProjectSpec{
+			Repository:    "xpkg.upbound.io/example/example",
+			Architectures: []string{"amd64"},
+		},
+	}
+	proj.Default()
+
+	ep, err := url.Parse("https://donotuse.example.com")
+	assert.NilError(t, err)
+	upCtx := &upbound.Context{
+		Domain:           &url.URL{},
+		RegistryEndpoint: ep,
+	}
+	m, err := NewDependencyManager(upCtx, proj, projFS,
+		WithCacheFS(afero.NewMemMapFs()),
+		WithSchemaGenerators(nil),
+	)
+	assert.NilError(t, err)
+
+	b := NewBuilder(
+		BuildWithMaxConcurrency(1),
+		BuildWithFunctionIdentifier(functions.FakeIdentifier),
+	)
+	imgMap, err := b.Build(t.Context(), upCtx, proj, projFS, BuildWithDependencyManager(m))
+	assert.NilError(t, err)
+
+	// The ignored function shouldn't be built.
+	var repos []string
+	for tag := range imgMap {
+		repos = append(repos, tag.Repository.String())
+	}
+	slices.Sort(repos)
+	assert.DeepEqual(t, repos, []string{
+		"xpkg.upbound.io/example/example",
+		"xpkg.upbound.io/example/example_fn1",
+	})
+
+	// Ignored Compositions shouldn't be in the configuration package.
+	var cfgImage v1.Image
+	for tag, img := range imgMap {
+		if tag.TagStr() == ConfigurationTag {
+			cfgImage = img
+		}
+	}
+	cfgFile, err := cfgImage.ConfigFile()
+	assert.NilError(t, err)
+	cfgImage, err = mutate.Config(cfgImage, cfgFile.Config)
+	assert.NilError(t, err)
+	cfgImage, err = xpkg.AnnotateImage(cfgImage)
+	assert.NilError(t, err)
+
+	marshaler, err := xpkgmarshaler.NewMarshaler()
+	assert.NilError(t, err)
+	pkg, err := marshaler.FromImage(xpkg.Image{Image: cfgImage})
+	assert.NilError(t, err)
+
+	var names []string
+	for _, obj := range pkg.Objects() {
+		mo, ok := obj.(metav1.Object)
+		assert.Assert(t, ok)
+		names = append(names, mo.GetName())
+	}
+	assert.DeepEqual(t, names, []string{"xnetworks"})
+}
@@ -135,16 +135,35 @@ func embeddedFunctionsToDaemon(ctx context.Context, imageMap project.ImageTagMap
 
 // BuildEmbeddedFunctionsLocalDaemon build and push to local deamon.
 func BuildEmbeddedFunctionsLocalDaemon(ctx context.Context, upCtx *upbound.Context, opts FunctionOptions) ([]pkgv1.Function, error) {
-	b := project.NewBuilder(
+	bopts := []project.BuilderOption{
 		project.BuildWithMaxConcurrency(opts.Concurrency),
 		project.BuildWithFunctionIdentifier(opts.FunctionIdentifier),
-	)
+	}
+	switch {
+	case opts.NoBuildCache:
+	case opts.FunctionBuildCacheDir != "":
+		bopts = append(bopts, project.BuildWithFunctionCache(project.NewFunctionCache(opts.FunctionBuildCacheDir)))
+	case opts.BuildCacheDir != "":
+		bopts = append(bopts, project.BuildWithFunctionCache(project.SharedFunctionCache(opts.BuildCacheDir)))
+	}
+	b := project.NewBuilder(bopts...)
 
-	imgMap, err := b.Build(ctx, upCtx, opts.Project, opts.ProjFS,
-		project.BuildWithEventChannel(opts.EventChannel),
-		project.BuildWithImageLabels(common.ImageLabels(opts)),
-		project.BuildWithDependencyManager(opts.DependencyManager),
+	var (
+		imgMap project.ImageTagMap
+		err    error
 	)
+	if opts.FunctionName != "" {
+		imgMap, err = b.BuildFunction(ctx, upCtx, opts.Project, opts.ProjFS, opts.FunctionName,
+			project.BuildWithEventChannel(opts.EventChannel),
+			project.BuildWithDependencyManager(opts.DependencyManager),
+		)
+	} else {
+		imgMap, err = b.Build(ctx, upCtx, opts.Project, opts.ProjFS,
+			project.BuildWithEventChannel(opts.EventChannel),
+			project.BuildWithImageLabels(common.ImageLabels(opts)),
+			project.BuildWithDependencyManager(opts.DependencyManager),
+		)
+	}
 	if err != nil {
 		return nil, err
 	}
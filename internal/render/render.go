@@ -72,12 +72,20 @@ type FunctionOptions struct {
 
 	Concurrency uint
 
-	NoBuildCache       bool
-	BuildCacheDir      string
-	ImageResolver      manager.ImageResolver
-	FunctionIdentifier functions.Identifier
-	DependencyManager  *project.DependencyManager
-	EventChannel       async.EventChannel
+	NoBuildCache  bool
+	BuildCacheDir string
+	// FunctionBuildCacheDir is the directory in which to cache built embedded
+	// functions. The shared function cache in BuildCacheDir is used if it is
+	// empty. Caching is disabled if NoBuildCache is set.
+	FunctionBuildCacheDir string
+	ImageResolver         manager.ImageResolver
+	FunctionIdentifier    functions.Identifier
+	DependencyManager     *project.DependencyManager
+	EventChannel          async.EventChannel
+
+	// FunctionName is the name of the only embedded function to build. All
+	// embedded functions are built if it is empty.
+	FunctionName string
 }
 
 // Render executes the rendering logic and returns YAML output as a string.
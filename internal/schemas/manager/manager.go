@@ -37,30 +37,38 @@ type Manager struct {
 // Add ensures schemas for resources in the given source are present in the
 // managed directory.
 func (m *Manager) Add(ctx context.Context, source Source) error {
-	version, err := source.Version(ctx)
+	current, err := m.Current(ctx, source)
 	if err != nil {
 		return err
 	}
+	if current {
+		// Current version schemas are already present, no need to regenerate.
+		return nil
+	}
+
+	_, err = m.Generate(ctx, source)
+	return err
+}
+
+// Current reports whether schemas for the current version of the given source
+// are present in the managed directory.
+func (m *Manager) Current(ctx context.Context, source Source) (bool, error) {
+	version, err := source.Version(ctx)
+	if err != nil {
+		return false, err
+	}
 
 	existing, err := m.currentVersion(source.ID())
 	if err != nil {
-		return err
+		return false, err
 	}
-	if existing == version {
-		// Version matches; also verify the generated files are still on disk.
-		// Models may have been deleted without the lock being updated.
-		intact, err := m.sourceFilesIntact(source.ID())
-		if err != nil {
-			return err
-		}
-		if intact {
-			// Current version schemas are already present, no need to regenerate.
-			return nil
-		}
+	if existing != version {
+		return false, nil
 	}
 
-	_, err = m.Generate(ctx, source)
-	return err
+	// Version matches; also verify the generated files are still on disk.
+	// Models may have been deleted without the lock being updated.
+	return m.sourceFilesIntact(source.ID())
 }
 
 // Generate generates and returns schemas using the manager's generators, and
@@ -20,7 +20,8 @@ import (
 )
 
 // ErrSpaceConnection is an error returned when the connection to the space,
-// through the connect API, fails.
+// through the connect API, fails. Errors returned for a failed connection wrap
+// both ErrSpaceConnection and the underlying error.
 var ErrSpaceConnection = errors.New("failed to connect to space through the API client")
 
 // SpaceIngress represents an ingress configuration for a space with host and CA data.
@@ -65,12 +66,12 @@ func (c *configMapReader) Get(ctx context.Context, space v1alpha1.Space) (*Space
 
 	connectClient, err := client.New(cfg, client.Options{})
 	if err != nil {
-		return nil, ErrSpaceConnection
+		return nil, errors.Errorf("%w: %w", ErrSpaceConnection, err)
 	}
 
 	var ingressPublic corev1.ConfigMap
 	if err := connectClient.Get(ctx, types.NamespacedName{Namespace: "upbound-system", Name: "ingress-public"}, &ingressPublic); err != nil {
-		return nil, ErrSpaceConnection
+		return nil, errors.Errorf("%w: %w", ErrSpaceConnection, err)
 	}
 
 	host, ok := ingressPublic.Data["ingress-host"]
@@ -159,3 +160,11 @@ func (c *IngressCache) Get(ctx context.Context, space v1alpha1.Space) (*SpaceIng
 
 	return ingress, nil
 }
+
+// Invalidate clears the cache, so that subsequent lookups are delegated to the
+// wrapped IngressReader.
+func (c *IngressCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.ingresses)
+}
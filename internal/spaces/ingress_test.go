@@ -0,0 +1,55 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package spaces
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/upbound/up-sdk-go/apis/upbound/v1alpha1"
+)
+
+type countingReader struct {
+	host  string
+	calls int
+}
+
+func (r *countingReader) Get(_ context.Context, _ v1alpha1.Space) (*SpaceIngress, error) {
+	r.calls++
+	return &SpaceIngress{Host: r.host}, nil
+}
+
+func TestIngressCacheInvalidate(t *testing.T) {
+	space := v1alpha1.Space{}
+	space.SetName("my-space")
+	space.SetNamespace("my-org")
+
+	r := &countingReader{host: "old.example.com"}
+	c := NewCachedReader(r)
+
+	get := func() string {
+		t.Helper()
+		ingress, err := c.Get(context.Background(), space)
+		if err != nil {
+			t.Fatalf("Get(...): unexpected error: %v", err)
+		}
+		return ingress.Host
+	}
+
+	get()
+	r.host = "new.example.com"
+	if diff := cmp.Diff("old.example.com", get()); diff != "" {
+		t.Errorf("Get(...): cached ingress: -want, +got:\n%s", diff)
+	}
+
+	c.Invalidate()
+	if diff := cmp.Diff("new.example.com", get()); diff != "" {
+		t.Errorf("Get(...): after Invalidate(): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(2, r.calls); diff != "" {
+		t.Errorf("wrapped reader calls: -want, +got:\n%s", diff)
+	}
+}
@@ -404,7 +404,7 @@ func (b *realBuilder) Build(ctx context.Context, fs afero.Fs, patterns []string,
 		opt(&buildOpts)
 	}
 
-	testDirs, err := discoverTestDirectories(fs, patterns, testsFolder)
+	testDirs, err := DiscoverTestDirectories(fs, patterns, testsFolder)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to discover test directories")
 	}
@@ -495,7 +495,9 @@ func (b *realBuilder) Build(ctx context.Context, fs afero.Fs, patterns []string,
 	return results, nil
 }
 
-func discoverTestDirectories(fs afero.Fs, patterns []string, testsFolder string) ([]string, error) {
+// DiscoverTestDirectories returns the directories in fs that match any of the
+// given glob patterns. Patterns may be prefixed with the tests folder.
+func DiscoverTestDirectories(fs afero.Fs, patterns []string, testsFolder string) ([]string, error) {
 	var matchedDirs []string
 
 	cleanedPatterns := make([]string, len(patterns))
@@ -11,7 +11,7 @@ import (
 	"gotest.tools/v3/assert"
 )
 
-// TestDiscoverTestDirectories verifies that discoverTestDirectories correctly finds directories matching glob patterns.
+// TestDiscoverTestDirectories verifies that DiscoverTestDirectories correctly finds directories matching glob patterns.
 func TestDiscoverTestDirectories(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -92,7 +92,7 @@ func TestDiscoverTestDirectories(t *testing.T) {
 			fs := afero.NewMemMapFs()
 			tt.setupFs(fs)
 
-			dirs, err := discoverTestDirectories(fs, tt.patterns, tt.testsFolder)
+			dirs, err := DiscoverTestDirectories(fs, tt.patterns, tt.testsFolder)
 
 			if tt.expectErr {
 				assert.Assert(t, err != nil)
@@ -0,0 +1,9 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+// Package tview contains reusable components for terminal UIs built with
+// tview. Dialogs are in the dialogs package, and views such as the top level
+// frame and the searchable table are in the views package. Components that
+// are useful to more than one command belong here rather than with the
+// command.
+package tview
@@ -0,0 +1,43 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package style
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// StatusBar configures the colors of a status bar. Colors set to
+// tcell.ColorDefault are not styled.
+type StatusBar struct {
+	Background tcell.Color
+	Branding   tcell.Color
+	// Breadcrumb is the color of the current breadcrumb, InactiveBreadcrumb
+	// the color of its parents.
+	Breadcrumb         tcell.Color
+	InactiveBreadcrumb tcell.Color
+	Key                tcell.Color
+	Description        tcell.Color
+}
+
+var (
+	// DefaultStatusBar is the status bar style for pretty output.
+	DefaultStatusBar = StatusBar{
+		Background:         BottomKeys,
+		Branding:           UpboundHeader,
+		Breadcrumb:         tcell.ColorWhite,
+		InactiveBreadcrumb: tcell.NewRGBColor(154, 156, 167),
+		Key:                tcell.ColorLightGray,
+		Description:        tcell.ColorWhite,
+	}
+
+	// PlainStatusBar renders a status bar without colors.
+	PlainStatusBar = StatusBar{
+		Background:         tcell.ColorDefault,
+		Branding:           tcell.ColorDefault,
+		Breadcrumb:         tcell.ColorDefault,
+		InactiveBreadcrumb: tcell.ColorDefault,
+		Key:                tcell.ColorDefault,
+		Description:        tcell.ColorDefault,
+	}
+)
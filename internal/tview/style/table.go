@@ -0,0 +1,14 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package style
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+var (
+	TableHeaderFg   = tcell.ColorDarkGray
+	TableSelectedBg = tcell.NewRGBColor(98, 0, 140)
+	TableSelectedFg = tcell.ColorWhite
+)
@@ -0,0 +1,111 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/upbound/up/internal/tview/style"
+)
+
+// KeyHint is a key binding shown in a StatusBar.
+type KeyHint struct {
+	Key         string
+	Description string
+}
+
+// StatusBar is a single line footer. It shows the Upbound branding and the
+// breadcrumbs of the current context on the left, and key hints on the right.
+// Hints that don't fit the width are dropped from the end.
+type StatusBar struct {
+	*tview.Box
+
+	breadcrumbs []string
+	hints       []KeyHint
+	style       style.StatusBar
+}
+
+// NewStatusBar returns a status bar with the default style.
+func NewStatusBar() *StatusBar {
+	return &StatusBar{
+		Box:   tview.NewBox(),
+		style: style.DefaultStatusBar,
+	}
+}
+
+// SetBreadcrumbs sets the path of the current context, e.g. organization,
+// space, group and control plane.
+func (s *StatusBar) SetBreadcrumbs(breadcrumbs ...string) *StatusBar {
+	s.breadcrumbs = breadcrumbs
+	return s
+}
+
+// SetHints sets the key hints.
+func (s *StatusBar) SetHints(hints ...KeyHint) *StatusBar {
+	s.hints = hints
+	return s
+}
+
+// SetStyle sets the colors of the status bar. Use style.PlainStatusBar when
+// output shouldn't be styled.
+func (s *StatusBar) SetStyle(st style.StatusBar) *StatusBar {
+	s.style = st
+	return s
+}
+
+// Draw draws the status bar on the first line of its rect.
+func (s *StatusBar) Draw(screen tcell.Screen) {
+	x, y, w, _ := s.GetRect()
+	for i := range w {
+		screen.SetContent(x+i, y, ' ', nil, tcell.StyleDefault.Background(s.style.Background))
+	}
+
+	left := s.left()
+	lw := tview.TaggedStringWidth(left)
+	tview.Print(screen, left, x+1, y, w-1, tview.AlignLeft, tcell.ColorDefault)
+
+	// Drop hints from the end until they fit next to the breadcrumbs.
+	for n := len(s.hints); n > 0; n-- {
+		right := s.right(s.hints[:n])
+		if lw+tview.TaggedStringWidth(right)+3 <= w { //nolint:mnd // Margins and a space between both sides.
+			tview.Print(screen, right, x, y, w-1, tview.AlignRight, tcell.ColorDefault)
+			return
+		}
+	}
+}
+
+func (s *StatusBar) left() string {
+	var b strings.Builder
+	b.WriteString(colorize(s.style.Branding, "Upbound"))
+	if len(s.breadcrumbs) == 0 {
+		return b.String()
+	}
+	b.WriteString(" ")
+	if len(s.breadcrumbs) > 1 {
+		b.WriteString(colorize(s.style.InactiveBreadcrumb, strings.Join(s.breadcrumbs[:len(s.breadcrumbs)-1], "/")+"/"))
+	}
+	b.WriteString(colorize(s.style.Breadcrumb, s.breadcrumbs[len(s.breadcrumbs)-1]))
+	return b.String()
+}
+
+func (s *StatusBar) right(hints []KeyHint) string {
+	parts := make([]string, 0, len(hints))
+	for _, h := range hints {
+		parts = append(parts, colorize(s.style.Key, h.Key)+" "+colorize(s.style.Description, h.Description))
+	}
+	return strings.Join(parts, "  ")
+}
+
+// colorize wraps s in a tview color tag, unless c is the default color.
+func colorize(c tcell.Color, s string) string {
+	s = tview.Escape(s)
+	if c == tcell.ColorDefault {
+		return s
+	}
+	return fmt.Sprintf("[#%06x]%s[-]", c.Hex(), s)
+}
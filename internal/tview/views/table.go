@@ -0,0 +1,274 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package views
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/upbound/up/internal/tview/style"
+)
+
+// TableColumn describes a column of a Table.
+type TableColumn struct {
+	// Title is shown in the header row.
+	Title string
+	// Expansion is the share of the remaining width the column gets. Columns
+	// with zero expansion are as wide as their content.
+	Expansion int
+	// Less orders two values of the column when sorting by it. Values are
+	// compared as strings if nil.
+	Less func(a, b string) bool
+}
+
+// TableRow is a row of a Table.
+type TableRow struct {
+	// Cells are the values of the row, one per column.
+	Cells []string
+	// Reference is passed back when the row is selected.
+	Reference any
+}
+
+// TableRowProvider returns the rows of a Table. It's called whenever the table
+// is refreshed.
+type TableRowProvider func() []TableRow
+
+// Table is a keyboard-navigable table that can be searched and sorted.
+//
+// Rows are navigated with the arrow keys and selected with enter. Typing '/'
+// opens a search field which filters the rows incrementally to those with a
+// cell containing the search text. Typing a column number sorts the rows by
+// that column, and typing it again reverses the order. Escape clears the
+// search, or calls the done function if there is none.
+type Table struct {
+	*tview.Flex
+
+	app    *tview.Application
+	table  *tview.Table
+	search *tview.InputField
+
+	columns  []TableColumn
+	provider TableRowProvider
+
+	rows       []TableRow
+	visible    []TableRow
+	query      string
+	sortColumn int
+	sortDesc   bool
+
+	selected func(row TableRow)
+	done     func()
+}
+
+// NewTable returns a table with the given columns, whose rows are returned by
+// the provider. Call Refresh to load the rows.
+func NewTable(app *tview.Application, columns []TableColumn, provider TableRowProvider) *Table {
+	t := &Table{
+		Flex:       tview.NewFlex().SetDirection(tview.FlexRow),
+		app:        app,
+		columns:    columns,
+		provider:   provider,
+		sortColumn: -1,
+	}
+
+	t.table = tview.NewTable().
+		SetSelectable(true, false).
+		SetFixed(1, 0).
+		SetSelectedStyle(tcell.StyleDefault.Background(style.TableSelectedBg).Foreground(style.TableSelectedFg))
+	t.table.SetSelectedFunc(func(row, _ int) {
+		if t.selected != nil && row > 0 && row <= len(t.visible) {
+			t.selected(t.visible[row-1])
+		}
+	})
+	t.table.SetInputCapture(t.tableInputCapture)
+
+	t.search = tview.NewInputField().
+		SetLabel("/").
+		SetFieldBackgroundColor(tcell.ColorDefault)
+	t.search.SetChangedFunc(func(text string) {
+		t.query = text
+		t.render()
+	})
+	t.search.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEscape {
+			t.search.SetText("")
+			t.query = ""
+			t.render()
+		}
+		t.showSearch(t.query != "")
+		t.app.SetFocus(t.table)
+	})
+
+	t.Flex.
+		AddItem(t.table, 0, 1, true).
+		AddItem(t.search, 0, 0, false)
+
+	return t
+}
+
+// SetSelectedFunc sets the function called when a row is selected with enter.
+func (t *Table) SetSelectedFunc(handler func(row TableRow)) *Table {
+	t.selected = handler
+	return t
+}
+
+// SetDoneFunc sets the function called when escape is pressed without an
+// active search.
+func (t *Table) SetDoneFunc(handler func()) *Table {
+	t.done = handler
+	return t
+}
+
+// SortBy sorts the rows by the given column, in descending order if desc is
+// true. A negative column keeps the order of the provider.
+func (t *Table) SortBy(column int, desc bool) *Table {
+	t.sortColumn = column
+	t.sortDesc = desc
+	t.render()
+	return t
+}
+
+// Refresh reloads the rows from the provider.
+func (t *Table) Refresh() *Table {
+	t.rows = t.provider()
+	t.render()
+	return t
+}
+
+// Focus is called when this primitive receives focus.
+func (t *Table) Focus(delegate func(p tview.Primitive)) {
+	delegate(t.table)
+}
+
+func (t *Table) tableInputCapture(event *tcell.EventKey) *tcell.EventKey {
+	switch event.Key() { //nolint:exhaustive // other keys are handled by the table.
+	case tcell.KeyEscape:
+		if t.query != "" {
+			t.search.SetText("")
+			t.query = ""
+			t.showSearch(false)
+			t.render()
+			return nil
+		}
+		if t.done != nil {
+			t.done()
+		}
+		return nil
+	case tcell.KeyRune:
+		switch r := event.Rune(); {
+		case r == '/':
+			t.showSearch(true)
+			t.app.SetFocus(t.search)
+			return nil
+		case r >= '1' && r <= '9':
+			col := int(r - '1')
+			if col >= len(t.columns) {
+				return nil
+			}
+			t.SortBy(col, col == t.sortColumn && !t.sortDesc)
+			return nil
+		}
+	}
+	return event
+}
+
+func (t *Table) showSearch(show bool) {
+	height := 0
+	if show {
+		height = 1
+	}
+	t.ResizeItem(t.search, height, 0)
+}
+
+// render redraws the table from the rows, keeping the selected row if it's
+// still visible.
+func (t *Table) render() {
+	var ref any
+	if row, _ := t.table.GetSelection(); row > 0 && row <= len(t.visible) {
+		ref = t.visible[row-1].Reference
+	}
+
+	t.visible = visibleRows(t.rows, t.columns, t.query, t.sortColumn, t.sortDesc)
+
+	t.table.Clear()
+	for c, col := range t.columns {
+		title := col.Title
+		switch {
+		case c == t.sortColumn && t.sortDesc:
+			title += " ▼"
+		case c == t.sortColumn:
+			title += " ▲"
+		}
+		t.table.SetCell(0, c, tview.NewTableCell(fmt.Sprintf("[::b]%s", title)).
+			SetTextColor(style.TableHeaderFg).
+			SetExpansion(col.Expansion).
+			SetSelectable(false))
+	}
+
+	sel := 1
+	for r, row := range t.visible {
+		for c := range t.columns {
+			var text string
+			if c < len(row.Cells) {
+				text = row.Cells[c]
+			}
+			t.table.SetCell(r+1, c, tview.NewTableCell(tview.Escape(text)).
+				SetExpansion(t.columns[c].Expansion))
+		}
+		if ref != nil && row.Reference == ref {
+			sel = r + 1
+		}
+	}
+	if len(t.visible) > 0 {
+		t.table.Select(sel, 0)
+	}
+}
+
+// visibleRows returns the rows with a cell containing the query, ignoring
+// case, sorted by the given column. A negative column keeps the order of the
+// rows.
+func visibleRows(rows []TableRow, columns []TableColumn, query string, sortColumn int, desc bool) []TableRow {
+	query = strings.ToLower(query)
+	out := make([]TableRow, 0, len(rows))
+	for _, row := range rows {
+		if query == "" || slices.ContainsFunc(row.Cells, func(c string) bool {
+			return strings.Contains(strings.ToLower(c), query)
+		}) {
+			out = append(out, row)
+		}
+	}
+
+	if sortColumn < 0 || sortColumn >= len(columns) {
+		return out
+	}
+	less := columns[sortColumn].Less
+	if less == nil {
+		less = func(a, b string) bool { return a < b }
+	}
+	cell := func(row TableRow) string {
+		if sortColumn < len(row.Cells) {
+			return row.Cells[sortColumn]
+		}
+		return ""
+	}
+	slices.SortStableFunc(out, func(a, b TableRow) int {
+		ca, cb := cell(a), cell(b)
+		if desc {
+			ca, cb = cb, ca
+		}
+		switch {
+		case less(ca, cb):
+			return -1
+		case less(cb, ca):
+			return 1
+		default:
+			return 0
+		}
+	})
+	return out
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package views
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestVisibleRows(t *testing.T) {
+	byNumber := func(a, b string) bool {
+		ia, _ := strconv.Atoi(a)
+		ib, _ := strconv.Atoi(b)
+		return ia < ib
+	}
+	columns := []TableColumn{{Title: "NAME"}, {Title: "COUNT", Less: byNumber}}
+	rows := []TableRow{
+		{Cells: []string{"beta", "10"}, Reference: "b"},
+		{Cells: []string{"alpha", "9"}, Reference: "a"},
+		{Cells: []string{"Gamma", "100"}, Reference: "g"},
+	}
+
+	type args struct {
+		query      string
+		sortColumn int
+		desc       bool
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   []any
+	}{
+		"Unsorted": {
+			reason: "Without a query or sort column all rows should be returned in order.",
+			args:   args{sortColumn: -1},
+			want:   []any{"b", "a", "g"},
+		},
+		"Search": {
+			reason: "Only rows with a cell containing the query, ignoring case, should be returned.",
+			args:   args{query: "GA", sortColumn: -1},
+			want:   []any{"g"},
+		},
+		"SearchNoMatch": {
+			reason: "No rows should be returned if none contain the query.",
+			args:   args{query: "delta", sortColumn: -1},
+		},
+		"SortAsString": {
+			reason: "Rows should be sorted by the string value of a column without a Less function.",
+			args:   args{sortColumn: 0},
+			want:   []any{"g", "a", "b"},
+		},
+		"SortWithLess": {
+			reason: "Rows should be sorted with the Less function of the column.",
+			args:   args{sortColumn: 1},
+			want:   []any{"a", "b", "g"},
+		},
+		"SortDescending": {
+			reason: "Rows should be sorted in reverse order when descending.",
+			args:   args{sortColumn: 1, desc: true},
+			want:   []any{"g", "b", "a"},
+		},
+		"SearchAndSort": {
+			reason: "Filtered rows should be sorted.",
+			args:   args{query: "a", sortColumn: 1, desc: true},
+			want:   []any{"g", "b", "a"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := visibleRows(rows, columns, tc.args.query, tc.args.sortColumn, tc.args.desc)
+			refs := make([]any, 0, len(got))
+			for _, r := range got {
+				refs = append(refs, r.Reference)
+			}
+			if diff := cmp.Diff(tc.want, refs, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nvisibleRows(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -22,6 +22,8 @@ type TopLevel struct {
 	SubTitles []GridTitle
 	Commands  []string
 	Error     func() error
+	// StatusBar is drawn on the bottom line, with the commands as key hints.
+	StatusBar *StatusBar
 
 	delegate func(event *tcell.EventKey, setFocus func(p tview.Primitive)) bool
 
@@ -35,7 +37,8 @@ func NewTopLevel(title string, grid *tview.Grid, app *tview.Application) *TopLev
 		Titles: []GridTitle{
 			{Col: 0, Row: 0, Text: fmt.Sprintf(" [::b]%s ", title), Color: style.UpboundHeader, Align: tview.AlignCenter},
 		},
-		Commands: []string{"", "", "", "", "", "", "", "", "", "Quit"},
+		Commands:  []string{"", "", "", "", "", "", "", "", "", "Quit"},
+		StatusBar: NewStatusBar(),
 	}
 
 	return tl
@@ -187,12 +190,14 @@ func (t *TopLevel) Draw(screen tcell.Screen) { //nolint:gocyclo // draw methods
 	}
 
 	// draw F1-F10 hints
-	w, h := screen.Size()
-	for x := range w {
-		screen.SetCell(x, h-1, tcell.StyleDefault.Background(style.BottomKeys), ' ')
-	}
-	for i := range 10 {
-		text := fmt.Sprintf("[lightgray::]F%d[-] %s", i+1, t.Commands[i])
-		tview.Print(screen, text, w/10*i, h-1, w/10, tview.AlignLeft, tcell.ColorWhite)
+	hints := make([]KeyHint, 0, len(t.Commands))
+	for i, cmd := range t.Commands {
+		if cmd != "" {
+			hints = append(hints, KeyHint{Key: fmt.Sprintf("F%d", i+1), Description: cmd})
+		}
 	}
+	w, h := screen.Size()
+	t.StatusBar.SetHints(hints...)
+	t.StatusBar.SetRect(0, h-1, w, 1)
+	t.StatusBar.Draw(screen)
 }
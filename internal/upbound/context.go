@@ -83,6 +83,10 @@ type Context struct {
 	AccountsEndpoint      *url.URL
 	InsecureSkipTLSVerify bool
 
+	// Proxy is the proxy to use for HTTP(S) requests. If nil, the proxy is
+	// determined from the environment.
+	Proxy *url.URL
+
 	// Logging
 	Log        xplogging.Logger
 	DebugLevel int
@@ -92,6 +96,7 @@ type Context struct {
 	cfgPath             string
 	fs                  afero.Fs
 	zl                  logr.Logger
+	logOptions          LogOptions
 }
 
 // Option modifies a Context.
@@ -129,9 +134,18 @@ func NewFromFlags(f Flags, opts ...Option) (*Context, error) {
 		o(c)
 	}
 
+	// setup logging
+	c.DebugLevel = f.Debug
+	c.logOptions = logOptions
+	if c.Log == nil {
+		c.zl = c.logOptions.newLogger(f.Debug)
+		c.Log = xplogging.NewLogrLogger(c.zl)
+	}
+
 	src := config.NewFSSource(
 		config.WithFS(c.fs),
 		config.WithPath(c.cfgPath),
+		config.WithLogger(c.Log),
 	)
 	if err := src.Initialize(); err != nil {
 		return nil, err
@@ -225,17 +239,7 @@ func NewFromFlags(f Flags, opts ...Option) (*Context, error) {
 	}
 
 	c.InsecureSkipTLSVerify = f.InsecureSkipTLSVerify
-
-	// setup logging
-	c.DebugLevel = f.Debug
-	if c.Log == nil {
-		zapOpts := []zap.Opts{}
-		if f.Debug > 0 {
-			zapOpts = append(zapOpts, zap.Level(zapcore.DebugLevel))
-		}
-		c.zl = zap.New(zapOpts...).WithName("up")
-		c.Log = xplogging.NewLogrLogger(c.zl)
-	}
+	c.Proxy = f.Proxy
 
 	rules := clientcmd.NewDefaultClientConfigLoadingRules()
 	rules.ExplicitPath = f.Kube.Kubeconfig
@@ -248,9 +252,13 @@ func NewFromFlags(f Flags, opts ...Option) (*Context, error) {
 }
 
 // SetupLogging sets up the logger in controller-runtime and kube's klog.
+// Requests made by client-go are logged at the debug log level.
 func (c *Context) SetupLogging() {
-	if c.DebugLevel > 1 {
+	switch {
+	case c.DebugLevel > 1:
 		logging.SetKlogLogger(c.DebugLevel, c.zl)
+	case c.logOptions.Level == LogLevelDebug:
+		logging.SetKlogLogger(requestDebugLevel, c.zl)
 	}
 	ctrl.SetLogger(c.zl)
 }
@@ -289,7 +297,7 @@ func (c *Context) buildSDKConfig(endpoint *url.URL) (*up.Config, error) {
 		})
 	}
 	var tr http.RoundTripper = &http.Transport{
-		Proxy: http.ProxyFromEnvironment,
+		Proxy: ProxyFunc(c.Proxy),
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: c.InsecureSkipTLSVerify, //nolint:gosec // Let the user be unsafe.
 		},
@@ -325,7 +333,7 @@ func (rt *cookieImpersonatingRoundTripper) RoundTrip(req *http.Request) (*http.R
 // K8s controller-runtime client.
 func (c *Context) BuildControllerClientConfig() (*rest.Config, error) {
 	var tr http.RoundTripper = &http.Transport{
-		Proxy: http.ProxyFromEnvironment,
+		Proxy: ProxyFunc(c.Proxy),
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: c.InsecureSkipTLSVerify, //nolint:gosec // Let the user be unsafe.
 		},
@@ -354,29 +362,41 @@ func (c *Context) BuildControllerClientConfig() (*rest.Config, error) {
 // session token has access to any particular resources or operations, only that
 // it is not expired.
 func (c *Context) HasValidSession() (bool, error) {
-	session := c.Profile.Session
-	if session == "" {
+	if c.Profile.Session == "" {
 		return false, nil
 	}
 
+	exp, err := c.SessionExpiration()
+	if err != nil {
+		return false, err
+	}
+	if exp.IsZero() {
+		return true, nil
+	}
+
+	// Treat the token as expired if it expires within the next 30 seconds. This
+	// forces users to re-auth a little earlier than necessary, but avoids
+	// tokens expiring part way through an operation.
+	return time.Now().Add(30 * time.Second).Before(exp), nil
+}
+
+// SessionExpiration returns the time at which the context's session token
+// expires. It returns the zero time if the token doesn't expire.
+func (c *Context) SessionExpiration() (time.Time, error) {
 	parser := jwt.NewParser()
-	token, _, err := parser.ParseUnverified(session, jwt.MapClaims{})
+	token, _, err := parser.ParseUnverified(c.Profile.Session, jwt.MapClaims{})
 	if err != nil {
-		return false, errors.Wrap(err, "failed to parse token")
+		return time.Time{}, errors.Wrap(err, "failed to parse token")
 	}
 
 	exp, err := token.Claims.GetExpirationTime()
 	if err != nil {
-		return false, errors.Wrap(err, "failed to get token expiration")
+		return time.Time{}, errors.Wrap(err, "failed to get token expiration")
 	}
 	if exp == nil {
-		return true, nil
+		return time.Time{}, nil
 	}
-
-	// Treat the token as expired if it expires within the next 30 seconds. This
-	// forces users to re-auth a little earlier than necessary, but avoids
-	// tokens expiring part way through an operation.
-	return time.Now().Add(30 * time.Second).Before(exp.Time), nil
+	return exp.Time, nil
 }
 
 // MarshalJSON marshals the Flags struct, converting the url.URL to strings.
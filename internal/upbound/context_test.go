@@ -204,6 +204,7 @@ func TestNewFromFlags(t *testing.T) {
 						TokenType: profile.TokenTypeUser,
 						Session:   "a token",
 						Account:   "",
+						Domain:    "https://upbound.io",
 					},
 					AuthEndpoint:     withURL("https://auth.upbound.io"),
 					ProxyEndpoint:    withURL("https://proxy.upbound.io/v1/controlPlanes"),
@@ -246,7 +247,7 @@ func TestNewFromFlags(t *testing.T) {
 			},
 		},
 		"PreExistingProfileWithAccount": {
-			reason: "We should successfully return a Context if a pre-existing profile exists with a (deprecated) account configured",
+			reason: "We should successfully return a Context if a pre-existing profile exists with a (deprecated) account configured, migrating the account to the organization",
 			args: args{
 				flags: []string{},
 				opts: []Option{
@@ -266,9 +267,8 @@ func TestNewFromFlags(t *testing.T) {
 						Type:         profile.TypeCloud,
 						TokenType:    profile.TokenTypeUser,
 						Session:      "a token",
-						Account:      "my-org",
 						Organization: "my-org",
-						Domain:       "",
+						Domain:       "https://upbound.io",
 					},
 					AuthEndpoint:     withURL("https://auth.upbound.io"),
 					ProxyEndpoint:    withURL("https://proxy.upbound.io/v1/controlPlanes"),
@@ -300,7 +300,39 @@ func TestNewFromFlags(t *testing.T) {
 						TokenType:    profile.TokenTypeUser,
 						Session:      "a token",
 						Organization: "my-org",
-						Domain:       "",
+						Domain:       "https://upbound.io",
+					},
+					AuthEndpoint:     withURL("https://auth.upbound.io"),
+					ProxyEndpoint:    withURL("https://proxy.upbound.io/v1/controlPlanes"),
+					RegistryEndpoint: withURL("https://xpkg.upbound.io"),
+					AccountsEndpoint: withURL("https://accounts.upbound.io"),
+					Token:            "",
+				},
+			},
+		},
+		"OrganizationFlagOverridesProfile": {
+			reason: "The organization flag should take precedence over the organization in the profile without changing the profile.",
+			args: args{
+				flags: []string{"--organization=other-org"},
+				opts: []Option{
+					withConfig(organizationConfigJSON),
+					withPath("/.up/config.json"),
+				},
+			},
+			want: want{
+				c: &Context{
+					ProfileName:           "default",
+					Organization:          "other-org",
+					APIEndpoint:           withURL("https://api.upbound.io"),
+					Domain:                withURL("https://upbound.io"),
+					InsecureSkipTLSVerify: false,
+					Profile: profile.Profile{
+						ID:           "someone@upbound.io",
+						Type:         profile.TypeCloud,
+						TokenType:    profile.TokenTypeUser,
+						Session:      "a token",
+						Organization: "my-org",
+						Domain:       "https://upbound.io",
 					},
 					AuthEndpoint:     withURL("https://auth.upbound.io"),
 					ProxyEndpoint:    withURL("https://proxy.upbound.io/v1/controlPlanes"),
@@ -373,3 +405,27 @@ func TestNewFromFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestNewFromFlagsOrganizationOverrideKeepsProfile(t *testing.T) {
+	flags := Flags{}
+	parser, _ := kong.New(&flags)
+	if _, err := parser.Parse([]string{"--org=other-org"}); err != nil {
+		t.Fatalf("Parse(...): unexpected error: %v", err)
+	}
+
+	upCtx, err := NewFromFlags(flags, withConfig(organizationConfigJSON), withPath("/.up/config.json"))
+	if err != nil {
+		t.Fatalf("NewFromFlags(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("other-org", upCtx.Organization); diff != "" {
+		t.Errorf("NewFromFlags(...): organization: -want, +got:\n%s", diff)
+	}
+
+	_, p, err := upCtx.Cfg.GetDefaultUpboundProfile()
+	if err != nil {
+		t.Fatalf("GetDefaultUpboundProfile(): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff("my-org", p.Organization); diff != "" {
+		t.Errorf("NewFromFlags(...): stored profile organization: -want, +got:\n%s", diff)
+	}
+}
@@ -14,9 +14,10 @@ type Flags struct {
 	Profile string   `env:"UP_PROFILE" help:"Profile used to execute command."                             json:"profile,omitempty" predictor:"profiles"`
 	// Deprecated: Prefer Organization and fall back to Account if necessary.
 	Account      string `env:"UP_ACCOUNT" help:"Deprecated. Use organization instead." json:"account,omitempty"                                                                   short:"a"`
-	Organization string `alias:"org"      env:"UP_ORGANIZATION"                        help:"Organization used to execute command. Overrides the current profile's organization." json:"organization,omitempty"`
+	Organization string `aliases:"org"    env:"UP_ORGANIZATION"                        help:"Organization used to execute command. Overrides the current profile's organization." json:"organization,omitempty"`
 
-	CABundle string `env:"UP_CA_BUNDLE" help:"Path to CA bundle file to prepend to existing CAs" name:"ca-bundle"`
+	CABundle string   `env:"UP_CA_BUNDLE" help:"Path to CA bundle file to prepend to existing CAs"                                                                             name:"ca-bundle"`
+	Proxy    *url.URL `env:"UP_PROXY"     help:"Proxy to use for HTTP(S) requests. Overrides the HTTP_PROXY and HTTPS_PROXY environment variables. NO_PROXY is still honored." json:"proxy,omitempty"`
 
 	// Insecure
 	InsecureSkipTLSVerify bool `env:"UP_INSECURE_SKIP_TLS_VERIFY" help:"[INSECURE] Skip verifying TLS certificates."                                                                          json:"insecureSkipTLSVerify,omitempty"`
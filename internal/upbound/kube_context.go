@@ -84,6 +84,22 @@ func (c *Context) GetCurrentContextName() (string, error) {
 	return config.CurrentContext, nil
 }
 
+// UseKubeContext makes the named kubeconfig context the current context for
+// the rest of the command. The kubeconfig itself isn't modified.
+func (c *Context) UseKubeContext(name string) error {
+	config, err := c.Kubecfg.RawConfig()
+	if err != nil {
+		return errors.Wrap(err, "cannot load kubeconfig")
+	}
+	if _, ok := config.Contexts[name]; !ok {
+		return errors.Errorf("kubeconfig context %q does not exist", name)
+	}
+
+	config.CurrentContext = name
+	c.Kubecfg = clientcmd.NewNonInteractiveClientConfig(config, name, &clientcmd.ConfigOverrides{}, c.Kubecfg.ConfigAccess())
+	return nil
+}
+
 // GetCurrentContext returns the current kubeconfig context along with the
 // cluster and auth to which it refers.
 func (c *Context) GetCurrentContext() (context *clientcmdapi.Context, cluster *clientcmdapi.Cluster, auth *clientcmdapi.AuthInfo, exists bool) {
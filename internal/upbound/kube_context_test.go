@@ -0,0 +1,88 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package upbound
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestUseKubeContext(t *testing.T) {
+	kubeconfig := clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"dev":     {Server: "https://dev.example.org"},
+			"staging": {Server: "https://staging.example.org"},
+		},
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			"user": {Token: "token"},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			"dev":     {Cluster: "dev", AuthInfo: "user"},
+			"staging": {Cluster: "staging", AuthInfo: "user"},
+		},
+		CurrentContext: "dev",
+	}
+
+	type want struct {
+		context string
+		server  string
+		err     bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		name   string
+		want   want
+	}{
+		"ExistingContext": {
+			reason: "Using an existing context should make it the current context.",
+			name:   "staging",
+			want: want{
+				context: "staging",
+				server:  "https://staging.example.org",
+			},
+		},
+		"MissingContext": {
+			reason: "Using a context that doesn't exist should return an error and leave the current context unchanged.",
+			name:   "prod",
+			want: want{
+				context: "dev",
+				server:  "https://dev.example.org",
+				err:     true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			upCtx := &Context{
+				Kubecfg: clientcmd.NewDefaultClientConfig(kubeconfig, &clientcmd.ConfigOverrides{}),
+			}
+
+			err := upCtx.UseKubeContext(tc.name)
+			if (err != nil) != tc.want.err {
+				t.Fatalf("\n%s\nUseKubeContext(...): unexpected error: %v", tc.reason, err)
+			}
+
+			ctxName, err := upCtx.GetCurrentContextName()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.context, ctxName); diff != "" {
+				t.Errorf("\n%s\nGetCurrentContextName(): -want, +got:\n%s", tc.reason, diff)
+			}
+
+			cfg, err := upCtx.GetKubeconfig()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.server, cfg.Host); diff != "" {
+				t.Errorf("\n%s\nGetKubeconfig(): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
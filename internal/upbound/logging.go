@@ -0,0 +1,100 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package upbound
+
+import (
+	"github.com/go-logr/logr"
+	"go.uber.org/zap/zapcore"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+const (
+	// LogLevelDebug logs everything, including client-go requests.
+	LogLevelDebug = "debug"
+	// LogLevelInfo logs informational messages, warnings, and errors.
+	LogLevelInfo = "info"
+	// LogLevelWarn logs warnings and errors.
+	LogLevelWarn = "warn"
+	// LogLevelError logs errors only.
+	LogLevelError = "error"
+
+	// LogFormatText logs human-readable lines.
+	LogFormatText = "text"
+	// LogFormatJSON logs one JSON object per line.
+	LogFormatJSON = "json"
+
+	// requestDebugLevel is the debug level at which client-go logs the
+	// requests it makes, without their headers or bodies.
+	requestDebugLevel = 3
+)
+
+// LogOptions configure the loggers of contexts.
+type LogOptions struct {
+	// Level is the minimum level of logged messages.
+	Level string
+	// Format is the format of logged messages.
+	Format string
+}
+
+// logOptions are the options used by contexts constructed from flags. They
+// are set once by the root command, before any context is constructed.
+var logOptions = LogOptions{Level: LogLevelInfo, Format: LogFormatText}
+
+// SetLogOptions sets the options used to configure the loggers of contexts
+// constructed from flags afterwards.
+func SetLogOptions(o LogOptions) error {
+	if _, err := o.level(); err != nil {
+		return err
+	}
+	if _, err := o.encoder(); err != nil {
+		return err
+	}
+	logOptions = o
+	return nil
+}
+
+// newLogger returns a logger for the options. Debug logging is enabled if the
+// options ask for it or debug is greater than zero.
+func (o LogOptions) newLogger(debug int) logr.Logger {
+	lvl, err := o.level()
+	if err != nil {
+		lvl = zapcore.InfoLevel
+	}
+	if debug > 0 {
+		lvl = zapcore.DebugLevel
+	}
+	enc, err := o.encoder()
+	if err != nil {
+		enc = zap.ConsoleEncoder()
+	}
+	return zap.New(zap.Level(lvl), enc).WithName("up")
+}
+
+func (o LogOptions) level() (zapcore.Level, error) {
+	switch o.Level {
+	case LogLevelDebug:
+		return zapcore.DebugLevel, nil
+	case LogLevelInfo, "":
+		return zapcore.InfoLevel, nil
+	case LogLevelWarn:
+		return zapcore.WarnLevel, nil
+	case LogLevelError:
+		return zapcore.ErrorLevel, nil
+	default:
+		return zapcore.InfoLevel, errors.Errorf("unknown log level %q", o.Level)
+	}
+}
+
+func (o LogOptions) encoder() (zap.Opts, error) {
+	switch o.Format {
+	case LogFormatText, "":
+		return zap.ConsoleEncoder(), nil
+	case LogFormatJSON:
+		return zap.JSONEncoder(), nil
+	default:
+		return nil, errors.Errorf("unknown log format %q", o.Format)
+	}
+}
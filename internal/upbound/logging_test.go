@@ -0,0 +1,101 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package upbound
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLogOptionsLevel(t *testing.T) {
+	type want struct {
+		level zapcore.Level
+		err   bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		opts   LogOptions
+		want   want
+	}{
+		"Default": {
+			reason: "An unset level should default to info.",
+			opts:   LogOptions{},
+			want:   want{level: zapcore.InfoLevel},
+		},
+		"Debug": {
+			reason: "The debug level should map to zap's debug level.",
+			opts:   LogOptions{Level: LogLevelDebug},
+			want:   want{level: zapcore.DebugLevel},
+		},
+		"Warn": {
+			reason: "The warn level should map to zap's warn level.",
+			opts:   LogOptions{Level: LogLevelWarn},
+			want:   want{level: zapcore.WarnLevel},
+		},
+		"Error": {
+			reason: "The error level should map to zap's error level.",
+			opts:   LogOptions{Level: LogLevelError},
+			want:   want{level: zapcore.ErrorLevel},
+		},
+		"Unknown": {
+			reason: "An unknown level should return an error.",
+			opts:   LogOptions{Level: "verbose"},
+			want:   want{level: zapcore.InfoLevel, err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			level, err := tc.opts.level()
+			if (err != nil) != tc.want.err {
+				t.Fatalf("\n%s\nlevel(): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.level, level); diff != "" {
+				t.Errorf("\n%s\nlevel(): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSetLogOptions(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		opts   LogOptions
+		err    bool
+	}{
+		"Valid": {
+			reason: "Known levels and formats should be accepted.",
+			opts:   LogOptions{Level: LogLevelWarn, Format: LogFormatJSON},
+		},
+		"UnknownFormat": {
+			reason: "An unknown format should return an error.",
+			opts:   LogOptions{Level: LogLevelInfo, Format: "xml"},
+			err:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			prev := logOptions
+			t.Cleanup(func() { logOptions = prev })
+
+			err := SetLogOptions(tc.opts)
+			if (err != nil) != tc.err {
+				t.Fatalf("\n%s\nSetLogOptions(...): unexpected error: %v", tc.reason, err)
+			}
+			if tc.err {
+				if diff := cmp.Diff(prev, logOptions); diff != "" {
+					t.Errorf("\n%s\nSetLogOptions(...): options changed despite error, -want, +got:\n%s", tc.reason, diff)
+				}
+				return
+			}
+			if diff := cmp.Diff(tc.opts, logOptions); diff != "" {
+				t.Errorf("\n%s\nSetLogOptions(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
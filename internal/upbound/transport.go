@@ -0,0 +1,43 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package upbound
+
+import (
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// ProxyFunc returns a function that selects the proxy to use for a request.
+// If proxy is non-nil it is used for all HTTP and HTTPS requests, otherwise
+// the HTTP_PROXY and HTTPS_PROXY environment variables are used. Requests to
+// hosts matching NO_PROXY never use a proxy.
+func ProxyFunc(proxy *url.URL) func(*http.Request) (*url.URL, error) {
+	// Unlike http.ProxyFromEnvironment, httpproxy doesn't cache the
+	// environment, so the configuration is read when the function is built.
+	cfg := httpproxy.FromEnvironment()
+	if proxy != nil {
+		cfg.HTTPProxy = proxy.String()
+		cfg.HTTPSProxy = proxy.String()
+	}
+	pf := cfg.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return pf(req.URL)
+	}
+}
+
+// NewTransport returns an HTTP transport with the default settings that uses
+// the given proxy, as described by ProxyFunc.
+func NewTransport(proxy *url.URL) *http.Transport {
+	tr := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert // The default transport is always an *http.Transport.
+	tr.Proxy = ProxyFunc(proxy)
+	return tr
+}
+
+// Transport returns an HTTP transport that honors the context's proxy
+// configuration. Clients that talk to OCI registries should use it.
+func (c *Context) Transport() http.RoundTripper {
+	return NewTransport(c.Proxy)
+}
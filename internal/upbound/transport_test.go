@@ -0,0 +1,130 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package upbound
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewTransport(t *testing.T) {
+	type args struct {
+		env   map[string]string
+		proxy string
+		req   string
+	}
+	type want struct {
+		proxy string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NoProxy": {
+			reason: "Requests should not be proxied without proxy configuration.",
+			args: args{
+				req: "https://xpkg.upbound.io/v2/",
+			},
+		},
+		"HTTPSProxyFromEnv": {
+			reason: "HTTPS requests should use HTTPS_PROXY.",
+			args: args{
+				env: map[string]string{
+					"HTTPS_PROXY": "http://https-proxy.example.com:3128",
+					"HTTP_PROXY":  "http://http-proxy.example.com:3128",
+				},
+				req: "https://xpkg.upbound.io/v2/",
+			},
+			want: want{
+				proxy: "http://https-proxy.example.com:3128",
+			},
+		},
+		"HTTPProxyFromEnv": {
+			reason: "HTTP requests should use HTTP_PROXY.",
+			args: args{
+				env: map[string]string{
+					"HTTPS_PROXY": "http://https-proxy.example.com:3128",
+					"HTTP_PROXY":  "http://http-proxy.example.com:3128",
+				},
+				req: "http://registry.local/v2/",
+			},
+			want: want{
+				proxy: "http://http-proxy.example.com:3128",
+			},
+		},
+		"NoProxyFromEnv": {
+			reason: "Requests to hosts in NO_PROXY should not be proxied.",
+			args: args{
+				env: map[string]string{
+					"HTTPS_PROXY": "http://https-proxy.example.com:3128",
+					"NO_PROXY":    ".upbound.io",
+				},
+				req: "https://xpkg.upbound.io/v2/",
+			},
+		},
+		"FlagOverridesEnv": {
+			reason: "The proxy flag should take precedence over the proxy environment variables.",
+			args: args{
+				env: map[string]string{
+					"HTTPS_PROXY": "http://https-proxy.example.com:3128",
+				},
+				proxy: "http://flag-proxy.example.com:8080",
+				req:   "https://xpkg.upbound.io/v2/",
+			},
+			want: want{
+				proxy: "http://flag-proxy.example.com:8080",
+			},
+		},
+		"FlagHonorsNoProxy": {
+			reason: "Requests to hosts in NO_PROXY should not be proxied even if the proxy flag is set.",
+			args: args{
+				env: map[string]string{
+					"NO_PROXY": "xpkg.upbound.io",
+				},
+				proxy: "http://flag-proxy.example.com:8080",
+				req:   "https://xpkg.upbound.io/v2/",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for _, k := range []string{"HTTP_PROXY", "HTTPS_PROXY", "NO_PROXY", "http_proxy", "https_proxy", "no_proxy", "REQUEST_METHOD"} {
+				t.Setenv(k, tc.args.env[k])
+			}
+
+			var proxy *url.URL
+			if tc.args.proxy != "" {
+				u, err := url.Parse(tc.args.proxy)
+				if err != nil {
+					t.Fatal(err)
+				}
+				proxy = u
+			}
+
+			req, err := http.NewRequest(http.MethodGet, tc.args.req, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := NewTransport(proxy).Proxy(req)
+			if err != nil {
+				t.Fatalf("\n%s\nProxy(...): unexpected error: %v", tc.reason, err)
+			}
+
+			var gotProxy string
+			if got != nil {
+				gotProxy = got.String()
+			}
+			if diff := cmp.Diff(tc.want.proxy, gotProxy); diff != "" {
+				t.Errorf("\n%s\nProxy(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
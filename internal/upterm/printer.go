@@ -9,12 +9,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"os"
 	"reflect"
 	"text/tabwriter"
 	"text/template"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
+	"golang.org/x/term"
 
 	"github.com/upbound/up/internal/config"
 	"github.com/upbound/up/internal/style"
@@ -46,6 +48,11 @@ type Printer interface {
 	PrintWarning(a ...any)
 	// PrintError prints a styled error.
 	PrintError(a ...any)
+
+	// Pretty returns true if the printer styles its output for a modern
+	// terminal. Callers can use it to decide whether to style output they
+	// format themselves.
+	Pretty() bool
 }
 
 // ResultPrinter prints the result of a command.
@@ -102,7 +109,9 @@ func NewPrinter(out, result io.Writer, format config.Format, pretty bool) Printe
 				pretty: pretty,
 				out:    spinnerOut,
 			},
-			out: out,
+			out:    out,
+			result: result,
+			format: format,
 		}
 	default:
 		return &plainPrinter{
@@ -111,11 +120,51 @@ func NewPrinter(out, result io.Writer, format config.Format, pretty bool) Printe
 				pretty: pretty,
 				out:    spinnerOut,
 			},
-			out: out,
+			out:    out,
+			result: result,
+			format: format,
 		}
 	}
 }
 
+// Redirect returns a printer like p that prints both regular output and results
+// to w. The format and styling of p are kept, as is any output p discards, so
+// quiet and silent output stay that way. Printers not created by NewPrinter are
+// returned unchanged.
+func Redirect(p Printer, w io.Writer) Printer {
+	var out, result io.Writer
+	var format config.Format
+	switch pp := p.(type) {
+	case *prettyPrinter:
+		out, result, format = pp.out, pp.result, pp.format
+	case *plainPrinter:
+		out, result, format = pp.out, pp.result, pp.format
+	default:
+		return p
+	}
+	if out != io.Discard {
+		out = w
+	}
+	if result != io.Discard {
+		result = w
+	}
+	return NewPrinter(out, result, format, p.Pretty())
+}
+
+// ResolvePretty returns whether output should be pretty. Quiet output is never
+// pretty. Otherwise an explicit setting wins, and output defaults to pretty when
+// stdout is a terminal, so piped and CI output stays plain.
+func ResolvePretty(explicit *bool, quiet bool) bool {
+	switch {
+	case quiet:
+		return false
+	case explicit != nil:
+		return *explicit
+	default:
+		return term.IsTerminal(int(os.Stdout.Fd()))
+	}
+}
+
 // NewTestPrinter returns a printer that suppresses all output, suitable for use
 // in unit tests.
 func NewTestPrinter() Printer {
@@ -127,7 +176,9 @@ type prettyPrinter struct {
 	ResultPrinter
 	SpinnerPrinter
 
-	out io.Writer
+	out    io.Writer
+	result io.Writer
+	format config.Format
 }
 
 func (p *prettyPrinter) Print(a ...any) {
@@ -146,6 +197,10 @@ func (p *prettyPrinter) Printfln(format string, a ...any) { //nolint:goprintffun
 	_, _ = fmt.Fprintf(p.out, format+"\n", a...)
 }
 
+func (p *prettyPrinter) Pretty() bool {
+	return true
+}
+
 func (p *prettyPrinter) PrintInfo(a ...any) {
 	p.Print("ℹ️ ")
 	p.Println(a...)
@@ -189,7 +244,9 @@ type plainPrinter struct {
 	ResultPrinter
 	SpinnerPrinter
 
-	out io.Writer
+	out    io.Writer
+	result io.Writer
+	format config.Format
 }
 
 func (p *plainPrinter) Print(a ...any) {
@@ -208,6 +265,10 @@ func (p *plainPrinter) Printfln(format string, a ...any) { //nolint:goprintffunc
 	_, _ = fmt.Fprintf(p.out, format+"\n", a...)
 }
 
+func (p *plainPrinter) Pretty() bool {
+	return false
+}
+
 func (p *plainPrinter) PrintInfo(a ...any) {
 	p.Print("INFO: ")
 	p.Println(a...)
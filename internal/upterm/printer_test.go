@@ -0,0 +1,80 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package upterm
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/upbound/up/internal/config"
+)
+
+func TestRedirect(t *testing.T) {
+	type want struct {
+		out    string
+		pretty bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		out    io.Writer
+		result io.Writer
+		format config.Format
+		pretty bool
+		want   want
+	}{
+		"KeepsFormat": {
+			reason: "Results should be printed to the new writer in the original format.",
+			out:    &bytes.Buffer{},
+			result: &bytes.Buffer{},
+			format: config.FormatJSON,
+			want: want{
+				out: "message\n{\"name\":\"example\"}\n",
+			},
+		},
+		"KeepsPretty": {
+			reason: "Pretty output should stay pretty.",
+			out:    &bytes.Buffer{},
+			result: &bytes.Buffer{},
+			format: config.FormatJSON,
+			pretty: true,
+			want: want{
+				out:    "message\n{\"name\":\"example\"}\n",
+				pretty: true,
+			},
+		},
+		"Quiet": {
+			reason: "Regular output discarded by a quiet printer should stay discarded.",
+			out:    io.Discard,
+			result: &bytes.Buffer{},
+			format: config.FormatJSON,
+			want: want{
+				out: "{\"name\":\"example\"}\n",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var w bytes.Buffer
+			p := Redirect(NewPrinter(tc.out, tc.result, tc.format, tc.pretty), &w)
+			p.Println("message")
+			if err := p.PrintObjectTemplate(struct {
+				Name string `json:"name"`
+			}{Name: "example"}, "{{ .Name }}"); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.want.out, w.String()); diff != "" {
+				t.Errorf("\n%s\nRedirect(...): -want output, +got output:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.pretty, p.Pretty()); diff != "" {
+				t.Errorf("\n%s\nRedirect(...): -want pretty, +got pretty:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package version
+
+import (
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// maxSpacesMinorSkew is how many minor versions a Space may be ahead of or
+// behind up without a warning.
+const maxSpacesMinorSkew = 2
+
+// SpacesSkewWarning returns a warning if the version a Space reports differs
+// from the version of up by more than the supported number of minor versions.
+// It returns an empty string if the versions are close enough, if either isn't
+// a semantic version, or if up is a development build.
+func SpacesSkewWarning(cli, space string) string {
+	c, err := semver.NewVersion(cli)
+	if err != nil || c.Prerelease() != "" {
+		return ""
+	}
+	s, err := semver.NewVersion(space)
+	if err != nil {
+		return ""
+	}
+
+	skew := int64(s.Minor()) - int64(c.Minor()) //nolint:gosec // Minor versions are small.
+	switch {
+	case s.Major() > c.Major() || (s.Major() == c.Major() && skew > maxSpacesMinorSkew):
+		return fmt.Sprintf("up %s is older than the Space, which runs %s. Some commands might not work as expected; consider upgrading up.", c.Original(), s.Original())
+	case s.Major() < c.Major() || (s.Major() == c.Major() && skew < -maxSpacesMinorSkew):
+		return fmt.Sprintf("up %s is newer than the Space, which runs %s. Some commands might not work as expected; consider upgrading the Space or using an older version of up.", c.Original(), s.Original())
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package version
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSpacesSkewWarning(t *testing.T) {
+	type args struct {
+		cli   string
+		space string
+	}
+
+	type want struct {
+		warning bool
+		mention string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"SameVersion": {
+			reason: "Should not warn when the Space runs the same version as up.",
+			args: args{
+				cli:   "v1.15.0",
+				space: "1.15.2",
+			},
+		},
+		"WithinSkew": {
+			reason: "Should not warn when the Space is within the supported skew.",
+			args: args{
+				cli:   "v1.15.0",
+				space: "1.13.0",
+			},
+		},
+		"NewerSpace": {
+			reason: "Should warn to upgrade up when the Space is too new.",
+			args: args{
+				cli:   "v1.15.0",
+				space: "1.18.0",
+			},
+			want: want{
+				warning: true,
+				mention: "upgrading up",
+			},
+		},
+		"OlderSpace": {
+			reason: "Should warn to upgrade the Space when it's too old.",
+			args: args{
+				cli:   "v1.15.0",
+				space: "1.12.1",
+			},
+			want: want{
+				warning: true,
+				mention: "upgrading the Space",
+			},
+		},
+		"NewerMajor": {
+			reason: "Should warn when the Space runs a newer major version.",
+			args: args{
+				cli:   "v1.15.0",
+				space: "2.0.0",
+			},
+			want: want{
+				warning: true,
+				mention: "upgrading up",
+			},
+		},
+		"CloudManaged": {
+			reason: "Should not warn when the Space version isn't a semantic version.",
+			args: args{
+				cli:   "v1.15.0",
+				space: "Upbound Cloud Managed",
+			},
+		},
+		"UnknownVersion": {
+			reason: "Should not warn when the version of up is unknown.",
+			args: args{
+				cli:   "",
+				space: "1.18.0",
+			},
+		},
+		"DevelopmentBuild": {
+			reason: "Should not warn when up is a development build.",
+			args: args{
+				cli:   "v0.0.0-1116.g14cbfe6",
+				space: "1.18.0",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := SpacesSkewWarning(tc.args.cli, tc.args.space)
+			if (got != "") != tc.want.warning {
+				t.Fatalf("\n%s\nSpacesSkewWarning(...): want warning %t, got %q", tc.reason, tc.want.warning, got)
+			}
+			if !strings.Contains(got, tc.want.mention) {
+				t.Errorf("\n%s\nSpacesSkewWarning(...): want warning mentioning %q, got %q", tc.reason, tc.want.mention, got)
+			}
+		})
+	}
+}
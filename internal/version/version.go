@@ -54,6 +54,7 @@ const (
 var (
 	version   string
 	gitCommit string //nolint:gochecknoglobals // Filled by ldflags.
+	buildDate string //nolint:gochecknoglobals // Filled by ldflags.
 )
 
 // UserAgent Function to print the UserAgent.
@@ -71,6 +72,11 @@ func GitCommit() string {
 	return gitCommit
 }
 
+// BuildDate returns the date the CLI was built, in RFC 3339 format.
+func BuildDate() string {
+	return buildDate
+}
+
 // AgentVersion returns the connect agent version.
 func AgentVersion() string {
 	return agentVersion
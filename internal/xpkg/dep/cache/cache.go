@@ -114,10 +114,14 @@ func (c *Local) Get(k v1beta1.Dependency) (*xpkg.ParsedPackage, error) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	e, err := c.currentEntry(calculatePath(k))
+	path := calculatePath(k)
+	e, err := c.currentEntry(path)
 	if err != nil {
 		return nil, err
 	}
+	if err := c.touch(path, time.Now()); err != nil {
+		c.log.Debug("failed to record cache entry access", "entry", path, "error", err)
+	}
 	return e.pkg, nil
 }
 
@@ -149,7 +153,9 @@ func (c *Local) Store(k v1beta1.Dependency, v *xpkg.ParsedPackage) error {
 		return err
 	}
 
-	return nil
+	// Storing an entry counts as accessing it.
+	now := time.Now()
+	return c.fs.Chtimes(filepath.Join(c.root, path), now, now)
 }
 
 // Versions returns a slice of versions that exist in the cache for the given
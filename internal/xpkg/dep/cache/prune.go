@@ -0,0 +1,282 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package cache
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/afero"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane/v2/apis/pkg/v1beta1"
+
+	"github.com/upbound/up/internal/xpkg"
+	"github.com/upbound/up/internal/xpkg/dep/resolver/image"
+	"github.com/upbound/up/internal/xpkg/dep/utils"
+)
+
+const (
+	// accessResolution is how stale an entry's last access time must be
+	// before a read updates it. Updating it on every read would make reads
+	// write to the cache, triggering cache watchers.
+	accessResolution = time.Hour
+
+	errFailedToListEntries = "failed to list cache entries"
+	errFailedToRemoveEntry = "failed to remove cache entry %s"
+)
+
+// EntryInfo describes an entry in the cache.
+type EntryInfo struct {
+	// Path is the path of the entry relative to the cache root.
+	Path string
+	// Package is the package the entry caches, without a tag or digest.
+	Package string
+	// Version is the tag or digest of the package the entry caches.
+	Version string
+	// Digest is the digest of the cached package.
+	Digest string
+	// Size is the size of the entry on disk, in bytes.
+	Size int64
+	// LastAccess is when the entry was last stored or read.
+	LastAccess time.Time
+}
+
+// Entries returns information about all the entries in the cache, sorted by
+// path. It returns no entries if the cache doesn't exist.
+func (c *Local) Entries() ([]EntryInfo, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.entries()
+}
+
+func (c *Local) entries() ([]EntryInfo, error) {
+	var infos []EntryInfo
+	err := afero.Walk(c.fs, c.root, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == c.root {
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() || !strings.Contains(info.Name(), "@") {
+			return nil
+		}
+		if ok, err := afero.Exists(c.fs, filepath.Join(path, xpkg.JSONStreamFile)); err != nil || !ok {
+			return err
+		}
+
+		e, err := c.entryInfo(path, info)
+		if err != nil {
+			return err
+		}
+		infos = append(infos, e)
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errFailedToListEntries)
+	}
+	return infos, nil
+}
+
+// entryInfo collects information about the entry in the given directory.
+func (c *Local) entryInfo(dir string, info fs.FileInfo) (EntryInfo, error) {
+	rel, err := filepath.Rel(c.root, dir)
+	if err != nil {
+		return EntryInfo{}, err
+	}
+	e := EntryInfo{
+		Path:       filepath.ToSlash(rel),
+		LastAccess: info.ModTime(),
+	}
+	if i := strings.LastIndex(e.Path, "@"); i >= 0 {
+		e.Package, e.Version = e.Path[:i], e.Path[i+1:]
+	}
+
+	err = afero.Walk(c.fs, dir, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		e.Size += info.Size()
+		if filepath.Dir(path) == dir && strings.HasPrefix(info.Name(), "sha256:") {
+			e.Digest = info.Name()
+		}
+		return nil
+	})
+	return e, err
+}
+
+// touch records that the entry at the given path was accessed at the given
+// time, unless it was already accessed recently. The access time is recorded
+// as the modification time of the entry's directory, which is also updated
+// whenever the entry is stored.
+func (c *Local) touch(path string, t time.Time) error {
+	p := filepath.Join(c.root, path)
+	info, err := c.fs.Stat(p)
+	if err != nil {
+		return err
+	}
+	if t.Sub(info.ModTime()) < accessResolution {
+		return nil
+	}
+	return c.fs.Chtimes(p, t, t)
+}
+
+// Referenced returns the paths of the entries that satisfy the given
+// dependencies and, transitively, the dependencies of those entries. Entries
+// for every cached version satisfying a constraint are included, since any
+// of them may be the one that was resolved.
+func (c *Local) Referenced(deps ...v1beta1.Dependency) (map[string]bool, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	refs := make(map[string]bool)
+	queue := slices.Clone(deps)
+	for len(queue) > 0 {
+		d := queue[0]
+		queue = queue[1:]
+
+		paths, err := c.matchingPaths(d)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range paths {
+			if refs[p] {
+				continue
+			}
+			e, err := c.currentEntry(p)
+			if os.IsNotExist(err) {
+				continue
+			}
+			if err != nil {
+				return nil, errors.Wrap(err, errFailedToFindEntry)
+			}
+			refs[p] = true
+			queue = append(queue, e.pkg.Dependencies()...)
+		}
+	}
+	return refs, nil
+}
+
+// matchingPaths returns the paths of the entries that may satisfy the given
+// dependency.
+func (c *Local) matchingPaths(d v1beta1.Dependency) ([]string, error) {
+	if utils.IsDigest(&d) {
+		return []string{filepath.ToSlash(calculatePath(d))}, nil
+	}
+
+	vers, err := c.Versions(d)
+	if err != nil {
+		return nil, err
+	}
+
+	constraints := d.Constraints
+	if constraints == "" {
+		constraints = image.DefaultVer
+	}
+	// Constraints that aren't semantic versions or ranges can only match a
+	// tag exactly.
+	cons, cerr := semver.NewConstraint(constraints)
+
+	paths := make([]string, 0, len(vers))
+	for _, ver := range vers {
+		match := ver == d.Constraints
+		if v, err := semver.NewVersion(ver); !match && cerr == nil && err == nil {
+			match = cons.Check(v)
+		}
+		if match {
+			d.Constraints = ver
+			paths = append(paths, filepath.ToSlash(calculatePath(d)))
+		}
+	}
+	return paths, nil
+}
+
+// PruneOption configures which entries Prune removes.
+type PruneOption func(*pruneOptions)
+
+type pruneOptions struct {
+	ttl     time.Duration
+	maxSize int64
+	keep    map[string]bool
+}
+
+// PruneWithTTL removes entries that haven't been accessed within the given
+// duration.
+func PruneWithTTL(ttl time.Duration) PruneOption {
+	return func(o *pruneOptions) {
+		o.ttl = ttl
+	}
+}
+
+// PruneWithMaxSize removes the least recently accessed entries until the cache
+// is no larger than the given number of bytes.
+func PruneWithMaxSize(size int64) PruneOption {
+	return func(o *pruneOptions) {
+		o.maxSize = size
+	}
+}
+
+// PruneWithKeep prevents the entries at the given paths, as returned by
+// Referenced, from being removed.
+func PruneWithKeep(paths map[string]bool) PruneOption {
+	return func(o *pruneOptions) {
+		o.keep = paths
+	}
+}
+
+// Prune removes entries from the cache that are older than the configured TTL
+// or that don't fit within the configured size. It returns the removed entries.
+func (c *Local) Prune(opts ...PruneOption) ([]EntryInfo, error) {
+	o := &pruneOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	infos, err := c.entries()
+	if err != nil {
+		return nil, err
+	}
+
+	// Consider the least recently accessed entries first.
+	slices.SortStableFunc(infos, func(a, b EntryInfo) int {
+		return a.LastAccess.Compare(b.LastAccess)
+	})
+
+	var size int64
+	for _, e := range infos {
+		size += e.Size
+	}
+
+	now := time.Now()
+	var removed []EntryInfo
+	for _, e := range infos {
+		if o.keep[e.Path] {
+			continue
+		}
+		expired := o.ttl > 0 && now.Sub(e.LastAccess) > o.ttl
+		oversize := o.maxSize > 0 && size > o.maxSize
+		if !expired && !oversize {
+			continue
+		}
+		if err := c.fs.RemoveAll(filepath.Join(c.root, e.Path)); err != nil {
+			return removed, errors.Wrapf(err, errFailedToRemoveEntry, e.Path)
+		}
+		size -= e.Size
+		removed = append(removed, e)
+	}
+	return removed, nil
+}
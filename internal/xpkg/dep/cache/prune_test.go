@@ -0,0 +1,279 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package cache
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	xpmetav1 "github.com/crossplane/crossplane/v2/apis/pkg/meta/v1"
+	"github.com/crossplane/crossplane/v2/apis/pkg/v1beta1"
+
+	"github.com/upbound/up/internal/xpkg/dep/marshaler/xpkg"
+)
+
+const (
+	pathAws      = "index.docker.io/crossplane/provider-aws@v0.20.1-alpha"
+	pathGcp      = "index.docker.io/crossplane/provider-gcp@v0.18.1"
+	pathUpGcp    = "registry.upbound.io/crossplane/provider-gcp@v0.2.0"
+	pathConfig   = "index.docker.io/crossplane/config@v1.0.0"
+	pruneRootDir = "/cache"
+)
+
+var configPkg = &xpkg.ParsedPackage{
+	MetaObj: &xpmetav1.Configuration{
+		TypeMeta: apimetav1.TypeMeta{
+			APIVersion: "meta.pkg.crossplane.io/v1",
+			Kind:       "Configuration",
+		},
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name: "config",
+		},
+		Spec: xpmetav1.ConfigurationSpec{
+			MetaSpec: xpmetav1.MetaSpec{
+				DependsOn: []xpmetav1.Dependency{
+					{Provider: ptr.To("crossplane/provider-aws"), Version: "v0.20.1-alpha"},
+					{Provider: ptr.To("crossplane/provider-gcp"), Version: ">=v0.18.0"},
+					{Provider: ptr.To("crossplane/provider-azure"), Version: ">=v1.0.0"},
+				},
+			},
+		},
+	},
+	Kind:       string(v1beta1.ConfigurationPackageType),
+	APIVersion: "pkg.crossplane.io/v1",
+	SHA:        "sha256:0fc58ff9ed6c7e2c7b8b7d1c7ac4e2b5a2f6a0b8c1d2e3f4a5b6c7d8e9f0a1b2",
+	Reg:        "index.docker.io",
+	Ver:        "v1.0.0",
+}
+
+// newPruneCache returns a cache containing pkg1, pkg2, and pkg3, last accessed
+// two days, two hours, and no time ago respectively.
+func newPruneCache(t *testing.T) *Local {
+	t.Helper()
+
+	c, err := NewLocal(pruneRootDir, WithFS(afero.NewMemMapFs()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	for path, pkg := range map[string]*xpkg.ParsedPackage{
+		pathAws:   pkg1,
+		pathGcp:   pkg2,
+		pathUpGcp: pkg3,
+	} {
+		if err := c.add(c.newEntry(pkg), path); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.fs.Chtimes(filepath.Join(pruneRootDir, path), now, now); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for path, age := range map[string]time.Duration{
+		pathAws: 48 * time.Hour,
+		pathGcp: 2 * time.Hour,
+	} {
+		at := now.Add(-age)
+		if err := c.fs.Chtimes(filepath.Join(pruneRootDir, path), at, at); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return c
+}
+
+func TestEntries(t *testing.T) {
+	c := newPruneCache(t)
+
+	got, err := c.Entries()
+	if err != nil {
+		t.Fatalf("Entries(...): unexpected error: %v", err)
+	}
+
+	want := []EntryInfo{
+		{Path: pathAws, Package: "index.docker.io/crossplane/provider-aws", Version: "v0.20.1-alpha", Digest: pkg1.SHA},
+		{Path: pathGcp, Package: "index.docker.io/crossplane/provider-gcp", Version: "v0.18.1", Digest: pkg2.SHA},
+		{Path: pathUpGcp, Package: "registry.upbound.io/crossplane/provider-gcp", Version: "v0.2.0", Digest: pkg3.SHA},
+	}
+	ignore := cmp.FilterPath(func(p cmp.Path) bool {
+		f := p.Last().String()
+		return f == ".Size" || f == ".LastAccess"
+	}, cmp.Ignore())
+	if diff := cmp.Diff(want, got, ignore); diff != "" {
+		t.Errorf("Entries(...): -want, +got:\n%s", diff)
+	}
+	for _, e := range got {
+		if e.Size == 0 {
+			t.Errorf("Entries(...): entry %s has no size", e.Path)
+		}
+	}
+
+	empty, err := NewLocal("/does-not-exist", WithFS(afero.NewMemMapFs()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err = empty.Entries()
+	if err != nil {
+		t.Fatalf("Entries(...): unexpected error for missing cache: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("Entries(...): want no entries for missing cache, got %d", len(got))
+	}
+}
+
+func TestGetRecordsAccess(t *testing.T) {
+	c := newPruneCache(t)
+
+	if _, err := c.Get(v1beta1.Dependency{Package: "crossplane/provider-aws", Constraints: "v0.20.1-alpha"}); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := c.fs.Stat(filepath.Join(pruneRootDir, pathAws))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if age := time.Since(info.ModTime()); age > time.Minute {
+		t.Errorf("Get(...): want entry access to be recorded, last access was %s ago", age)
+	}
+}
+
+func TestPrune(t *testing.T) {
+	type args struct {
+		opts func(c *Local) []PruneOption
+	}
+	type want struct {
+		removed   []string
+		remaining int
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NoLimits": {
+			reason: "Nothing should be removed without a TTL or size budget.",
+			args: args{
+				opts: func(_ *Local) []PruneOption { return nil },
+			},
+			want: want{
+				remaining: 3,
+			},
+		},
+		"TTL": {
+			reason: "Entries not accessed within the TTL should be removed.",
+			args: args{
+				opts: func(_ *Local) []PruneOption {
+					return []PruneOption{PruneWithTTL(24 * time.Hour)}
+				},
+			},
+			want: want{
+				removed:   []string{pathAws},
+				remaining: 2,
+			},
+		},
+		"TTLKeep": {
+			reason: "Kept entries should never be removed, even if they're expired.",
+			args: args{
+				opts: func(_ *Local) []PruneOption {
+					return []PruneOption{
+						PruneWithTTL(time.Hour),
+						PruneWithKeep(map[string]bool{pathAws: true}),
+					}
+				},
+			},
+			want: want{
+				removed:   []string{pathGcp},
+				remaining: 2,
+			},
+		},
+		"MaxSize": {
+			reason: "The least recently accessed entries should be removed until the cache fits its size budget.",
+			args: args{
+				opts: func(c *Local) []PruneOption {
+					return []PruneOption{PruneWithMaxSize(entrySize(t, c, pathUpGcp))}
+				},
+			},
+			want: want{
+				removed:   []string{pathAws, pathGcp},
+				remaining: 1,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newPruneCache(t)
+
+			removed, err := c.Prune(tc.args.opts(c)...)
+			if err != nil {
+				t.Fatalf("\n%s\nPrune(...): unexpected error: %v", tc.reason, err)
+			}
+
+			var got []string
+			for _, e := range removed {
+				got = append(got, e.Path)
+			}
+			if diff := cmp.Diff(tc.want.removed, got); diff != "" {
+				t.Errorf("\n%s\nPrune(...): -want removed, +got removed:\n%s", tc.reason, diff)
+			}
+
+			remaining, err := c.Entries()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.remaining, len(remaining)); diff != "" {
+				t.Errorf("\n%s\nPrune(...): -want remaining, +got remaining:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReferenced(t *testing.T) {
+	c := newPruneCache(t)
+	if err := c.add(c.newEntry(configPkg), pathConfig); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.Referenced(v1beta1.Dependency{
+		Package:     "crossplane/config",
+		Constraints: ">=v1.0.0",
+	})
+	if err != nil {
+		t.Fatalf("Referenced(...): unexpected error: %v", err)
+	}
+
+	// The configuration's dependencies are referenced transitively. Its
+	// uncached dependency and the unrelated registry.upbound.io package are
+	// not.
+	want := map[string]bool{
+		pathConfig: true,
+		pathAws:    true,
+		pathGcp:    true,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Referenced(...): -want, +got:\n%s", diff)
+	}
+}
+
+func entrySize(t *testing.T, c *Local, path string) int64 {
+	t.Helper()
+
+	infos, err := c.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range infos {
+		if e.Path == path {
+			return e.Size
+		}
+	}
+	t.Fatalf("no entry %s", path)
+	return 0
+}
@@ -6,6 +6,7 @@ package image
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/name"
@@ -15,7 +16,8 @@ import (
 
 // LocalFetcher --.
 type LocalFetcher struct {
-	keychain authn.Keychain
+	keychain  authn.Keychain
+	transport http.RoundTripper
 }
 
 // LocalFetcherOption modifies the fetcher.
@@ -28,10 +30,18 @@ func WithKeychain(kc authn.Keychain) LocalFetcherOption {
 	}
 }
 
+// WithTransport sets the HTTP transport the fetcher will use.
+func WithTransport(t http.RoundTripper) LocalFetcherOption {
+	return func(f *LocalFetcher) {
+		f.transport = t
+	}
+}
+
 // NewLocalFetcher --.
 func NewLocalFetcher(opts ...LocalFetcherOption) *LocalFetcher {
 	f := &LocalFetcher{
-		keychain: authn.DefaultKeychain,
+		keychain:  authn.DefaultKeychain,
+		transport: remote.DefaultTransport,
 	}
 
 	for _, opt := range opts {
@@ -43,15 +53,23 @@ func NewLocalFetcher(opts ...LocalFetcherOption) *LocalFetcher {
 
 // Fetch fetches a package image.
 func (r *LocalFetcher) Fetch(ctx context.Context, ref name.Reference, _ ...string) (v1.Image, error) {
-	return remote.Image(ref, remote.WithContext(ctx), remote.WithAuthFromKeychain(r.keychain))
+	return remote.Image(ref, r.options(ctx)...)
 }
 
 // Head fetches a package descriptor.
 func (r *LocalFetcher) Head(ctx context.Context, ref name.Reference, _ ...string) (*v1.Descriptor, error) {
-	return remote.Head(ref, remote.WithContext(ctx), remote.WithAuthFromKeychain(r.keychain))
+	return remote.Head(ref, r.options(ctx)...)
 }
 
 // Tags fetches a package's tags.
 func (r *LocalFetcher) Tags(ctx context.Context, ref name.Reference, _ ...string) ([]string, error) {
-	return remote.List(ref.Context(), remote.WithContext(ctx), remote.WithAuthFromKeychain(r.keychain))
+	return remote.List(ref.Context(), r.options(ctx)...)
+}
+
+func (r *LocalFetcher) options(ctx context.Context) []remote.Option {
+	return []remote.Option{
+		remote.WithContext(ctx),
+		remote.WithAuthFromKeychain(r.keychain),
+		remote.WithTransport(r.transport),
+	}
 }
@@ -4,6 +4,7 @@
 package xpkg
 
 import (
+	"maps"
 	"slices"
 	"strings"
 
@@ -31,13 +32,20 @@ type ImageMeta struct {
 
 // AnnotateImage reads in the layers of the given v1.Image and annotates the
 // xpkg layers with their corresponding annotations, returning a new v1.Image
-// containing the annotation details.
+// containing the annotation details. Labels constructed by
+// ManifestAnnotationLabel are added to the manifest as annotations. Existing
+// manifest and layer annotations are preserved.
 func AnnotateImage(i v1.Image) (v1.Image, error) {
 	cfgFile, err := i.ConfigFile()
 	if err != nil {
 		return nil, err
 	}
 
+	manifest, err := i.Manifest()
+	if err != nil {
+		return nil, err
+	}
+
 	layers, err := i.Layers()
 	if err != nil {
 		return nil, err
@@ -56,24 +64,25 @@ func AnnotateImage(i v1.Image) (v1.Image, error) {
 	// will get the same digest again if they round-trip it through an on-disk
 	// tarball.
 
-	for _, l := range layers {
+	for idx, l := range layers {
 		d, err := l.Digest()
 		if err != nil {
 			return nil, err
 		}
+		var annotations map[string]string
+		if idx < len(manifest.Layers) {
+			annotations = maps.Clone(manifest.Layers[idx].Annotations)
+		}
 		if annotation, ok := cfgFile.Config.Labels[Label(d.String())]; ok {
-			addendums = append(addendums, mutate.Addendum{
-				Layer:     l,
-				MediaType: types.DockerLayer,
-				Annotations: map[string]string{
-					AnnotationKey: annotation,
-				},
-			})
-			continue
+			if annotations == nil {
+				annotations = make(map[string]string, 1)
+			}
+			annotations[AnnotationKey] = annotation
 		}
 		addendums = append(addendums, mutate.Addendum{
-			Layer:     l,
-			MediaType: types.DockerLayer,
+			Layer:       l,
+			MediaType:   types.DockerLayer,
+			Annotations: annotations,
 		})
 	}
 
@@ -98,6 +107,23 @@ func AnnotateImage(i v1.Image) (v1.Image, error) {
 	img = mutate.MediaType(img, types.DockerManifestSchema2)
 	img = mutate.ConfigMediaType(img, types.DockerConfigJSON)
 
+	annotations := maps.Clone(manifest.Annotations)
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	for k, v := range cfgFile.Config.Labels {
+		if key, ok := strings.CutPrefix(k, ManifestAnnotationLabelPrefix); ok {
+			annotations[key] = v
+		}
+	}
+	if len(annotations) > 0 {
+		aimg, ok := mutate.Annotations(img, annotations).(v1.Image)
+		if !ok {
+			return nil, errors.New("failed to annotate image manifest")
+		}
+		img = aimg
+	}
+
 	return img, nil
 }
 
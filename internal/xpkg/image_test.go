@@ -0,0 +1,128 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package xpkg
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+)
+
+func TestAnnotateImage(t *testing.T) {
+	layer, err := random.Layer(1024, types.DockerLayer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dgst, err := layer.Digest()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type args struct {
+		manifestAnnotations map[string]string
+		layerAnnotations    map[string]string
+		labels              map[string]string
+	}
+	type want struct {
+		manifestAnnotations map[string]string
+		layerAnnotations    map[string]string
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NoAnnotations": {
+			reason: "An image without annotations should only have its xpkg layers annotated.",
+			args: args{
+				labels: map[string]string{
+					Label(dgst.String()): PackageAnnotation,
+				},
+			},
+			want: want{
+				layerAnnotations: map[string]string{
+					AnnotationKey: PackageAnnotation,
+				},
+			},
+		},
+		"ExistingAnnotations": {
+			reason: "Existing manifest and layer annotations should be merged with the xpkg annotations.",
+			args: args{
+				manifestAnnotations: map[string]string{
+					"org.opencontainers.image.source": "https://github.com/upbound/example",
+				},
+				layerAnnotations: map[string]string{
+					"org.opencontainers.image.title": "package.yaml",
+				},
+				labels: map[string]string{
+					Label(dgst.String()):                         PackageAnnotation,
+					ManifestAnnotationLabel("example.org/build"): "42",
+				},
+			},
+			want: want{
+				manifestAnnotations: map[string]string{
+					"org.opencontainers.image.source": "https://github.com/upbound/example",
+					"example.org/build":               "42",
+				},
+				layerAnnotations: map[string]string{
+					"org.opencontainers.image.title": "package.yaml",
+					AnnotationKey:                    PackageAnnotation,
+				},
+			},
+		},
+		"ExistingLayerAnnotationsOnly": {
+			reason: "Existing annotations on layers that aren't xpkg layers should be preserved.",
+			args: args{
+				layerAnnotations: map[string]string{
+					"org.opencontainers.image.title": "schema",
+				},
+			},
+			want: want{
+				layerAnnotations: map[string]string{
+					"org.opencontainers.image.title": "schema",
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			img, err := mutate.Append(empty.Image, mutate.Addendum{
+				Layer:       layer,
+				Annotations: tc.args.layerAnnotations,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			img, err = mutate.Config(img, v1.Config{Labels: tc.args.labels})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.args.manifestAnnotations != nil {
+				img = mutate.Annotations(img, tc.args.manifestAnnotations).(v1.Image)
+			}
+
+			got, err := AnnotateImage(img)
+			if err != nil {
+				t.Fatalf("\n%s\nAnnotateImage(...): unexpected error: %v", tc.reason, err)
+			}
+			m, err := got.Manifest()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.want.manifestAnnotations, m.Annotations); diff != "" {
+				t.Errorf("\n%s\nAnnotateImage(...): -want manifest annotations, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.layerAnnotations, m.Layers[0].Annotations); diff != "" {
+				t.Errorf("\n%s\nAnnotateImage(...): -want layer annotations, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
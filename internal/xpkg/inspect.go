@@ -0,0 +1,116 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package xpkg
+
+import (
+	"archive/tar"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/partial"
+	"github.com/spf13/afero/tarfs"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// SchemaAnnotationPrefix prefixes the annotation values of layers that contain
+// generated schemas. The rest of the value is the schema language.
+const SchemaAnnotationPrefix = "schema."
+
+// Contents are the contents of a package image.
+type Contents struct {
+	// Labels are the annotations of the image's layers, read from the labels
+	// in its config so that they're available for images read from a tarball.
+	Labels []string
+	// Package is the package YAML stream.
+	Package []byte
+	// Examples is the examples YAML stream, if the package has examples.
+	Examples []byte
+	// HelmChart is the Helm chart, if the package has one.
+	HelmChart []byte
+	// IncludesAuth is true if the package YAML stream includes an auth
+	// object.
+	IncludesAuth bool
+}
+
+// Schemas returns the languages of the schemas in the package, sorted.
+func (c Contents) Schemas() []string {
+	var langs []string
+	for _, l := range c.Labels {
+		if lang, ok := strings.CutPrefix(l, SchemaAnnotationPrefix); ok {
+			langs = append(langs, lang)
+		}
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// ReadContents reads the contents of a package image.
+func ReadContents(i v1.Image) (Contents, error) {
+	contents := Contents{
+		Labels: make([]string, 0),
+	}
+
+	fs := tarfs.New(tar.NewReader(mutate.Extract(i)))
+	pkgYaml, err := fs.Open(StreamFile)
+	if err != nil {
+		return contents, errors.Wrap(err, "cannot open package stream file")
+	}
+	if contents.Package, err = io.ReadAll(pkgYaml); err != nil {
+		return contents, errors.Wrap(err, "cannot read package stream file")
+	}
+	contents.IncludesAuth = strings.Contains(string(contents.Package), AuthObjectAnno)
+
+	if contents.Examples, err = readOptionalFile(fs, XpkgExamplesFile); err != nil {
+		return contents, errors.Wrap(err, "cannot read examples")
+	}
+	if contents.HelmChart, err = readOptionalFile(fs, XpkgHelmChartFile); err != nil {
+		return contents, errors.Wrap(err, "cannot read helm chart")
+	}
+
+	labels, err := LayerAnnotations(i)
+	if err != nil {
+		return contents, err
+	}
+	contents.Labels = labels
+
+	return contents, nil
+}
+
+// readOptionalFile reads a file from a package image, returning nil if it
+// doesn't exist.
+func readOptionalFile(fs *tarfs.Fs, name string) ([]byte, error) {
+	f, err := fs.Open(name)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(f)
+}
+
+// LayerAnnotations returns the annotations of an image's layers, read from the
+// labels in its config.
+func LayerAnnotations(i partial.WithConfigFile) ([]string, error) {
+	labels := []string{}
+
+	cfgFile, err := i.ConfigFile()
+	if err != nil {
+		return labels, errors.Wrap(err, "cannot get image config")
+	}
+
+	for key, label := range cfgFile.Config.Labels {
+		if strings.HasPrefix(key, AnnotationKey+":") {
+			labels = append(labels, label)
+		}
+	}
+	sort.Strings(labels)
+
+	return labels, nil
+}
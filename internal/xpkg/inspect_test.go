@@ -0,0 +1,144 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package xpkg
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+)
+
+func TestReadContents(t *testing.T) {
+	type file struct {
+		name       string
+		annotation string
+		content    string
+	}
+	type want struct {
+		labels    []string
+		schemas   []string
+		examples  bool
+		helmChart bool
+		auth      bool
+	}
+
+	pkg := file{name: StreamFile, annotation: PackageAnnotation, content: "apiVersion: meta.pkg.crossplane.io/v1\nkind: Configuration\n"}
+
+	cases := map[string]struct {
+		reason string
+		files  []file
+		want   want
+	}{
+		"PackageOnly": {
+			reason: "A package with only a package YAML stream should have only the base layer.",
+			files:  []file{pkg},
+			want: want{
+				labels: []string{PackageAnnotation},
+			},
+		},
+		"ExamplesAndSchemas": {
+			reason: "Examples and the language of each schema layer should be reported.",
+			files: []file{
+				pkg,
+				{name: XpkgExamplesFile, annotation: ExamplesAnnotation, content: "kind: XNetwork\n"},
+				{name: "models/python.tgz", annotation: SchemaAnnotationPrefix + "python"},
+				{name: "models/kcl.tgz", annotation: SchemaAnnotationPrefix + "kcl"},
+			},
+			want: want{
+				labels:   []string{PackageAnnotation, "schema.kcl", "schema.python", ExamplesAnnotation},
+				schemas:  []string{"kcl", "python"},
+				examples: true,
+			},
+		},
+		"HelmChartAndAuth": {
+			reason: "A Helm chart and an auth object in the package YAML stream should be reported.",
+			files: []file{
+				{name: StreamFile, annotation: PackageAnnotation, content: "metadata:\n  annotations:\n    auth.upbound.io/config: {}\n"},
+				{name: XpkgHelmChartFile, annotation: HelmChartAnnotation, content: "chart"},
+			},
+			want: want{
+				labels:    []string{PackageAnnotation, HelmChartAnnotation},
+				helmChart: true,
+				auth:      true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg := v1.Config{Labels: map[string]string{}}
+			img := empty.Image
+			for _, f := range tc.files {
+				l, err := Layer(bytes.NewBufferString(f.content), f.name, f.annotation, int64(len(f.content)), StreamFileMode, &cfg)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if img, err = mutate.AppendLayers(img, l); err != nil {
+					t.Fatal(err)
+				}
+			}
+			img, err := mutate.Config(img, cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			contents, err := ReadContents(img)
+			if err != nil {
+				t.Fatalf("\n%s\nReadContents(...): unexpected error: %v", tc.reason, err)
+			}
+			got := want{
+				labels:    contents.Labels,
+				schemas:   contents.Schemas(),
+				examples:  len(contents.Examples) != 0,
+				helmChart: len(contents.HelmChart) != 0,
+				auth:      contents.IncludesAuth,
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nReadContents(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestLayerAnnotations(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		labels map[string]string
+		want   []string
+	}{
+		"NoLabels": {
+			reason: "An image without labels should have no layer annotations.",
+			want:   []string{},
+		},
+		"OnlyLayerAnnotations": {
+			reason: "Only labels recording layer annotations should be returned, sorted.",
+			labels: map[string]string{
+				Label("schema.python"):           "schema.python",
+				Label(PackageAnnotation):         PackageAnnotation,
+				"org.opencontainers.image.title": "example",
+			},
+			want: []string{PackageAnnotation, "schema.python"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			img, err := mutate.Config(empty.Image, v1.Config{Labels: tc.labels})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := LayerAnnotations(img)
+			if err != nil {
+				t.Fatalf("\n%s\nLayerAnnotations(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nLayerAnnotations(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/empty"
@@ -81,6 +82,20 @@ func Label(annotation string) string {
 	return fmt.Sprintf("%s:%s", AnnotationKey, annotation)
 }
 
+// ManifestAnnotationLabel constructs the label that AnnotateImage copies to
+// the manifest annotation with the given key.
+func ManifestAnnotationLabel(key string) string {
+	return ManifestAnnotationLabelPrefix + key
+}
+
+// IsReservedKey returns true if the given label or annotation key is used by
+// AnnotateImage, and so can't be set by users.
+func IsReservedKey(key string) bool {
+	return key == AnnotationKey ||
+		strings.HasPrefix(key, AnnotationKey+":") ||
+		strings.HasPrefix(key, ManifestAnnotationLabelPrefix)
+}
+
 // ImageFromFiles creates a v1.Image from arbitrary files on disk.
 // Each top-level directory at `root` is a separate layer.
 // The function performs no interpretation (parsing) of the files.
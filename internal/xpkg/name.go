@@ -41,6 +41,11 @@ const (
 
 	// AnnotationKey is the key value for xpkg annotations.
 	AnnotationKey string = "io.crossplane.xpkg"
+	// ManifestAnnotationLabelPrefix prefixes image config labels that
+	// AnnotateImage copies to manifest annotations. Annotations are stored
+	// as labels so that they survive being written to a tarball, which
+	// doesn't retain image manifests.
+	ManifestAnnotationLabelPrefix string = "io.upbound.xpkg.annotation:"
 	// PackageAnnotation is the annotation value used for the package.yaml
 	// layer.
 	PackageAnnotation string = "base"
@@ -0,0 +1,247 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package snapshot
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/golang/tools/lsp/protocol"
+	"github.com/golang/tools/span"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up/internal/xpkg/snapshot/validator"
+)
+
+const (
+	// listItem is the path element for an item in a YAML sequence.
+	listItem = "[]"
+
+	docSeparator = "---"
+)
+
+// Complete returns completions for the field names that can be set at the
+// given position in a file. Fields are completed using the schemas of the
+// types defined in the workspace and in the cached dependencies of the
+// project. Resources embedded in other resources, such as the resource
+// templates in a composition function's input, are completed using their own
+// type's schema.
+func (s *Snapshot) Complete(_ context.Context, uri span.URI, pos protocol.Position) ([]protocol.CompletionItem, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	details, ok := s.wsview.FileDetails()[uri]
+	if !ok {
+		return nil, errors.New(errInvalidFileURI)
+	}
+
+	sch := s.completionSchema(strings.Split(string(details.Body), "\n"), int(pos.Line), int(pos.Character))
+	if sch == nil {
+		return nil, nil
+	}
+
+	items := make([]protocol.CompletionItem, 0, len(sch.Properties))
+	for name, p := range sch.Properties {
+		item := protocol.CompletionItem{
+			Label:         name,
+			Kind:          protocol.FieldCompletion,
+			Documentation: p.Description,
+			InsertText:    name + ": ",
+		}
+		if len(p.Type) > 0 {
+			item.Detail = p.Type[0]
+		}
+		items = append(items, item)
+	}
+	slices.SortFunc(items, func(a, b protocol.CompletionItem) int {
+		return strings.Compare(a.Label, b.Label)
+	})
+	return items, nil
+}
+
+// completionSchema returns the schema of the YAML mapping that encloses the
+// given position, or nil if there isn't one or the position isn't where a
+// field name would be written.
+func (s *Snapshot) completionSchema(lines []string, line, char int) *spec.Schema {
+	if line >= len(lines) {
+		return nil
+	}
+	start, end := documentBounds(lines, line)
+
+	prefix := lines[line]
+	if char < len(prefix) {
+		prefix = prefix[:char]
+	}
+	if strings.Contains(prefix, ":") {
+		// We're writing a value, not a field name.
+		return nil
+	}
+
+	// Find the mappings that enclose the position, from the innermost out,
+	// and the path from each of them to the position.
+	indent := len(prefix) - len(strings.TrimLeft(prefix, " "))
+	if strings.TrimSpace(prefix) == "" {
+		// Editors may place the cursor past the end of a blank line.
+		indent = char
+	}
+	cur := mapping{indent: indent, start: start}
+	var steps []string
+	list := false
+	if item, ok := cutListItem(prefix, indent); ok {
+		// We're writing the first field of a new list item.
+		cur = mapping{indent: item, start: line}
+		steps = append(steps, listItem)
+		list = true
+	}
+	ms := []mapping{cur}
+	for i := line - 1; i >= start; i-- {
+		ind, text, ok := splitLine(lines[i])
+		if !ok {
+			continue
+		}
+		if item, ok := cutListItem(text, ind); ok {
+			if !list && item == indent {
+				// The innermost mapping is an item in a list.
+				ms[len(ms)-1].start = i
+				steps = append(steps, listItem)
+				list = true
+				indent = ind
+			}
+			continue
+		}
+		if ind > indent || (ind == indent && !list) {
+			continue
+		}
+		key, value, _ := strings.Cut(text, ":")
+		if strings.TrimSpace(value) != "" {
+			// Malformed YAML; we can't tell where we are.
+			return nil
+		}
+		if !list {
+			ms[len(ms)-1].start = i + 1
+		}
+		steps = append(steps, strings.TrimSpace(key))
+		ms = append(ms, mapping{indent: ind, depth: len(steps)})
+		list = false
+		indent = ind
+	}
+	ms[len(ms)-1].start = start
+
+	// The schema comes from the innermost mapping that's a resource.
+	for _, m := range ms {
+		fields := m.fields(lines[:end])
+		apiVersion, kind := fields["apiVersion"], fields["kind"]
+		if apiVersion == "" || kind == "" {
+			continue
+		}
+		v, ok := s.validators[schema.FromAPIVersionAndKind(apiVersion, kind)].(*validator.ObjectValidator)
+		if !ok {
+			return nil
+		}
+		path := slices.Clone(steps[:m.depth])
+		slices.Reverse(path)
+		return schemaAt(v.Schema(), path)
+	}
+	return nil
+}
+
+// A mapping is a YAML mapping that encloses a position in a document.
+type mapping struct {
+	// indent is the indentation of the mapping's keys.
+	indent int
+	// start is the first line of the mapping.
+	start int
+	// depth is the number of path elements from the mapping to the position.
+	depth int
+}
+
+// fields returns the scalar fields of the mapping.
+func (m mapping) fields(lines []string) map[string]string {
+	fields := make(map[string]string)
+	for i := m.start; i < len(lines); i++ {
+		ind, text, ok := splitLine(lines[i])
+		if !ok {
+			continue
+		}
+		if item, ok := cutListItem(text, ind); ok && i == m.start && item == m.indent {
+			// The first field of a list item.
+			ind, text = item, text[item-ind:]
+		}
+		if ind < m.indent {
+			break
+		}
+		if ind > m.indent {
+			continue
+		}
+		if key, value, ok := strings.Cut(text, ":"); ok {
+			fields[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"'`)
+		}
+	}
+	return fields
+}
+
+// schemaAt returns the schema at the given path in s.
+func schemaAt(s *spec.Schema, path []string) *spec.Schema {
+	for _, p := range path {
+		if s == nil {
+			return nil
+		}
+		if p == listItem {
+			if s.Items == nil {
+				return nil
+			}
+			s = s.Items.Schema
+			continue
+		}
+		prop, ok := s.Properties[p]
+		if !ok {
+			return nil
+		}
+		s = &prop
+	}
+	return s
+}
+
+// documentBounds returns the first line of the YAML document containing the
+// given line, and the line after its last.
+func documentBounds(lines []string, line int) (int, int) {
+	start, end := 0, len(lines)
+	for i := line - 1; i >= 0; i-- {
+		if strings.HasPrefix(lines[i], docSeparator) {
+			start = i + 1
+			break
+		}
+	}
+	for i := line + 1; i < len(lines); i++ {
+		if strings.HasPrefix(lines[i], docSeparator) {
+			end = i
+			break
+		}
+	}
+	return start, end
+}
+
+// splitLine returns the indentation and text of a line, and false if the line
+// is blank or a comment.
+func splitLine(line string) (int, string, bool) {
+	text := strings.TrimLeft(line, " ")
+	if t := strings.TrimSpace(text); t == "" || strings.HasPrefix(t, "#") {
+		return 0, "", false
+	}
+	return len(line) - len(text), text, true
+}
+
+// cutListItem returns the indentation of the content of a list item, if text
+// at the given indentation starts one.
+func cutListItem(text string, indent int) (int, bool) {
+	text = strings.TrimLeft(text, " ")
+	if text != "-" && !strings.HasPrefix(text, "- ") {
+		return 0, false
+	}
+	rest := text[1:]
+	return indent + 1 + len(rest) - len(strings.TrimLeft(rest, " ")), true
+}
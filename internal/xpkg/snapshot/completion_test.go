@@ -0,0 +1,188 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package snapshot
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/golang/tools/lsp/protocol"
+	"github.com/golang/tools/span"
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+
+	"github.com/upbound/up/internal/xpkg/workspace"
+)
+
+func TestComplete(t *testing.T) {
+	forProviderFields := []string{
+		"certificateAuthorityARN",
+		"certificateAuthorityARNRef",
+		"certificateAuthorityARNSelector",
+		"certificateTransparencyLoggingPreference",
+		"domainName",
+		"domainValidationOptions",
+		"region",
+		"renewCertificate",
+		"subjectAlternativeNames",
+		"tags",
+		"validationMethod",
+	}
+
+	type args struct {
+		file string
+		pos  protocol.Position
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   []string
+	}{
+		"TopLevel": {
+			reason: "Completing at the top level of a resource should return its top level fields.",
+			args: args{
+				file: `apiVersion: acm.aws.crossplane.io/v1alpha1
+kind: Certificate
+
+`,
+				pos: protocol.Position{Line: 2, Character: 0},
+			},
+			want: []string{"apiVersion", "kind", "metadata", "spec", "status"},
+		},
+		"Nested": {
+			reason: "Completing inside a field should return the fields of its schema.",
+			args: args{
+				file: `apiVersion: acm.aws.crossplane.io/v1alpha1
+kind: Certificate
+spec:
+  forProvider:
+    region: us-east-1
+    do
+`,
+				pos: protocol.Position{Line: 5, Character: 6},
+			},
+			want: forProviderFields,
+		},
+		"ListItem": {
+			reason: "Completing inside a list item should return the fields of the list's item schema.",
+			args: args{
+				file: `apiVersion: acm.aws.crossplane.io/v1alpha1
+kind: Certificate
+spec:
+  forProvider:
+    tags:
+    - key: a
+
+`,
+				pos: protocol.Position{Line: 6, Character: 6},
+			},
+			want: []string{"key", "value"},
+		},
+		"NewListItem": {
+			reason: "Completing the first field of a new list item should return the fields of the list's item schema.",
+			args: args{
+				file: `apiVersion: acm.aws.crossplane.io/v1alpha1
+kind: Certificate
+spec:
+  forProvider:
+    tags:
+    - 
+`,
+				pos: protocol.Position{Line: 5, Character: 6},
+			},
+			want: []string{"key", "value"},
+		},
+		"EmbeddedResource": {
+			reason: "Completing inside a resource embedded in another should use the embedded resource's schema.",
+			args: args{
+				file: `apiVersion: apiextensions.crossplane.io/v1
+kind: Composition
+spec:
+  pipeline:
+  - step: patch-and-transform
+    input:
+      apiVersion: pt.fn.crossplane.io/v1beta1
+      kind: Resources
+      resources:
+      - name: certificate
+        base:
+          apiVersion: acm.aws.crossplane.io/v1alpha1
+          kind: Certificate
+          spec:
+            forProvider:
+
+`,
+				pos: protocol.Position{Line: 15, Character: 14},
+			},
+			want: forProviderFields,
+		},
+		"SecondDocument": {
+			reason: "Completing in a later document of a file should use that document's type.",
+			args: args{
+				file: `apiVersion: example.org/v1
+kind: Unknown
+---
+apiVersion: acm.aws.crossplane.io/v1alpha1
+kind: Certificate
+spec:
+  forProvider:
+    tags:
+    - key: a
+
+`,
+				pos: protocol.Position{Line: 9, Character: 6},
+			},
+			want: []string{"key", "value"},
+		},
+		"Value": {
+			reason: "Completing a field's value should return no completions.",
+			args: args{
+				file: `apiVersion: acm.aws.crossplane.io/v1alpha1
+kind: Certificate
+spec:
+  deletionPolicy: 
+`,
+				pos: protocol.Position{Line: 3, Character: 18},
+			},
+		},
+		"UnknownType": {
+			reason: "Completing inside a resource of an unknown type should return no completions.",
+			args: args{
+				file: `apiVersion: example.org/v1
+kind: Unknown
+
+`,
+				pos: protocol.Position{Line: 2, Character: 0},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			_ = fs.Mkdir("/ws", os.ModePerm)
+			_ = afero.WriteFile(fs, "/ws/crd.yaml", testSingleVersionCRD, os.ModePerm)
+			_ = afero.WriteFile(fs, "/ws/file.yaml", []byte(tc.args.file), os.ModePerm)
+			ws, _ := workspace.New("/ws", workspace.WithFS(fs), workspace.WithPermissiveParser())
+
+			factory, _ := NewFactory("/ws", WithDepManager(NewMockDepManager()))
+			snap, err := factory.New(context.Background(), WithWorkspace(ws))
+			if err != nil {
+				t.Fatalf("\n%s\nNew(...): unexpected error: %v", tc.reason, err)
+			}
+
+			items, err := snap.Complete(context.Background(), span.URIFromPath("/ws/file.yaml"), tc.args.pos)
+			if err != nil {
+				t.Fatalf("\n%s\nComplete(...): unexpected error: %v", tc.reason, err)
+			}
+			var got []string
+			for _, i := range items {
+				got = append(got, i.Label)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nComplete(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
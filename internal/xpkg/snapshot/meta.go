@@ -212,7 +212,10 @@ func NewVersionValidator(manager DepManager) *VersionValidator {
 	}
 }
 
-// validate validates the dependency versions in a meta file.
+// validate validates the dependency versions in a meta file. Dependencies that
+// aren't in the cache are reported as information rather than errors, since
+// they only mean that their types can't be validated until the cache is
+// updated.
 func (v *VersionValidator) validate(ctx context.Context, i int, d v1beta1.Dependency) error {
 	// check explicit version
 	vers, err := v.manager.Versions(ctx, d)
@@ -222,14 +225,16 @@ func (v *VersionValidator) validate(ctx context.Context, i int, d v1beta1.Depend
 	}
 	if len(vers) == 0 {
 		return &validator.ValidationError{
-			Name:    fmt.Sprintf(dependsOnPathFmt, i, strings.ToLower(string(*d.Type))),
-			Message: fmt.Sprintf(errPackageDNEFmt, d.Package),
+			Name:     fmt.Sprintf(dependsOnPathFmt, i, strings.ToLower(string(*d.Type))),
+			Message:  fmt.Sprintf(errPackageDNEFmt, d.Package),
+			TypeCode: validator.InfoTypeCode,
 		}
 	}
 	if !versionMatch(d.Constraints, vers) {
 		return &validator.ValidationError{
-			Name:    fmt.Sprintf(dependsOnPathFmt, i, versionField),
-			Message: fmt.Sprintf(errVersionDENFmt, d.Constraints),
+			Name:     fmt.Sprintf(dependsOnPathFmt, i, versionField),
+			Message:  fmt.Sprintf(errVersionDENFmt, d.Constraints),
+			TypeCode: validator.InfoTypeCode,
 		}
 	}
 	return nil
@@ -514,6 +514,8 @@ func validationDiagnostics(res *validate.Result, n ast.Node, gvk schema.GroupVer
 				switch c := e.code; {
 				case c == validator.WarningTypeCode:
 					sev = protocol.SeverityWarning
+				case c == validator.InfoTypeCode:
+					sev = protocol.SeverityInformation
 				case c == validator.ErrorTypeCode:
 					sev = protocol.SeverityError
 				case c >= 422:
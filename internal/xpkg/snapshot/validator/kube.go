@@ -6,6 +6,7 @@ package validator
 import (
 	"context"
 
+	"k8s.io/kube-openapi/pkg/validation/spec"
 	"k8s.io/kube-openapi/pkg/validation/validate"
 )
 
@@ -31,3 +32,12 @@ type UsingContext struct {
 func (uc *UsingContext) Validate(_ context.Context, data any) *validate.Result {
 	return uc.k.Validate(data)
 }
+
+// Schema returns the OpenAPI schema used by the underlying kubeValidator, or
+// nil if it isn't a schema validator.
+func (uc *UsingContext) Schema() *spec.Schema {
+	if sv, ok := uc.k.(*validate.SchemaValidator); ok {
+		return sv.Schema
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ package validator
 import (
 	"context"
 
+	"k8s.io/kube-openapi/pkg/validation/spec"
 	"k8s.io/kube-openapi/pkg/validation/validate"
 )
 
@@ -14,6 +15,8 @@ import (
 const (
 	// WarningTypeCode indicates a warning is being returned.
 	WarningTypeCode = 100
+	// InfoTypeCode indicates information is being returned.
+	InfoTypeCode = 200
 	// ErrorTypeCode indicates an error is being returned.
 	ErrorTypeCode = 500
 
@@ -82,3 +85,14 @@ func (o *ObjectValidator) Validate(ctx context.Context, data any) *validate.Resu
 func (o *ObjectValidator) AddToChain(validators ...Validator) {
 	o.chain = append(o.chain, validators...)
 }
+
+// Schema returns the OpenAPI schema the ObjectValidator validates objects
+// against, or nil if it doesn't validate against a schema.
+func (o *ObjectValidator) Schema() *spec.Schema {
+	for _, v := range o.chain {
+		if s, ok := v.(interface{ Schema() *spec.Schema }); ok && s.Schema() != nil {
+			return s.Schema()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package xpls
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/golang/tools/lsp/protocol"
+	"github.com/golang/tools/span"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up/internal/xpkg"
+	"github.com/upbound/up/internal/xpkg/snapshot"
+)
+
+const (
+	errInvalidPath  = "cannot read path"
+	errNotInProject = "%s is not a YAML file in the project"
+)
+
+// A Diagnostic is a problem found in a file by the language server's analysis.
+type Diagnostic struct {
+	// Path is the path of the file the problem was found in.
+	Path string `json:"path"`
+	// Line and Column are the 1-based position of the problem in the file.
+	Line     uint32 `json:"line"`
+	Column   uint32 `json:"column"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// IsError returns true if the diagnostic is an error, rather than a warning or
+// information.
+func (d Diagnostic) IsError() bool {
+	return d.Severity == severityName(protocol.SeverityError)
+}
+
+// Diagnose returns the diagnostics the language server would publish for the
+// file at path, or for every file in the directory at path. Files are analyzed
+// in the context of the project that contains them, so that types defined
+// elsewhere in the project or by its dependencies are understood. The
+// diagnostics are sorted by path and position.
+func Diagnose(ctx context.Context, path string, opts ...snapshot.FactoryOption) ([]Diagnostic, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, errors.Wrap(err, errInvalidPath)
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrap(err, errInvalidPath)
+	}
+	dir := path
+	if !fi.IsDir() {
+		dir = filepath.Dir(path)
+	}
+
+	f, err := snapshot.NewFactory(projectRoot(dir), opts...)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := f.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make(map[span.URI][]protocol.Diagnostic)
+	if fi.IsDir() {
+		all, err := snap.ValidateAllFiles(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for uri, diags := range all {
+			if within(uri.Filename(), path) {
+				results[uri] = diags
+			}
+		}
+	} else {
+		uri := span.URIFromPath(path)
+		diags, err := snap.Validate(ctx, uri)
+		if err != nil {
+			return nil, errors.Errorf(errNotInProject, path)
+		}
+		results[uri] = diags
+	}
+
+	out := make([]Diagnostic, 0)
+	for uri, diags := range results {
+		for _, d := range diags {
+			out = append(out, Diagnostic{
+				Path:     uri.Filename(),
+				Line:     d.Range.Start.Line + 1,
+				Column:   d.Range.Start.Character + 1,
+				Severity: severityName(d.Severity),
+				Message:  d.Message,
+			})
+		}
+	}
+	slices.SortFunc(out, func(a, b Diagnostic) int {
+		if c := strings.Compare(a.Path, b.Path); c != 0 {
+			return c
+		}
+		if a.Line != b.Line {
+			return int(a.Line) - int(b.Line)
+		}
+		return int(a.Column) - int(b.Column)
+	})
+	return out, nil
+}
+
+// projectRoot returns the root of the project containing dir, which is the
+// nearest directory containing a package metadata file. If there is none, dir
+// is treated as the root.
+func projectRoot(dir string) string {
+	for d := dir; ; d = filepath.Dir(d) {
+		if _, err := os.Stat(filepath.Join(d, xpkg.MetaFile)); err == nil {
+			return d
+		}
+		if filepath.Dir(d) == d {
+			return dir
+		}
+	}
+}
+
+// within returns true if path is dir or is inside it.
+func within(path, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func severityName(s protocol.DiagnosticSeverity) string {
+	switch s {
+	case protocol.SeverityError:
+		return "error"
+	case protocol.SeverityWarning:
+		return "warning"
+	case protocol.SeverityInformation:
+		return "info"
+	case protocol.SeverityHint:
+		return "hint"
+	default:
+		return "unknown"
+	}
+}
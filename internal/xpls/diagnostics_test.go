@@ -0,0 +1,131 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package xpls
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	testMeta = `apiVersion: meta.pkg.crossplane.io/v1
+kind: Configuration
+metadata:
+  name: test
+`
+	testXRD = `apiVersion: apiextensions.crossplane.io/v1
+kind: CompositeResourceDefinition
+metadata:
+  name: xbuckets.example.com
+spec:
+  group: example.com
+  names:
+    kind: XBucket
+    plural: xbuckets
+  versions:
+  - name: v1alpha1
+    served: true
+    referenceable: true
+    schema:
+      openAPIV3Schema:
+        type: object
+        properties:
+          spec:
+            type: object
+            properties:
+              versioning:
+                type: boolean
+`
+	testXR = `apiVersion: example.com/v1alpha1
+kind: XBucket
+metadata:
+  name: test
+spec:
+  versioning: "yes"
+`
+	testUnknown = `apiVersion: example.com/v1alpha1
+kind: Unknown
+metadata:
+  name: test
+`
+)
+
+func TestDiagnose(t *testing.T) {
+	// Isolate the dependency cache.
+	t.Setenv("HOME", t.TempDir())
+
+	root := t.TempDir()
+	files := map[string]string{
+		"crossplane.yaml":         testMeta,
+		"apis/xrd.yaml":           testXRD,
+		"examples/xr.yaml":        testXR,
+		"examples/other/unk.yaml": testUnknown,
+	}
+	for name, content := range files {
+		p := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	xrErr := Diagnostic{
+		Path:     filepath.Join(root, "examples/xr.yaml"),
+		Line:     6,
+		Column:   15,
+		Severity: "error",
+		Message:  `spec.versioning in body must be of type boolean: "string" (example.com/v1alpha1, Kind=XBucket)`,
+	}
+	unkWarn := Diagnostic{
+		Path:     filepath.Join(root, "examples/other/unk.yaml"),
+		Line:     1,
+		Column:   13,
+		Severity: "warning",
+		Message:  "no definition found for resource (example.com/v1alpha1, Kind=Unknown)",
+	}
+
+	cases := map[string]struct {
+		reason string
+		path   string
+		want   []Diagnostic
+		err    bool
+	}{
+		"File": {
+			reason: "Diagnosing a file should use types defined elsewhere in its project.",
+			path:   filepath.Join(root, "examples/xr.yaml"),
+			want:   []Diagnostic{xrErr},
+		},
+		"Directory": {
+			reason: "Diagnosing a directory should return the diagnostics for every file in it, sorted by path.",
+			path:   filepath.Join(root, "examples"),
+			want:   []Diagnostic{unkWarn, xrErr},
+		},
+		"NoProblems": {
+			reason: "Diagnosing a valid file should return no diagnostics.",
+			path:   filepath.Join(root, "apis/xrd.yaml"),
+			want:   []Diagnostic{},
+		},
+		"NotExist": {
+			reason: "Diagnosing a path that doesn't exist should return an error.",
+			path:   filepath.Join(root, "nope.yaml"),
+			err:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Diagnose(t.Context(), tc.path)
+			if (err != nil) != tc.err {
+				t.Fatalf("\n%s\nDiagnose(...): want error %t, got %v", tc.reason, tc.err, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nDiagnose(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
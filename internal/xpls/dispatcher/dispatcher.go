@@ -14,8 +14,9 @@ import (
 )
 
 const (
-	errParseSaveParameters   = "failed to parse document save parameters"
-	errParseChangeParameters = "failed to parse document change parameters"
+	errParseSaveParameters       = "failed to parse document save parameters"
+	errParseChangeParameters     = "failed to parse document change parameters"
+	errParseCompletionParameters = "failed to parse completion parameters"
 )
 
 // Server defines the set of LSP methods we currently support.
@@ -25,6 +26,7 @@ type Server interface {
 	DidSave(context.Context, *protocol.DidSaveTextDocumentParams)
 	DidChangeWatchedFiles(context.Context, *protocol.DidChangeWatchedFilesParams)
 	Initialize(context.Context, *jsonrpc2.Conn, jsonrpc2.ID, *protocol.InitializeParams)
+	Completion(context.Context, jsonrpc2.ID, *protocol.CompletionParams)
 }
 
 // Dispatcher is responsible for routing JSONPPC request events to the
@@ -100,6 +102,14 @@ func (d *Dispatcher) Dispatch(ctx context.Context, server Server, conn *jsonrpc2
 		}
 		server.DidSave(ctx, &params)
 		return
+	case "textDocument/completion":
+		var params protocol.CompletionParams
+		if err := json.Unmarshal(*r.Params, &params); err != nil {
+			d.log.Debug(errParseCompletionParameters)
+			break
+		}
+		server.Completion(ctx, r.ID, &params)
+		return
 	case "workspace/didChangeWatchedFiles":
 		var params protocol.DidChangeWatchedFilesParams
 		if err := json.Unmarshal(*r.Params, &params); err != nil {
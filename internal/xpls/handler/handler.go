@@ -10,6 +10,7 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 
+	"github.com/upbound/up/internal/xpkg/dep/manager"
 	"github.com/upbound/up/internal/xpls/dispatcher"
 	"github.com/upbound/up/internal/xpls/server"
 )
@@ -17,6 +18,7 @@ import (
 // A Handler handles LSP requests.
 type Handler struct {
 	log        logging.Logger
+	m          *manager.Manager
 	dispatcher *dispatcher.Dispatcher
 	server     *server.Server
 }
@@ -27,7 +29,15 @@ func New(opts ...Option) (*Handler, error) {
 		log: logging.NewNopLogger(),
 	}
 
-	server, err := server.New(server.WithLogger(h.log))
+	for _, o := range opts {
+		o(h)
+	}
+
+	sopts := []server.Option{server.WithLogger(h.log)}
+	if h.m != nil {
+		sopts = append(sopts, server.WithDepManager(h.m))
+	}
+	server, err := server.New(sopts...)
 	if err != nil {
 		return nil, err
 	}
@@ -36,10 +46,6 @@ func New(opts ...Option) (*Handler, error) {
 
 	h.dispatcher = dispatcher.New(dispatcher.WithLogger(h.log))
 
-	for _, o := range opts {
-		o(h)
-	}
-
 	return h, nil
 }
 
@@ -53,6 +59,14 @@ func WithLogger(l logging.Logger) Option {
 	}
 }
 
+// WithDepManager sets the dependency manager the handler's server uses to
+// load the schemas of a project's dependencies.
+func WithDepManager(m *manager.Manager) Option {
+	return func(h *Handler) {
+		h.m = m
+	}
+}
+
 // Handle handles LSP requests. It panics if we cannot initialize the workspace.
 func (h *Handler) Handle(ctx context.Context, conn *jsonrpc2.Conn, r *jsonrpc2.Request) { //nolint:gocyclo
 	h.dispatcher.Dispatch(ctx, h.server, conn, r)
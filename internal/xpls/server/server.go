@@ -32,8 +32,10 @@ const (
 	newVersionMsgFmt     = `Version %s of up is now available. Current version is %s.
 	Update for the latest features!`
 
+	errComplete           = "failed to complete fields"
 	errParseWorkspace     = "failed to parse workspace"
 	errPublishDiagnostics = "failed to publish diagnostics"
+	errReplyCompletion    = "failed to reply to completion request"
 	errRegisteringWatches = "failed to register workspace watchers"
 	errValidateMeta       = "failed to validate crossplane.yaml file in workspace"
 	errShowMessage        = "failed to show message"
@@ -61,21 +63,26 @@ func New(opts ...Option) (*Server, error) {
 		log: logging.NewNopLogger(),
 	}
 
-	interval, err := time.ParseDuration(defaultWatchInterval)
-	if err != nil {
-		return nil, err
+	for _, o := range opts {
+		o(s)
 	}
 
-	// TODO(@tnthornton) supply cache root from Config here.
-	m, err := manager.New(
-		manager.WithLogger(s.log),
-		manager.WithWatchInterval(&interval),
-	)
-	if err != nil {
-		return nil, err
-	}
+	if s.m == nil {
+		interval, err := time.ParseDuration(defaultWatchInterval)
+		if err != nil {
+			return nil, err
+		}
 
-	s.m = m
+		m, err := manager.New(
+			manager.WithLogger(s.log),
+			manager.WithWatchInterval(&interval),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		s.m = m
+	}
 
 	s.i = version.NewInformer(version.WithLogger(s.log))
 
@@ -93,6 +100,15 @@ func WithLogger(l logging.Logger) Option {
 	}
 }
 
+// WithDepManager overrides the default dependency manager for the Server. The
+// manager's cache supplies the schemas of the project's dependencies, which
+// are used to complete and validate the resources they define.
+func WithDepManager(m *manager.Manager) Option {
+	return func(s *Server) {
+		s.m = m
+	}
+}
+
 // Initialize handles calls to Initialize.
 func (s *Server) Initialize(ctx context.Context, conn *jsonrpc2.Conn, id jsonrpc2.ID, params *protocol.InitializeParams) {
 	// TODO(@tnthornton) this is the only place that the passed in conn is used.
@@ -129,6 +145,7 @@ func (s *Server) Initialize(ctx context.Context, conn *jsonrpc2.Conn, id jsonrpc
 			TextDocumentSync: &lsp.TextDocumentSyncOptionsOrKind{
 				Kind: &kind,
 			},
+			CompletionProvider: &lsp.CompletionOptions{},
 		},
 	}
 
@@ -246,6 +263,24 @@ func (s *Server) DidChangeWatchedFiles(ctx context.Context, params *protocol.Did
 	}
 }
 
+// Completion handles calls to Completion.
+func (s *Server) Completion(ctx context.Context, id jsonrpc2.ID, params *protocol.CompletionParams) {
+	s.mu.RLock()
+	snap := s.snap
+	s.mu.RUnlock()
+
+	items, err := snap.Complete(ctx, params.TextDocument.URI.SpanURI(), params.Position)
+	if err != nil {
+		s.log.Debug(errComplete, "error", err)
+	}
+	if items == nil {
+		items = []protocol.CompletionItem{}
+	}
+	if err := s.conn.Reply(ctx, id, &protocol.CompletionList{Items: items}); err != nil {
+		s.log.Debug(errReplyCompletion, "error", err)
+	}
+}
+
 func (s *Server) publishDiagnostics(ctx context.Context, params *protocol.PublishDiagnosticsParams) {
 	if err := s.conn.Notify(ctx, "textDocument/publishDiagnostics", params); err != nil {
 		s.log.Debug(errPublishDiagnostics, "error", err)
@@ -10,10 +10,12 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"path/filepath"
 	"slices"
 	"strings"
+	"sync"
 
 	"github.com/spf13/afero"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -53,6 +55,16 @@ type Options struct {
 	// Resource types to exclude from the export.
 	ExcludeResources []string // default: none
 
+	// Filter selects the resources to export by type and label.
+	Filter Filter // default: all resources
+
+	// Concurrency is the maximum number of resource types to export at once.
+	// Values below 2 export one type at a time.
+	Concurrency int // default: 1
+	// Progress, if set, is called as the export of each resource type starts
+	// and finishes. It may be called concurrently.
+	Progress func(p ExportProgress)
+
 	// PauseBeforeExport pauses all managed resources before starting the export process.
 	PauseBeforeExport bool // default: false
 }
@@ -66,6 +78,8 @@ type ControlPlaneStateExporter struct {
 	resourceMapper  meta.RESTMapper
 
 	options Options
+
+	counts map[string]int
 }
 
 // NewControlPlaneStateExporter returns a new ControlPlaneStateExporter.
@@ -148,6 +162,10 @@ func (e *ControlPlaneStateExporter) Export(ctx context.Context) error {
 		if slices.Contains(e.options.ExcludeResources, crd.Name) {
 			continue
 		}
+		// - Types not selected by the filter - Specified by the user.
+		if !e.options.Filter.Types.Matches(schema.GroupVersionKind{Group: crd.Spec.Group, Kind: crd.Spec.Names.Kind}) {
+			continue
+		}
 
 		exportList = append(exportList, crd)
 	}
@@ -161,34 +179,39 @@ func (e *ControlPlaneStateExporter) Export(ctx context.Context) error {
 	s = migration.DefaultSpinner(exportCRsMsg)
 	s.Start()
 
+	var mu sync.Mutex
 	crCounts := make(map[string]int, len(exportList))
 
-	for i, crd := range exportList {
-		inExportCRsMsg := fmt.Sprintf("( %d / %d ) Exporting Crossplane resource %s...", i+1, len(exportList), crd.GetName())
-		s.UpdateText(inExportCRsMsg)
+	done := 0
+	err = forEachConcurrently(e.options.Concurrency, exportList, func(crd apiextensionsv1.CustomResourceDefinition) error {
+		e.reportProgress(ExportProgress{Resource: crd.GetName(), State: ExportStateStarted})
 
-		var inCount int
-		var gvr schema.GroupVersionResource
+		gvr, count, err := e.exportCrossplaneResources(ctx, crd, fs, tmpDir)
 
-		err := retry.OnError(retry.DefaultRetry, net.IsConnectionRefused, func() (exportErr error) {
-			gvr, inCount, exportErr = e.exportCrossplaneResources(ctx, crd, fs, tmpDir)
-			return exportErr
-		})
+		mu.Lock()
+		defer mu.Unlock()
+		done++
+		s.UpdateText(fmt.Sprintf("( %d / %d ) Exported Crossplane resource %s...", done, len(exportList), crd.GetName()))
 
 		if kerrors.IsNotFound(err) || meta.IsNoMatchError(err) {
 			// These errors mean something is in-flight, either being created or
 			// deleted, so we ignore it and continue with the next one.
-			s.UpdateText(inExportCRsMsg + " skipped, resource not found.")
-			continue
+			e.reportProgress(ExportProgress{Resource: crd.GetName(), State: ExportStateSkipped})
+			return nil
 		}
-
 		if err != nil {
-			s.UpdateText(inExportCRsMsg + stepFailed)
-			s.Fail()
+			e.reportProgress(ExportProgress{Resource: crd.GetName(), State: ExportStateFailed, Err: err})
 			return errors.Wrapf(err, "cannot export Crossplane resource %q", crd.GetName())
 		}
 
-		crCounts[gvr.GroupResource().String()] = inCount
+		crCounts[gvr.GroupResource().String()] = count
+		e.reportProgress(ExportProgress{Resource: crd.GetName(), State: ExportStateDone, Count: count})
+		return nil
+	})
+	if err != nil {
+		s.UpdateText(exportCRsMsg + stepFailed)
+		s.Fail()
+		return err
 	}
 
 	total := 0
@@ -201,40 +224,46 @@ func (e *ControlPlaneStateExporter) Export(ctx context.Context) error {
 	//////////////////////
 
 	// Export native resources.
-	exportNativeMsg := fmt.Sprintf("Exporting %d native resources...", len(e.options.IncludeExtraResources))
+	extra := slices.Sorted(maps.Keys(e.extraResources()))
+	exportNativeMsg := fmt.Sprintf("Exporting %d native resources...", len(extra))
 	s = migration.DefaultSpinner(exportNativeMsg)
 	s.Start()
 
-	nativeCounts := make(map[string]int, len(e.options.IncludeExtraResources))
+	nativeCounts := make(map[string]int, len(extra))
 
 	// In addition to the Crossplane resources, we also need to export some native resources. These are
 	// defaulted as "namespaces", "configmaps" and "secrets". However, the user can also specify additional
 	// resources to include or exclude the default ones.
-	for r := range e.extraResources() {
-		inExportNativeMsg := fmt.Sprintf("( %d / %d ) Exporting native resource %s...", len(nativeCounts)+1, len(e.options.IncludeExtraResources), r)
-		s.UpdateText(inExportNativeMsg)
+	done = 0
+	err = forEachConcurrently(e.options.Concurrency, extra, func(r string) error {
+		e.reportProgress(ExportProgress{Resource: r, State: ExportStateStarted})
 
-		var inCount int
+		count, err := e.exportNativeResource(ctx, r, fs, tmpDir)
 
-		err := retry.OnError(retry.DefaultRetry, net.IsConnectionRefused, func() (exportErr error) {
-			inCount, exportErr = e.exportNativeResource(ctx, r, fs, tmpDir)
-			return exportErr
-		})
+		mu.Lock()
+		defer mu.Unlock()
+		done++
+		s.UpdateText(fmt.Sprintf("( %d / %d ) Exported native resource %s...", done, len(extra), r))
 
 		if kerrors.IsNotFound(err) || meta.IsNoMatchError(err) {
 			// These errors mean something is in-flight, either being created or
 			// deleted, so we ignore it and continue with the next one.
-			s.UpdateText(inExportNativeMsg + " skipped, resource not found.")
-			continue
+			e.reportProgress(ExportProgress{Resource: r, State: ExportStateSkipped})
+			return nil
 		}
-
 		if err != nil {
-			s.UpdateText(inExportNativeMsg + stepFailed)
-			s.Fail()
+			e.reportProgress(ExportProgress{Resource: r, State: ExportStateFailed, Err: err})
 			return errors.Wrapf(err, "cannot export native resource %q", r)
 		}
 
-		nativeCounts[r] = inCount
+		nativeCounts[r] = count
+		e.reportProgress(ExportProgress{Resource: r, State: ExportStateDone, Count: count})
+		return nil
+	})
+	if err != nil {
+		s.UpdateText(exportNativeMsg + stepFailed)
+		s.Fail()
+		return err
 	}
 
 	total = 0
@@ -244,6 +273,10 @@ func (e *ControlPlaneStateExporter) Export(ctx context.Context) error {
 
 	s.UpdateText(exportNativeMsg + fmt.Sprintf("%d resources exported! 📤", total))
 	s.Success()
+
+	e.counts = make(map[string]int, len(crCounts)+len(nativeCounts))
+	maps.Copy(e.counts, crCounts)
+	maps.Copy(e.counts, nativeCounts)
 	//////////////////////
 
 	// Export a top level metadata file. This file contains details like when the export was done,
@@ -321,6 +354,18 @@ func (e *ControlPlaneStateExporter) exportNativeResource(ctx context.Context, r
 	return count, nil
 }
 
+func (e *ControlPlaneStateExporter) reportProgress(p ExportProgress) {
+	if e.options.Progress != nil {
+		e.options.Progress(p)
+	}
+}
+
+// Counts returns the number of resources exported by Export for each type,
+// keyed by group resource, e.g. "buckets.s3.aws.upbound.io".
+func (e *ControlPlaneStateExporter) Counts() map[string]int {
+	return e.counts
+}
+
 // IncludedExtraResource adds a resource to include.
 func (e *ControlPlaneStateExporter) IncludedExtraResource(gr string) bool {
 	for r := range e.extraResources() {
@@ -6,12 +6,16 @@ package exporter
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/dynamic"
 )
 
@@ -19,6 +23,14 @@ const (
 	defaultPageSize = 500
 )
 
+//nolint:gochecknoglobals // Constant.
+var defaultFetchBackoff = wait.Backoff{
+	Duration: 500 * time.Millisecond, // Initial retry delay
+	Factor:   2.0,                    // Doubles each time
+	Jitter:   0.1,                    // 10% random jitter
+	Steps:    5,                      // Maximum retries
+}
+
 type ResourceFetcher interface {
 	FetchResources(ctx context.Context, gvr schema.GroupVersionResource) ([]unstructured.Unstructured, error)
 }
@@ -26,9 +38,12 @@ type ResourceFetcher interface {
 type UnstructuredFetcher struct {
 	kube     dynamic.Interface
 	pageSize int64
+	backoff  wait.Backoff
 
 	includedNamespaces map[string]struct{}
 	excludedNamespaces map[string]struct{}
+
+	filter Filter
 }
 
 func NewUnstructuredFetcher(kube dynamic.Interface, opts Options) *UnstructuredFetcher {
@@ -44,9 +59,12 @@ func NewUnstructuredFetcher(kube dynamic.Interface, opts Options) *UnstructuredF
 	return &UnstructuredFetcher{
 		kube:     kube,
 		pageSize: defaultPageSize,
+		backoff:  defaultFetchBackoff,
 
 		includedNamespaces: inc,
 		excludedNamespaces: exc,
+
+		filter: opts.Filter,
 	}
 }
 
@@ -55,10 +73,7 @@ func (e *UnstructuredFetcher) FetchResources(ctx context.Context, gvr schema.Gro
 
 	continueToken := ""
 	for {
-		l, err := e.kube.Resource(gvr).List(ctx, v1.ListOptions{
-			Limit:    e.pageSize,
-			Continue: continueToken,
-		})
+		l, err := e.listPage(ctx, gvr, continueToken)
 		if err != nil {
 			return nil, errors.Wrapf(err, "cannot list %q resources", gvr.GroupResource())
 		}
@@ -76,6 +91,42 @@ func (e *UnstructuredFetcher) FetchResources(ctx context.Context, gvr schema.Gro
 	return resources, nil
 }
 
+// listPage lists a page of resources, retrying with backoff when the API
+// server is unavailable or rate limiting requests.
+func (e *UnstructuredFetcher) listPage(ctx context.Context, gvr schema.GroupVersionResource, continueToken string) (*unstructured.UnstructuredList, error) {
+	var (
+		l *unstructured.UnstructuredList
+		// lastErr records the most recent retryable error, so that we can
+		// surface it rather than a bare timeout once retries are exhausted.
+		lastErr error
+	)
+	err := wait.ExponentialBackoffWithContext(ctx, e.backoff, func(ctx context.Context) (bool, error) {
+		var err error
+		l, err = e.kube.Resource(gvr).List(ctx, v1.ListOptions{
+			Limit:    e.pageSize,
+			Continue: continueToken,
+		})
+		if isRetryable(err) {
+			lastErr = err
+			return false, nil // Retry
+		}
+		return err == nil, err
+	})
+	if wait.Interrupted(err) && lastErr != nil {
+		err = lastErr
+	}
+	return l, err
+}
+
+// isRetryable returns true if err is a transient API error.
+func isRetryable(err error) bool {
+	return net.IsConnectionRefused(err) ||
+		kerrors.IsTooManyRequests(err) ||
+		kerrors.IsServerTimeout(err) ||
+		kerrors.IsTimeout(err) ||
+		kerrors.IsServiceUnavailable(err)
+}
+
 func (e *UnstructuredFetcher) namespaceInScope(namespace string) bool {
 	if len(e.includedNamespaces) > 0 {
 		if _, ok := e.includedNamespaces[namespace]; !ok {
@@ -99,6 +150,11 @@ func (e *UnstructuredFetcher) shouldSkip(r unstructured.Unstructured) bool { //
 		return true
 	}
 
+	if !e.filter.Matches(r) {
+		// Not selected by the user's filter.
+		return true
+	}
+
 	if r.GetKind() == "ConfigMap" && r.GetName() == "kube-root-ca.crt" {
 		// This is cluster-specific and should not be exported.
 		return true
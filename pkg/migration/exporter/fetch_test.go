@@ -4,10 +4,20 @@
 package exporter
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
 	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic/fake"
+	ktesting "k8s.io/client-go/testing"
 )
 
 func TestUnstructuredFetcherShouldSkip(t *testing.T) {
@@ -259,3 +269,85 @@ func TestUnstructuredFetcherShouldSkip(t *testing.T) {
 		})
 	}
 }
+
+func TestUnstructuredFetcherFetchResources(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "example.org", Version: "v1", Resource: "widgets"}
+	widget := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "example.org/v1",
+			"kind":       "Widget",
+			"metadata": map[string]interface{}{
+				"name": "widget",
+			},
+		},
+	}
+	rateLimited := kerrors.NewTooManyRequests("slow down", 1)
+
+	type args struct {
+		failures int
+	}
+	type want struct {
+		names []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Success": {
+			reason: "Resources should be returned when listing succeeds.",
+			want: want{
+				names: []string{"widget"},
+			},
+		},
+		"RetryRateLimited": {
+			reason: "Listing should be retried when the API server is rate limiting requests.",
+			args: args{
+				failures: 2,
+			},
+			want: want{
+				names: []string{"widget"},
+			},
+		},
+		"RetriesExhausted": {
+			reason: "The last error should be returned once retries are exhausted.",
+			args: args{
+				failures: 3,
+			},
+			want: want{
+				err: errors.Wrapf(rateLimited, "cannot list %q resources", gvr.GroupResource()),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{gvr: "WidgetList"}, widget)
+			calls := 0
+			client.PrependReactor("list", "widgets", func(_ ktesting.Action) (bool, runtime.Object, error) {
+				calls++
+				if calls <= tc.args.failures {
+					return true, nil, rateLimited
+				}
+				return false, nil, nil
+			})
+
+			f := NewUnstructuredFetcher(client, Options{})
+			f.backoff = wait.Backoff{Duration: time.Millisecond, Steps: 3}
+
+			got, err := f.FetchResources(context.Background(), gvr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nFetchResources(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			var names []string
+			for _, u := range got {
+				names = append(names, u.GetName())
+			}
+			if diff := cmp.Diff(tc.want.names, names); diff != "" {
+				t.Errorf("\n%s\nFetchResources(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package exporter
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/upbound/up/pkg/migration/importer"
+)
+
+// Filter selects a subset of resources to export, in addition to the
+// namespaces selected by Options.
+type Filter struct {
+	// Types selects resources by their type, using the same patterns as the
+	// importer's filter, e.g. "Secret" or "*.aws.upbound.io".
+	Types importer.GVKFilter
+	// Selector, if set, limits the export to resources whose labels match
+	// it. Namespaces are exported regardless of their labels, so that the
+	// namespaced resources selected can be imported.
+	Selector labels.Selector
+}
+
+// Matches returns true if the resource should be exported.
+func (f Filter) Matches(u unstructured.Unstructured) bool {
+	gvk := u.GroupVersionKind()
+	if !f.Types.Matches(gvk) {
+		return false
+	}
+	if f.Selector == nil || (gvk.Group == "" && gvk.Kind == "Namespace") {
+		return true
+	}
+	return f.Selector.Matches(labels.Set(u.GetLabels()))
+}
@@ -0,0 +1,154 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package exporter
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/upbound/up/pkg/migration/importer"
+)
+
+func TestFilterMatches(t *testing.T) {
+	bucket := unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "s3.aws.upbound.io/v1beta1",
+			"kind":       "Bucket",
+			"metadata": map[string]interface{}{
+				"name": "bucket",
+				"labels": map[string]interface{}{
+					"team": "a",
+				},
+			},
+		},
+	}
+	namespace := unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"metadata": map[string]interface{}{
+				"name": "team-b",
+			},
+		},
+	}
+	secret := unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata": map[string]interface{}{
+				"name":      "creds",
+				"namespace": "team-b",
+				"labels": map[string]interface{}{
+					"team": "b",
+				},
+			},
+		},
+	}
+
+	type args struct {
+		filter Filter
+		r      unstructured.Unstructured
+	}
+	type want struct {
+		matches bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NoFilter": {
+			reason: "An empty filter should match every resource.",
+			args: args{
+				r: bucket,
+			},
+			want: want{
+				matches: true,
+			},
+		},
+		"IncludedType": {
+			reason: "A resource of an included type should match.",
+			args: args{
+				filter: Filter{Types: importer.GVKFilter{Include: []string{"*.aws.upbound.io"}}},
+				r:      bucket,
+			},
+			want: want{
+				matches: true,
+			},
+		},
+		"NotIncludedType": {
+			reason: "A resource of a type that isn't included should not match.",
+			args: args{
+				filter: Filter{Types: importer.GVKFilter{Include: []string{"*.aws.upbound.io"}}},
+				r:      secret,
+			},
+			want: want{
+				matches: false,
+			},
+		},
+		"ExcludedType": {
+			reason: "A resource of an excluded type should not match, even if its type is included.",
+			args: args{
+				filter: Filter{Types: importer.GVKFilter{Include: []string{"*"}, Exclude: []string{"Secret"}}},
+				r:      secret,
+			},
+			want: want{
+				matches: false,
+			},
+		},
+		"SelectedLabels": {
+			reason: "A resource whose labels match the selector should match.",
+			args: args{
+				filter: Filter{Selector: labels.SelectorFromSet(labels.Set{"team": "a"})},
+				r:      bucket,
+			},
+			want: want{
+				matches: true,
+			},
+		},
+		"NotSelectedLabels": {
+			reason: "A resource whose labels don't match the selector should not match.",
+			args: args{
+				filter: Filter{Selector: labels.SelectorFromSet(labels.Set{"team": "a"})},
+				r:      secret,
+			},
+			want: want{
+				matches: false,
+			},
+		},
+		"NamespaceIgnoresSelector": {
+			reason: "A namespace should match regardless of its labels, so the resources in it can be imported.",
+			args: args{
+				filter: Filter{Selector: labels.SelectorFromSet(labels.Set{"team": "b"})},
+				r:      namespace,
+			},
+			want: want{
+				matches: true,
+			},
+		},
+		"NamespaceExcludedType": {
+			reason: "A namespace should not match if its type is excluded.",
+			args: args{
+				filter: Filter{Types: importer.GVKFilter{Exclude: []string{"Namespace"}}, Selector: labels.Everything()},
+				r:      namespace,
+			},
+			want: want{
+				matches: false,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.args.filter.Matches(tc.args.r)
+			if diff := cmp.Diff(tc.want.matches, got); diff != "" {
+				t.Errorf("\n%s\nMatches(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
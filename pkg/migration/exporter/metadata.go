@@ -48,6 +48,10 @@ func (e *PersistentMetadataExporter) ExportMetadata(ctx context.Context, opts Op
 	for _, v := range custom {
 		total += v
 	}
+	var selector string
+	if opts.Filter.Selector != nil {
+		selector = opts.Filter.Selector.String()
+	}
 	em := &v1alpha1.ExportMeta{
 		Version:    "v1alpha1",
 		ExportedAt: time.Now(),
@@ -56,6 +60,9 @@ func (e *PersistentMetadataExporter) ExportMetadata(ctx context.Context, opts Op
 			ExcludedNamespaces:     opts.ExcludeNamespaces,
 			IncludedExtraResources: opts.IncludeExtraResources,
 			ExcludedResources:      opts.ExcludeResources,
+			IncludedTypes:          opts.Filter.Types.Include,
+			ExcludedTypes:          opts.Filter.Types.Exclude,
+			LabelSelector:          selector,
 			PausedBeforeExport:     opts.PauseBeforeExport,
 		},
 		Crossplane: *xp,
@@ -0,0 +1,71 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package exporter
+
+import (
+	"sync"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// ExportState is the state of the export of a resource type.
+type ExportState string
+
+const (
+	// ExportStateStarted means the resource type is being exported.
+	ExportStateStarted ExportState = "Started"
+	// ExportStateDone means the resource type was exported.
+	ExportStateDone ExportState = "Done"
+	// ExportStateSkipped means the resource type was skipped because it was
+	// being created or deleted.
+	ExportStateSkipped ExportState = "Skipped"
+	// ExportStateFailed means the resource type could not be exported.
+	ExportStateFailed ExportState = "Failed"
+)
+
+// ExportProgress is the progress of the export of a resource type.
+type ExportProgress struct {
+	// Resource is the group resource being exported, e.g.
+	// "buckets.s3.aws.upbound.io".
+	Resource string
+	State    ExportState
+	// Count is the number of resources exported, once State is
+	// ExportStateDone.
+	Count int
+	// Err is why the export failed, if State is ExportStateFailed.
+	Err error
+}
+
+// forEachConcurrently calls fn for each item using a bounded pool of
+// workers, returning all errors encountered. Values of n below 2 call fn for
+// one item at a time, in order.
+func forEachConcurrently[T any](n int, items []T, fn func(item T) error) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	sem := make(chan struct{}, max(n, 1))
+	for _, item := range items {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := fn(item); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+	return nil
+}
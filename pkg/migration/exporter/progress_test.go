@@ -0,0 +1,112 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package exporter
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+func TestForEachConcurrently(t *testing.T) {
+	type args struct {
+		n     int
+		items []int
+		fail  map[int]bool
+	}
+	type want struct {
+		maxRunning int32
+		errs       int
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Sequential": {
+			reason: "Values of n below 2 should call fn for one item at a time.",
+			args: args{
+				n:     0,
+				items: []int{1, 2, 3, 4},
+			},
+			want: want{
+				maxRunning: 1,
+			},
+		},
+		"Bounded": {
+			reason: "No more than n items should be processed at once.",
+			args: args{
+				n:     2,
+				items: []int{1, 2, 3, 4, 5, 6},
+			},
+			want: want{
+				maxRunning: 2,
+			},
+		},
+		"AllErrorsReturned": {
+			reason: "Every item should be processed, and all errors returned.",
+			args: args{
+				n:     3,
+				items: []int{1, 2, 3, 4, 5, 6},
+				fail:  map[int]bool{2: true, 5: true},
+			},
+			want: want{
+				maxRunning: 3,
+				errs:       2,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var (
+				running, maxRunning atomic.Int32
+				mu                  sync.Mutex
+				seen                = make(map[int]bool)
+			)
+			err := forEachConcurrently(tc.args.n, tc.args.items, func(item int) error {
+				r := running.Add(1)
+				defer running.Add(-1)
+				for {
+					m := maxRunning.Load()
+					if r <= m || maxRunning.CompareAndSwap(m, r) {
+						break
+					}
+				}
+				// Give other workers a chance to start.
+				time.Sleep(10 * time.Millisecond)
+
+				mu.Lock()
+				seen[item] = true
+				mu.Unlock()
+				if tc.args.fail[item] {
+					return errors.Errorf("item %d failed", item)
+				}
+				return nil
+			})
+
+			if got := maxRunning.Load(); got != tc.want.maxRunning {
+				t.Errorf("\n%s\nforEachConcurrently(...): got %d concurrent calls, want %d", tc.reason, got, tc.want.maxRunning)
+			}
+			if len(seen) != len(tc.args.items) {
+				t.Errorf("\n%s\nforEachConcurrently(...): processed %d items, want %d", tc.reason, len(seen), len(tc.args.items))
+			}
+			var got int
+			if err != nil {
+				var me interface{ Unwrap() []error }
+				if !errors.As(err, &me) {
+					t.Fatalf("\n%s\nforEachConcurrently(...): error %v doesn't wrap multiple errors", tc.reason, err)
+				}
+				got = len(me.Unwrap())
+			}
+			if got != tc.want.errs {
+				t.Errorf("\n%s\nforEachConcurrently(...): got %d errors, want %d", tc.reason, got, tc.want.errs)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package importer
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
@@ -12,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/util/retry"
@@ -22,81 +24,421 @@ type ResourceApplier interface {
 	ModifyResources(ctx context.Context, resources []unstructured.Unstructured, modify func(*unstructured.Unstructured) error) error
 }
 
+// RetryOptions configure the backoff used when retrying transient API errors.
+// Zero values fall back to the defaults of the loop they configure.
+type RetryOptions struct {
+	// Steps is the maximum number of attempts.
+	Steps int
+	// Duration is the initial delay between attempts.
+	Duration time.Duration
+	// Factor is the multiplier applied to Duration after each attempt.
+	Factor float64
+}
+
+// ApplierOptions are the options for an UnstructuredResourceApplier.
+type ApplierOptions struct {
+	// ApplyRetry configures retries of ApplyResources.
+	ApplyRetry RetryOptions
+	// ModifyRetry configures retries of ModifyResources.
+	ModifyRetry RetryOptions
+	// Concurrency is the maximum number of resources ApplyResources applies
+	// at once. Values below 2 apply resources one at a time. Either way,
+	// CustomResourceDefinitions are applied first, then Namespaces, then all
+	// other resources in their original order.
+	Concurrency int
+	// FieldManager is the field manager used to server-side apply resources.
+	// Defaults to "up-controlplane-migrator".
+	FieldManager string
+	// ForceConflicts, if set, determines whether server-side apply takes
+	// ownership of fields owned by other field managers, such as controllers
+	// already running in the target control plane. Defaults to true.
+	ForceConflicts *bool
+	// EstablishTimeout is how long ApplyResources waits for the
+	// CustomResourceDefinitions it applies to be established before applying
+	// other resources. Defaults to two minutes.
+	EstablishTimeout time.Duration
+	// DryRun applies resources with server-side dry-run, so nothing is
+	// persisted. What would have changed is recorded for DryRunResults.
+	DryRun bool
+	// Filter, if set, selects which resources ApplyResources applies. Other
+	// resources are skipped before their REST mapping is looked up.
+	Filter *GVKFilter
+	// RemapGroup, if set, returns the API group to apply a resource as, given
+	// its exported API group.
+	RemapGroup func(group string) string
+	// Transforms modify each resource, in order, before it's applied. They
+	// run after RemapGroup.
+	Transforms []Transform
+	// Checkpoint, if set, records each successfully applied resource.
+	// Resources it already records as applied are skipped.
+	Checkpoint *Checkpoint
+	// Progress, if set, is called each time ApplyResources finishes with a
+	// resource, including resources skipped because of Checkpoint.
+	Progress func(p ApplyProgress)
+}
+
+// ApplyPhase is a phase of ApplyResources. All resources of a phase are
+// applied before those of the next phase.
+type ApplyPhase string
+
+const (
+	// ApplyPhaseCRDs applies CustomResourceDefinitions.
+	ApplyPhaseCRDs ApplyPhase = "CustomResourceDefinitions"
+	// ApplyPhaseNamespaces applies Namespaces.
+	ApplyPhaseNamespaces ApplyPhase = "Namespaces"
+	// ApplyPhaseResources applies all other resources.
+	ApplyPhaseResources ApplyPhase = "Resources"
+)
+
+// ApplyProgress is the progress of a phase of a call to ApplyResources.
+type ApplyProgress struct {
+	Phase ApplyPhase
+	// Done is the number of resources of the phase that have been applied or
+	// skipped so far.
+	Done int
+	// Skipped is the number of resources of the phase that were skipped
+	// because they were already applied.
+	Skipped int
+	// Total is the number of resources of the phase.
+	Total int
+}
+
+//nolint:gochecknoglobals // Constant.
+var (
+	crdGroupKind       = schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}
+	namespaceGroupKind = schema.GroupKind{Kind: "Namespace"}
+)
+
+// defaultFieldManager is the field manager used to apply resources, unless
+// another is configured.
+const defaultFieldManager = "up-controlplane-migrator"
+
+//nolint:gochecknoglobals // Constant.
+var defaultApplyBackoff = wait.Backoff{
+	Duration: 500 * time.Millisecond, // Initial retry delay
+	Factor:   2.0,                    // Doubles each time
+	Jitter:   0.1,                    // 10% random jitter
+	Steps:    5,                      // Maximum retries
+}
+
 type UnstructuredResourceApplier struct {
 	dynamicClient  dynamic.Interface
 	resourceMapper meta.RESTMapper
+
+	options ApplierOptions
+	// establishPollInterval is how often to check whether applied
+	// CustomResourceDefinitions are established.
+	establishPollInterval time.Duration
+
+	// dryRunMu guards dryRunResults, which may be recorded concurrently.
+	dryRunMu      sync.Mutex
+	dryRunResults []DryRunResult
 }
 
-func NewUnstructuredResourceApplier(dynamicClient dynamic.Interface, resourceMapper meta.RESTMapper) *UnstructuredResourceApplier {
+func NewUnstructuredResourceApplier(dynamicClient dynamic.Interface, resourceMapper meta.RESTMapper, opts ApplierOptions) *UnstructuredResourceApplier {
 	return &UnstructuredResourceApplier{
 		dynamicClient:  dynamicClient,
 		resourceMapper: resourceMapper,
+		options:        opts,
+
+		establishPollInterval: defaultEstablishPollInterval,
 	}
 }
 
+// backoff returns def with any non-zero fields of o applied on top.
+func (o RetryOptions) backoff(def wait.Backoff) wait.Backoff {
+	if o.Steps > 0 {
+		def.Steps = o.Steps
+	}
+	if o.Duration > 0 {
+		def.Duration = o.Duration
+	}
+	if o.Factor > 0 {
+		def.Factor = o.Factor
+	}
+	return def
+}
+
 func (a *UnstructuredResourceApplier) ApplyResources(ctx context.Context, resources []unstructured.Unstructured, applyStatus bool) error {
-	// It's possible that webhook if providers are not ready - and the import will fail.
+	resources, err := a.filterResources(resources)
+	if err != nil {
+		return err
+	}
+	pt := newProgressTracker(a.options.Progress, resources)
+	tiers := applyTiers(resources)
+
+	// Resources can't be applied until the CustomResourceDefinitions that
+	// define their types are established, so those are applied and waited
+	// for first.
+	if crds := tiers[0]; len(crds) > 0 {
+		if err := a.applyTier(ctx, pt, crds, applyStatus); err != nil {
+			return err
+		}
+		if !a.options.DryRun {
+			if err := a.waitForEstablished(ctx, crds); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, tier := range tiers[1:] {
+		if err := a.applyTier(ctx, pt, tier, applyStatus); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyTier applies the given resources, concurrently if configured to.
+func (a *UnstructuredResourceApplier) applyTier(ctx context.Context, pt *progressTracker, resources []*unstructured.Unstructured, applyStatus bool) error {
+	if a.options.Concurrency >= 2 {
+		return a.applyConcurrently(ctx, pt, resources, applyStatus)
+	}
+	for _, u := range resources {
+		if err := a.applyCheckpointed(ctx, pt, u, applyStatus); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//nolint:gochecknoglobals // Constant.
+var applyPhases = []ApplyPhase{ApplyPhaseCRDs, ApplyPhaseNamespaces, ApplyPhaseResources}
+
+// phaseOf returns the index in applyPhases of the phase the resource is applied
+// in.
+func phaseOf(u *unstructured.Unstructured) int {
+	switch u.GroupVersionKind().GroupKind() {
+	case crdGroupKind:
+		return 0
+	case namespaceGroupKind:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// applyTiers groups resources into tiers that must be applied one after the
+// other: CustomResourceDefinitions first, then Namespaces, then everything
+// else. Resources within a tier can be applied in parallel.
+func applyTiers(resources []unstructured.Unstructured) [][]*unstructured.Unstructured {
+	tiers := make([][]*unstructured.Unstructured, len(applyPhases))
 	for i := range resources {
-		err := wait.ExponentialBackoff(wait.Backoff{
-			Duration: 500 * time.Millisecond, // Initial retry delay
-			Factor:   2.0,                    // Doubles each time
-			Jitter:   0.1,                    // 10% random jitter
-			Steps:    5,                      // Maximum retries
-		}, func() (bool, error) {
-			rm, err := a.resourceMapper.RESTMapping(resources[i].GroupVersionKind().GroupKind(), resources[i].GroupVersionKind().Version)
-			if err != nil {
-				return false, errors.Wrap(err, "cannot get REST mapping") // Retryable error
+		t := phaseOf(&resources[i])
+		tiers[t] = append(tiers[t], &resources[i])
+	}
+	return tiers
+}
+
+// progressTracker counts the resources of each phase ApplyResources has
+// finished with, and reports them to a progress function.
+type progressTracker struct {
+	mu       sync.Mutex
+	fn       func(p ApplyProgress)
+	progress []ApplyProgress
+}
+
+func newProgressTracker(fn func(p ApplyProgress), resources []unstructured.Unstructured) *progressTracker {
+	t := &progressTracker{fn: fn, progress: make([]ApplyProgress, len(applyPhases))}
+	for i, ph := range applyPhases {
+		t.progress[i].Phase = ph
+	}
+	for i := range resources {
+		t.progress[phaseOf(&resources[i])].Total++
+	}
+	return t
+}
+
+func (t *progressTracker) done(u *unstructured.Unstructured, skipped bool) {
+	if t.fn == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p := &t.progress[phaseOf(u)]
+	p.Done++
+	if skipped {
+		p.Skipped++
+	}
+	t.fn(*p)
+}
+
+// applyConcurrently applies the given resources using a bounded pool of
+// workers, returning all errors encountered.
+func (a *UnstructuredResourceApplier) applyConcurrently(ctx context.Context, pt *progressTracker, resources []*unstructured.Unstructured, applyStatus bool) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	sem := make(chan struct{}, a.options.Concurrency)
+	for _, u := range resources {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := a.applyCheckpointed(ctx, pt, u, applyStatus); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
 			}
+		}()
+	}
+	wg.Wait()
 
-			rs := resources[i].DeepCopy()
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+	return nil
+}
 
-			_, err = a.dynamicClient.Resource(rm.Resource).Namespace(resources[i].GetNamespace()).Apply(ctx, resources[i].GetName(), &resources[i], v1.ApplyOptions{
-				FieldManager: "up-controlplane-migrator",
-				Force:        true,
-			})
-			if err != nil {
+// applyCheckpointed applies a single resource unless the checkpoint records it
+// as already applied, and reports progress once done.
+func (a *UnstructuredResourceApplier) applyCheckpointed(ctx context.Context, pt *progressTracker, u *unstructured.Unstructured, applyStatus bool) error {
+	// Nothing is persisted in dry-run mode, so there's nothing to record or
+	// skip.
+	cp := a.options.Checkpoint
+	if a.options.DryRun {
+		cp = nil
+	}
+
+	if cp.Applied(u) {
+		pt.done(u, true)
+		return nil
+	}
+	if err := a.applyResource(ctx, u, applyStatus); err != nil {
+		return err
+	}
+	if err := cp.Record(u); err != nil {
+		return errors.Wrapf(err, "cannot record resource %s/%s as applied", u.GetKind(), u.GetName())
+	}
+	pt.done(u, false)
+	return nil
+}
+
+func (a *UnstructuredResourceApplier) applyOptions() v1.ApplyOptions {
+	o := v1.ApplyOptions{
+		FieldManager: defaultFieldManager,
+		Force:        true,
+	}
+	if a.options.FieldManager != "" {
+		o.FieldManager = a.options.FieldManager
+	}
+	if a.options.ForceConflicts != nil {
+		o.Force = *a.options.ForceConflicts
+	}
+	if a.options.DryRun {
+		o.DryRun = []string{v1.DryRunAll}
+	}
+	return o
+}
+
+func (a *UnstructuredResourceApplier) updateOptions() v1.UpdateOptions {
+	o := v1.UpdateOptions{}
+	if a.options.DryRun {
+		o.DryRun = []string{v1.DryRunAll}
+	}
+	return o
+}
+
+// applyResource applies a single resource, and optionally its status,
+// retrying on API errors. It's possible that webhooks are not ready if
+// providers are not ready - and the import will fail.
+func (a *UnstructuredResourceApplier) applyResource(ctx context.Context, u *unstructured.Unstructured, applyStatus bool) error {
+	// lastErr records the most recent retryable error, so that we can
+	// surface it rather than a bare timeout once retries are exhausted.
+	var lastErr error
+	err := wait.ExponentialBackoff(a.options.ApplyRetry.backoff(defaultApplyBackoff), func() (bool, error) {
+		rm, err := a.resourceMapper.RESTMapping(u.GroupVersionKind().GroupKind(), u.GroupVersionKind().Version)
+		if a.options.DryRun && meta.IsNoMatchError(err) {
+			// The type is not served yet, typically because the package or
+			// XRD that defines it was only applied in dry-run mode.
+			a.recordDryRun(u, nil, nil)
+			return true, nil
+		}
+		if err != nil {
+			return false, errors.Wrap(err, "cannot get REST mapping") // Retryable error
+		}
+
+		var existing *unstructured.Unstructured
+		if a.options.DryRun {
+			existing, err = a.dynamicClient.Resource(rm.Resource).Namespace(u.GetNamespace()).Get(ctx, u.GetName(), v1.GetOptions{})
+			if resource.IgnoreNotFound(err) != nil {
 				if resource.IsAPIErrorWrapped(err) {
+					lastErr = err
 					return false, nil // Retry
 				}
-				return false, errors.Wrap(err, "cannot apply resource") // Non-retryable error
+				return false, errors.Wrap(err, "cannot get resource") // Non-retryable error
 			}
+			if err != nil {
+				existing = nil
+			}
+		}
+
+		rs := u.DeepCopy()
 
-			if !applyStatus {
-				return true, nil // Success, no need for status update
+		applied, err := a.dynamicClient.Resource(rm.Resource).Namespace(u.GetNamespace()).Apply(ctx, u.GetName(), u, a.applyOptions())
+		if err != nil {
+			if resource.IsAPIErrorWrapped(err) {
+				lastErr = err
+				return false, nil // Retry
 			}
+			return false, errors.Wrap(err, "cannot apply resource") // Non-retryable error
+		}
 
-			_, err = a.dynamicClient.Resource(rm.Resource).Namespace(resources[i].GetNamespace()).ApplyStatus(ctx, rs.GetName(), rs, v1.ApplyOptions{
-				FieldManager: "up-controlplane-migrator",
-				Force:        true,
-			})
-			if err != nil {
-				// Note: We just successfully applied this resource above,
-				// so we can ignore the not found error here. This could happen if
-				// the resource was being deleted during export and garbage
-				// collected right after the resource was applied. In this case,
-				// we will get a not found error here, while applying the status,
-				// so we can ignore it.
-				if resource.IgnoreNotFound(err) != nil {
-					if resource.IsAPIError(err) {
-						return false, nil // Retry
-					}
-					return false, errors.Wrap(err, "cannot apply resource status") // Non-retryable error
-				}
+		if !applyStatus {
+			if a.options.DryRun {
+				a.recordDryRun(u, existing, applied)
 			}
+			return true, nil // Success, no need for status update
+		}
 
-			return true, nil // Successfully applied both resource and status
-		})
+		// In dry-run mode the resource may not exist yet, in which case there
+		// is no status subresource to apply to.
+		if a.options.DryRun && existing == nil {
+			a.recordDryRun(u, existing, applied)
+			return true, nil
+		}
 
+		appliedStatus, err := a.dynamicClient.Resource(rm.Resource).Namespace(u.GetNamespace()).ApplyStatus(ctx, rs.GetName(), rs, a.applyOptions())
 		if err != nil {
-			return errors.Wrapf(err, "cannot apply resource %s/%s", resources[i].GetKind(), resources[i].GetName())
+			// Note: We just successfully applied this resource above,
+			// so we can ignore the not found error here. This could happen if
+			// the resource was being deleted during export and garbage
+			// collected right after the resource was applied. In this case,
+			// we will get a not found error here, while applying the status,
+			// so we can ignore it.
+			if resource.IgnoreNotFound(err) != nil {
+				if resource.IsAPIError(err) {
+					lastErr = err
+					return false, nil // Retry
+				}
+				return false, errors.Wrap(err, "cannot apply resource status") // Non-retryable error
+			}
+		}
+		if a.options.DryRun {
+			if appliedStatus != nil {
+				applied = appliedStatus
+			}
+			a.recordDryRun(u, existing, applied)
 		}
+
+		return true, nil // Successfully applied both resource and status
+	})
+	if wait.Interrupted(err) && lastErr != nil {
+		err = lastErr
 	}
-	return nil
+
+	return errors.Wrapf(err, "cannot apply resource %s/%s", u.GetKind(), u.GetName())
 }
 
 func (a *UnstructuredResourceApplier) ModifyResources(ctx context.Context, resources []unstructured.Unstructured, modify func(*unstructured.Unstructured) error) error {
 	for i := range resources {
-		err := retry.OnError(retry.DefaultRetry, resource.IsAPIErrorWrapped, func() error {
+		err := retry.OnError(a.options.ModifyRetry.backoff(retry.DefaultRetry), resource.IsAPIErrorWrapped, func() error {
 			rm, err := a.resourceMapper.RESTMapping(resources[i].GroupVersionKind().GroupKind(), resources[i].GroupVersionKind().Version)
 			if err != nil {
 				return errors.Wrap(err, "cannot get REST mapping")
@@ -109,7 +451,7 @@ func (a *UnstructuredResourceApplier) ModifyResources(ctx context.Context, resou
 				return errors.Wrap(err, "cannot modify resource")
 			}
 
-			_, err = a.dynamicClient.Resource(rm.Resource).Namespace(resources[i].GetNamespace()).Update(ctx, u, v1.UpdateOptions{})
+			_, err = a.dynamicClient.Resource(rm.Resource).Namespace(resources[i].GetNamespace()).Update(ctx, u, a.updateOptions())
 			if err != nil {
 				return errors.Wrap(err, "cannot update resource")
 			}
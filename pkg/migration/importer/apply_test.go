@@ -6,14 +6,19 @@ package importer
 import (
 	"context"
 	"errors"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/fake"
 )
@@ -697,8 +702,10 @@ func TestNewUnstructuredResourceApplier(t *testing.T) {
 	dynamicClient := fake.NewSimpleDynamicClient(scheme)
 	restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{})
 
+	opts := ApplierOptions{ApplyRetry: RetryOptions{Steps: 3}}
+
 	// When
-	ra := NewUnstructuredResourceApplier(dynamicClient, restMapper)
+	ra := NewUnstructuredResourceApplier(dynamicClient, restMapper, opts)
 
 	// Then
 	if ra.dynamicClient != dynamicClient {
@@ -708,6 +715,602 @@ func TestNewUnstructuredResourceApplier(t *testing.T) {
 	if ra.resourceMapper != restMapper {
 		t.Errorf("Expected resourceMapper to be %v, got %v", restMapper, ra.resourceMapper)
 	}
+
+	if ra.options.ApplyRetry != opts.ApplyRetry {
+		t.Errorf("Expected apply retry options to be %v, got %v", opts.ApplyRetry, ra.options.ApplyRetry)
+	}
+}
+
+func TestApplyResourcesApplyOptions(t *testing.T) {
+	testResource := unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "test.upbound.io/v1",
+			"kind":       "TestResource",
+			"metadata": map[string]interface{}{
+				"name":      "test-resource",
+				"namespace": "test-namespace",
+			},
+		},
+	}
+	noForce := false
+
+	type want struct {
+		options v1.ApplyOptions
+	}
+
+	cases := map[string]struct {
+		reason string
+		opts   ApplierOptions
+		want   want
+	}{
+		"Defaults": {
+			reason: "Resources should be force-applied with the default field manager by default.",
+			want: want{
+				options: v1.ApplyOptions{FieldManager: "up-controlplane-migrator", Force: true},
+			},
+		},
+		"CustomFieldManager": {
+			reason: "Resources should be applied with the configured field manager.",
+			opts:   ApplierOptions{FieldManager: "my-migrator"},
+			want: want{
+				options: v1.ApplyOptions{FieldManager: "my-migrator", Force: true},
+			},
+		},
+		"NoForceConflicts": {
+			reason: "Resources should not be force-applied if forcing is disabled.",
+			opts:   ApplierOptions{ForceConflicts: &noForce},
+			want: want{
+				options: v1.ApplyOptions{FieldManager: "up-controlplane-migrator", Force: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var applied, appliedStatus []v1.ApplyOptions
+			dc := &mockDynamicInterface{
+				resourceFunc: func(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+					return &mockNamespaceableResourceInterface{
+						namespaceFunc: func(ns string) dynamic.ResourceInterface {
+							return &mockResourceInterface{
+								applyFunc: func(ctx context.Context, name string, obj *unstructured.Unstructured, options v1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+									applied = append(applied, options)
+									return obj, nil
+								},
+								applyStatusFunc: func(ctx context.Context, name string, obj *unstructured.Unstructured, options v1.ApplyOptions) (*unstructured.Unstructured, error) {
+									appliedStatus = append(appliedStatus, options)
+									return obj, nil
+								},
+							}
+						},
+					}
+				},
+			}
+			mapper := &mockRESTMapper{
+				mappingFunc: func(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+					return &meta.RESTMapping{Resource: schema.GroupVersionResource{Group: "test.upbound.io", Version: "v1", Resource: "testresources"}}, nil
+				},
+			}
+			a := NewUnstructuredResourceApplier(dc, mapper, tc.opts)
+
+			if err := a.ApplyResources(context.Background(), []unstructured.Unstructured{testResource}, true); err != nil {
+				t.Fatalf("ApplyResources() error = %v, want nil", err)
+			}
+
+			if diff := cmp.Diff([]v1.ApplyOptions{tc.want.options}, applied); diff != "" {
+				t.Errorf("\n%s\nApply() options: -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff([]v1.ApplyOptions{tc.want.options}, appliedStatus); diff != "" {
+				t.Errorf("\n%s\nApplyStatus() options: -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestApplyTiers(t *testing.T) {
+	resources := []unstructured.Unstructured{
+		{Object: map[string]interface{}{"apiVersion": "example.org/v1", "kind": "Widget", "metadata": map[string]interface{}{"name": "w"}}},
+		{Object: map[string]interface{}{"apiVersion": "v1", "kind": "Namespace", "metadata": map[string]interface{}{"name": "ns"}}},
+		{Object: map[string]interface{}{"apiVersion": "apiextensions.k8s.io/v1", "kind": "CustomResourceDefinition", "metadata": map[string]interface{}{"name": "crd"}}},
+		{Object: map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]interface{}{"name": "cm"}}},
+	}
+	want := [][]string{{"crd"}, {"ns"}, {"w", "cm"}}
+
+	tiers := applyTiers(resources)
+	got := make([][]string, len(tiers))
+	for i, tier := range tiers {
+		got[i] = []string{}
+		for _, u := range tier {
+			got[i] = append(got[i], u.GetName())
+		}
+	}
+	if len(got) != len(want) {
+		t.Fatalf("applyTiers() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if strings.Join(got[i], ",") != strings.Join(want[i], ",") {
+			t.Errorf("applyTiers() tier %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestApplyResourcesConcurrently(t *testing.T) {
+	newResource := func(apiVersion, kind, name string) unstructured.Unstructured {
+		return unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       kind,
+			"metadata":   map[string]interface{}{"name": name},
+		}}
+	}
+	mapper := &mockRESTMapper{
+		mappingFunc: func(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+			return &meta.RESTMapping{Resource: schema.GroupVersionResource{Group: gk.Group, Version: "v1", Resource: strings.ToLower(gk.Kind) + "s"}}, nil
+		},
+	}
+	testError := errors.New("test error")
+
+	t.Run("OrdersTiersAndBoundsParallelism", func(t *testing.T) {
+		resources := []unstructured.Unstructured{
+			newResource("example.org/v1", "Widget", "w1"),
+			newResource("example.org/v1", "Widget", "w2"),
+			newResource("example.org/v1", "Widget", "w3"),
+			newResource("example.org/v1", "Widget", "w4"),
+			newResource("v1", "Namespace", "ns"),
+			newResource("apiextensions.k8s.io/v1", "CustomResourceDefinition", "crd"),
+		}
+
+		var (
+			mu       sync.Mutex
+			order    []string
+			inFlight int
+			peak     int
+		)
+		dc := &mockDynamicInterface{
+			resourceFunc: func(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+				return &mockNamespaceableResourceInterface{
+					namespaceFunc: func(ns string) dynamic.ResourceInterface {
+						return &mockResourceInterface{
+							applyFunc: func(ctx context.Context, name string, obj *unstructured.Unstructured, options v1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+								mu.Lock()
+								inFlight++
+								peak = max(peak, inFlight)
+								mu.Unlock()
+
+								time.Sleep(10 * time.Millisecond)
+
+								mu.Lock()
+								inFlight--
+								order = append(order, obj.GetKind())
+								mu.Unlock()
+								return obj, nil
+							},
+							getFunc: func(ctx context.Context, name string, options v1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+								return establishedCRD(name, true), nil
+							},
+						}
+					},
+				}
+			},
+		}
+		a := NewUnstructuredResourceApplier(dc, mapper, ApplierOptions{Concurrency: 2})
+
+		if err := a.ApplyResources(context.Background(), resources, false); err != nil {
+			t.Fatalf("ApplyResources() error = %v, want nil", err)
+		}
+
+		want := []string{"CustomResourceDefinition", "Namespace", "Widget", "Widget", "Widget", "Widget"}
+		if strings.Join(order, ",") != strings.Join(want, ",") {
+			t.Errorf("ApplyResources() order = %v, want %v", order, want)
+		}
+		if peak != 2 {
+			t.Errorf("ApplyResources() peak concurrency = %d, want 2", peak)
+		}
+	})
+
+	t.Run("AggregatesErrors", func(t *testing.T) {
+		resources := []unstructured.Unstructured{
+			newResource("example.org/v1", "Widget", "ok"),
+			newResource("example.org/v1", "Widget", "bad1"),
+			newResource("example.org/v1", "Widget", "bad2"),
+		}
+		dc := &mockDynamicInterface{
+			resourceFunc: func(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+				return &mockNamespaceableResourceInterface{
+					namespaceFunc: func(ns string) dynamic.ResourceInterface {
+						return &mockResourceInterface{
+							applyFunc: func(ctx context.Context, name string, obj *unstructured.Unstructured, options v1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+								if name == "ok" {
+									return obj, nil
+								}
+								return nil, testError
+							},
+						}
+					},
+				}
+			},
+		}
+		a := NewUnstructuredResourceApplier(dc, mapper, ApplierOptions{Concurrency: 4})
+
+		err := a.ApplyResources(context.Background(), resources, false)
+		if !errors.Is(err, testError) {
+			t.Fatalf("ApplyResources() error = %v, want %v", err, testError)
+		}
+		for _, name := range []string{"bad1", "bad2"} {
+			if !strings.Contains(err.Error(), "Widget/"+name) {
+				t.Errorf("ApplyResources() error = %v, want it to mention %q", err, name)
+			}
+		}
+	})
+
+	t.Run("StopsBeforeDependentTier", func(t *testing.T) {
+		resources := []unstructured.Unstructured{
+			newResource("v1", "Namespace", "ns"),
+			newResource("example.org/v1", "Widget", "w"),
+		}
+		applied := map[string]bool{}
+		var mu sync.Mutex
+		dc := &mockDynamicInterface{
+			resourceFunc: func(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+				return &mockNamespaceableResourceInterface{
+					namespaceFunc: func(ns string) dynamic.ResourceInterface {
+						return &mockResourceInterface{
+							applyFunc: func(ctx context.Context, name string, obj *unstructured.Unstructured, options v1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+								mu.Lock()
+								applied[name] = true
+								mu.Unlock()
+								if obj.GetKind() == "Namespace" {
+									return nil, testError
+								}
+								return obj, nil
+							},
+						}
+					},
+				}
+			},
+		}
+		a := NewUnstructuredResourceApplier(dc, mapper, ApplierOptions{Concurrency: 2})
+
+		if err := a.ApplyResources(context.Background(), resources, false); !errors.Is(err, testError) {
+			t.Fatalf("ApplyResources() error = %v, want %v", err, testError)
+		}
+		if applied["w"] {
+			t.Errorf("ApplyResources() applied Widget after its Namespace failed")
+		}
+	})
+}
+
+func TestApplyResourcesSequentially(t *testing.T) {
+	newResource := func(apiVersion, kind, name string) unstructured.Unstructured {
+		return unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       kind,
+			"metadata":   map[string]interface{}{"name": name},
+		}}
+	}
+	mapper := &mockRESTMapper{
+		mappingFunc: func(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+			return &meta.RESTMapping{Resource: schema.GroupVersionResource{Group: gk.Group, Version: "v1", Resource: strings.ToLower(gk.Kind) + "s"}}, nil
+		},
+	}
+	resources := []unstructured.Unstructured{
+		newResource("example.org/v1", "Widget", "w1"),
+		newResource("v1", "Namespace", "ns"),
+		newResource("example.org/v1", "Widget", "w2"),
+		newResource("apiextensions.k8s.io/v1", "CustomResourceDefinition", "crd"),
+	}
+
+	var order []string
+	dc := &mockDynamicInterface{
+		resourceFunc: func(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+			return &mockNamespaceableResourceInterface{
+				namespaceFunc: func(ns string) dynamic.ResourceInterface {
+					return &mockResourceInterface{
+						applyFunc: func(ctx context.Context, name string, obj *unstructured.Unstructured, options v1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+							order = append(order, obj.GetKind()+"/"+name)
+							return obj, nil
+						},
+						getFunc: func(ctx context.Context, name string, options v1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+							return establishedCRD(name, true), nil
+						},
+					}
+				},
+			}
+		},
+	}
+	a := NewUnstructuredResourceApplier(dc, mapper, ApplierOptions{Concurrency: 1})
+
+	if err := a.ApplyResources(context.Background(), resources, false); err != nil {
+		t.Fatalf("ApplyResources() error = %v, want nil", err)
+	}
+
+	// Namespaces are applied before the resources that may be in them, and
+	// other resources keep their original order.
+	want := []string{"CustomResourceDefinition/crd", "Namespace/ns", "Widget/w1", "Widget/w2"}
+	if diff := cmp.Diff(want, order); diff != "" {
+		t.Errorf("ApplyResources() order: -want, +got:\n%s", diff)
+	}
+}
+
+func TestApplyResourcesDryRun(t *testing.T) {
+	newWidget := func(color string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "example.org/v1",
+			"kind":       "Widget",
+			"metadata": map[string]interface{}{
+				"name":            "w",
+				"namespace":       "default",
+				"resourceVersion": "1",
+			},
+			"spec": map[string]interface{}{"color": color},
+		}}
+	}
+	mapper := &mockRESTMapper{
+		mappingFunc: func(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+			return &meta.RESTMapping{Resource: schema.GroupVersionResource{Group: "example.org", Version: "v1", Resource: "widgets"}}, nil
+		},
+	}
+	notFound := k8serrors.NewNotFound(schema.GroupResource{Group: "example.org", Resource: "widgets"}, "w")
+
+	cases := map[string]struct {
+		mapper   meta.RESTMapper
+		existing *unstructured.Unstructured
+		getErr   error
+		want     DryRunAction
+		wantDiff bool
+	}{
+		"Create": {
+			mapper: mapper,
+			getErr: notFound,
+			want:   DryRunActionCreate,
+		},
+		"Update": {
+			mapper:   mapper,
+			existing: newWidget("blue"),
+			want:     DryRunActionUpdate,
+			wantDiff: true,
+		},
+		"Unchanged": {
+			mapper:   mapper,
+			existing: newWidget("red"),
+			want:     DryRunActionUnchanged,
+		},
+		"Unknown": {
+			mapper: &mockRESTMapper{
+				mappingFunc: func(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+					return nil, &meta.NoKindMatchError{GroupKind: gk}
+				},
+			},
+			want: DryRunActionUnknown,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			dc := &mockDynamicInterface{
+				resourceFunc: func(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+					return &mockNamespaceableResourceInterface{
+						namespaceFunc: func(ns string) dynamic.ResourceInterface {
+							return &mockResourceInterface{
+								getFunc: func(ctx context.Context, name string, options v1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+									return tc.existing, tc.getErr
+								},
+								applyFunc: func(ctx context.Context, name string, obj *unstructured.Unstructured, options v1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+									if len(options.DryRun) != 1 || options.DryRun[0] != v1.DryRunAll {
+										t.Errorf("Apply() DryRun = %v, want [%s]", options.DryRun, v1.DryRunAll)
+									}
+									applied := obj.DeepCopy()
+									// The API server bumps the resource version on
+									// every write; this must not show up as a change.
+									applied.SetResourceVersion("2")
+									return applied, nil
+								},
+							}
+						},
+					}
+				},
+			}
+			a := NewUnstructuredResourceApplier(dc, tc.mapper, ApplierOptions{DryRun: true})
+
+			if err := a.ApplyResources(context.Background(), []unstructured.Unstructured{*newWidget("red")}, false); err != nil {
+				t.Fatalf("ApplyResources() error = %v, want nil", err)
+			}
+
+			results := a.DryRunResults()
+			if len(results) != 1 {
+				t.Fatalf("DryRunResults() = %v, want 1 result", results)
+			}
+			if results[0].Action != tc.want {
+				t.Errorf("DryRunResults()[0].Action = %q, want %q", results[0].Action, tc.want)
+			}
+			if gotDiff := results[0].Diff != ""; gotDiff != tc.wantDiff {
+				t.Errorf("DryRunResults()[0].Diff = %q, want diff: %t", results[0].Diff, tc.wantDiff)
+			}
+		})
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	got := Summarize([]DryRunResult{
+		{Action: DryRunActionCreate},
+		{Action: DryRunActionCreate},
+		{Action: DryRunActionUnchanged},
+	})
+	if got[DryRunActionCreate] != 2 || got[DryRunActionUnchanged] != 1 || got[DryRunActionUpdate] != 0 {
+		t.Errorf("Summarize() = %v, want 2 create and 1 unchanged", got)
+	}
+}
+
+func TestRetryOptionsBackoff(t *testing.T) {
+	def := wait.Backoff{Duration: time.Second, Factor: 2.0, Jitter: 0.1, Steps: 5}
+
+	cases := map[string]struct {
+		opts RetryOptions
+		want wait.Backoff
+	}{
+		"ZeroValueUsesDefaults": {
+			opts: RetryOptions{},
+			want: def,
+		},
+		"OverridesAllFields": {
+			opts: RetryOptions{Steps: 10, Duration: time.Millisecond, Factor: 1.5},
+			want: wait.Backoff{Duration: time.Millisecond, Factor: 1.5, Jitter: 0.1, Steps: 10},
+		},
+		"OverridesSomeFields": {
+			opts: RetryOptions{Steps: 2},
+			want: wait.Backoff{Duration: time.Second, Factor: 2.0, Jitter: 0.1, Steps: 2},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.opts.backoff(def); got != tc.want {
+				t.Errorf("backoff() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestApplyResourcesExhaustRetries(t *testing.T) {
+	testResource := unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "test.upbound.io/v1",
+			"kind":       "TestResource",
+			"metadata": map[string]interface{}{
+				"name":      "test-resource",
+				"namespace": "test-namespace",
+			},
+		},
+	}
+	mapper := &mockRESTMapper{
+		mappingFunc: func(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+			return &meta.RESTMapping{
+				Resource: schema.GroupVersionResource{
+					Group:    "test.upbound.io",
+					Version:  "v1",
+					Resource: "testresources",
+				},
+			}, nil
+		},
+	}
+	throttled := k8serrors.NewTooManyRequestsError("server overloaded")
+	conflict := k8serrors.NewConflict(schema.GroupResource{Group: "test.upbound.io", Resource: "testresources"}, "test-resource", errors.New("resource modified"))
+
+	cases := map[string]struct {
+		applyStatus bool
+		ri          func(attempts *int) *mockResourceInterface
+		steps       int
+		want        error
+	}{
+		"Apply": {
+			ri: func(attempts *int) *mockResourceInterface {
+				return &mockResourceInterface{
+					applyFunc: func(ctx context.Context, name string, obj *unstructured.Unstructured, options v1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+						*attempts++
+						return nil, throttled
+					},
+				}
+			},
+			steps: 3,
+			want:  throttled,
+		},
+		"ApplyStatus": {
+			applyStatus: true,
+			ri: func(attempts *int) *mockResourceInterface {
+				return &mockResourceInterface{
+					applyFunc: func(ctx context.Context, name string, obj *unstructured.Unstructured, options v1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+						return obj, nil
+					},
+					applyStatusFunc: func(ctx context.Context, name string, obj *unstructured.Unstructured, options v1.ApplyOptions) (*unstructured.Unstructured, error) {
+						*attempts++
+						return nil, conflict
+					},
+				}
+			},
+			steps: 2,
+			want:  conflict,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			attempts := 0
+			dc := &mockDynamicInterface{
+				resourceFunc: func(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+					return &mockNamespaceableResourceInterface{
+						namespaceFunc: func(ns string) dynamic.ResourceInterface {
+							return tc.ri(&attempts)
+						},
+					}
+				},
+			}
+			a := NewUnstructuredResourceApplier(dc, mapper, ApplierOptions{
+				ApplyRetry: RetryOptions{Steps: tc.steps, Duration: time.Millisecond},
+			})
+
+			got := a.ApplyResources(context.Background(), []unstructured.Unstructured{testResource}, tc.applyStatus)
+			if !errors.Is(got, tc.want) {
+				t.Errorf("ApplyResources() error = %v, want %v", got, tc.want)
+			}
+			if attempts != tc.steps {
+				t.Errorf("ApplyResources() attempts = %d, want %d", attempts, tc.steps)
+			}
+		})
+	}
+}
+
+func TestModifyResourcesExhaustRetries(t *testing.T) {
+	testResource := unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "test.upbound.io/v1",
+			"kind":       "TestResource",
+			"metadata": map[string]interface{}{
+				"name":      "test-resource",
+				"namespace": "test-namespace",
+			},
+		},
+	}
+	timeout := k8serrors.NewServerTimeout(schema.GroupResource{Group: "test.upbound.io", Resource: "testresources"}, "update", 5)
+
+	attempts := 0
+	dc := &mockDynamicInterface{
+		resourceFunc: func(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+			return &mockNamespaceableResourceInterface{
+				namespaceFunc: func(ns string) dynamic.ResourceInterface {
+					return &mockResourceInterface{
+						getFunc: func(ctx context.Context, name string, options v1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+							return testResource.DeepCopy(), nil
+						},
+						updateFunc: func(ctx context.Context, obj *unstructured.Unstructured, options v1.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+							attempts++
+							return nil, timeout
+						},
+					}
+				},
+			}
+		},
+	}
+	mapper := &mockRESTMapper{
+		mappingFunc: func(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+			return &meta.RESTMapping{
+				Resource: schema.GroupVersionResource{
+					Group:    "test.upbound.io",
+					Version:  "v1",
+					Resource: "testresources",
+				},
+			}, nil
+		},
+	}
+	a := NewUnstructuredResourceApplier(dc, mapper, ApplierOptions{
+		ModifyRetry: RetryOptions{Steps: 4, Duration: time.Millisecond},
+	})
+
+	got := a.ModifyResources(context.Background(), []unstructured.Unstructured{testResource}, func(*unstructured.Unstructured) error { return nil })
+	if !errors.Is(got, timeout) {
+		t.Errorf("ModifyResources() error = %v, want %v", got, timeout)
+	}
+	if attempts != 4 {
+		t.Errorf("ModifyResources() attempts = %d, want %d", attempts, 4)
+	}
 }
 
 // mockRESTMapper is a mock implementation of RESTMapper
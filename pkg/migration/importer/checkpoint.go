@@ -0,0 +1,156 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package importer
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// checkpointEntry identifies a resource that was successfully applied. Each
+// entry is written to the checkpoint file as a single line of JSON.
+type checkpointEntry struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+type checkpointKey struct {
+	gvk       schema.GroupVersionKind
+	namespace string
+	name      string
+}
+
+func keyOf(u *unstructured.Unstructured) checkpointKey {
+	return checkpointKey{gvk: u.GroupVersionKind(), namespace: u.GetNamespace(), name: u.GetName()}
+}
+
+// Checkpoint records which resources were successfully applied, so that an
+// interrupted import can be resumed without applying them again. Entries are
+// appended to the checkpoint file as they are recorded, so the file is
+// consistent even if the import crashes. It is safe for concurrent use. All
+// methods are no-ops on a nil checkpoint.
+type Checkpoint struct {
+	mu      sync.Mutex
+	f       afero.File
+	applied map[checkpointKey]bool
+}
+
+// NewCheckpoint opens the checkpoint file at the given path, creating it if it
+// does not exist. If resume is true, resources recorded in an existing file
+// are reported as applied. Otherwise, the file is truncated.
+func NewCheckpoint(fs afero.Fs, path string, resume bool) (*Checkpoint, error) {
+	c := &Checkpoint{applied: map[checkpointKey]bool{}}
+
+	flag := os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	if resume {
+		if err := c.load(fs, path); err != nil {
+			return nil, err
+		}
+	} else {
+		flag |= os.O_TRUNC
+	}
+
+	f, err := fs.OpenFile(path, flag, 0o600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot open checkpoint file %q", path)
+	}
+	c.f = f
+	return c, nil
+}
+
+func (c *Checkpoint) load(fs afero.Fs, path string) error {
+	f, err := fs.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "cannot open checkpoint file %q", path)
+	}
+	defer func() { _ = f.Close() }()
+
+	var lines [][]byte
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		lines = append(lines, append([]byte(nil), sc.Bytes()...))
+	}
+	if err := sc.Err(); err != nil {
+		return errors.Wrapf(err, "cannot read checkpoint file %q", path)
+	}
+
+	for i, l := range lines {
+		e := checkpointEntry{}
+		if err := json.Unmarshal(l, &e); err != nil {
+			if i == len(lines)-1 {
+				// The last entry may have been only partially written if
+				// the previous import crashed. It's safe to ignore; the
+				// resource will just be applied again.
+				break
+			}
+			return errors.Wrapf(err, "cannot parse line %d of checkpoint file %q", i+1, path)
+		}
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion(e.APIVersion)
+		u.SetKind(e.Kind)
+		u.SetNamespace(e.Namespace)
+		u.SetName(e.Name)
+		c.applied[keyOf(u)] = true
+	}
+	return nil
+}
+
+// Applied returns true if the resource was recorded as applied.
+func (c *Checkpoint) Applied(u *unstructured.Unstructured) bool {
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.applied[keyOf(u)]
+}
+
+// Record records the resource as applied, and persists it to the checkpoint
+// file before returning.
+func (c *Checkpoint) Record(u *unstructured.Unstructured) error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, err := json.Marshal(checkpointEntry{
+		APIVersion: u.GetAPIVersion(),
+		Kind:       u.GetKind(),
+		Namespace:  u.GetNamespace(),
+		Name:       u.GetName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal checkpoint entry")
+	}
+	if _, err := c.f.Write(append(b, '\n')); err != nil {
+		return errors.Wrap(err, "cannot write checkpoint entry")
+	}
+	if err := c.f.Sync(); err != nil {
+		return errors.Wrap(err, "cannot sync checkpoint file")
+	}
+	c.applied[keyOf(u)] = true
+	return nil
+}
+
+// Close closes the checkpoint file.
+func (c *Checkpoint) Close() error {
+	if c == nil {
+		return nil
+	}
+	return c.f.Close()
+}
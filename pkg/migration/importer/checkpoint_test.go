@@ -0,0 +1,202 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package importer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+func checkpointResource(apiVersion, kind, namespace, name string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(kind)
+	u.SetNamespace(namespace)
+	u.SetName(name)
+	return u
+}
+
+func TestCheckpoint(t *testing.T) {
+	secret := checkpointResource("v1", "Secret", "default", "creds")
+	bucket := checkpointResource("s3.aws.upbound.io/v1beta1", "Bucket", "", "creds")
+
+	type want struct {
+		applied map[string]bool
+		err     bool
+	}
+	cases := map[string]struct {
+		reason   string
+		existing string
+		resume   bool
+		want     want
+	}{
+		"Resume": {
+			reason:   "Resources recorded in an existing checkpoint file should be reported as applied when resuming.",
+			existing: `{"apiVersion":"v1","kind":"Secret","namespace":"default","name":"creds"}` + "\n",
+			resume:   true,
+			want: want{
+				applied: map[string]bool{"secret": true, "bucket": false},
+			},
+		},
+		"NoResume": {
+			reason:   "An existing checkpoint file should be ignored when not resuming.",
+			existing: `{"apiVersion":"v1","kind":"Secret","namespace":"default","name":"creds"}` + "\n",
+			want: want{
+				applied: map[string]bool{"secret": false, "bucket": false},
+			},
+		},
+		"PartialLastEntry": {
+			reason:   "A partially written last entry should be ignored.",
+			existing: `{"apiVersion":"v1","kind":"Secret","namespace":"default","name":"creds"}` + "\n" + `{"apiVersion":"s3.aws`,
+			resume:   true,
+			want: want{
+				applied: map[string]bool{"secret": true, "bucket": false},
+			},
+		},
+		"CorruptEntry": {
+			reason:   "A malformed entry that is not the last one should return an error.",
+			existing: "garbage\n" + `{"apiVersion":"v1","kind":"Secret","namespace":"default","name":"creds"}` + "\n",
+			resume:   true,
+			want: want{
+				err: true,
+			},
+		},
+		"NoExistingFile": {
+			reason: "Resuming without a checkpoint file should start from scratch.",
+			resume: true,
+			want: want{
+				applied: map[string]bool{"secret": false, "bucket": false},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if tc.existing != "" {
+				if err := afero.WriteFile(fs, "checkpoint", []byte(tc.existing), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			c, err := NewCheckpoint(fs, "checkpoint", tc.resume)
+			if (err != nil) != tc.want.err {
+				t.Fatalf("\n%s\nNewCheckpoint(...): error = %v, want error %t", tc.reason, err, tc.want.err)
+			}
+			if err != nil {
+				return
+			}
+			defer func() { _ = c.Close() }()
+
+			got := map[string]bool{"secret": c.Applied(secret), "bucket": c.Applied(bucket)}
+			if diff := cmp.Diff(tc.want.applied, got); diff != "" {
+				t.Errorf("\n%s\nApplied(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCheckpointRecord(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	bucket := checkpointResource("s3.aws.upbound.io/v1beta1", "Bucket", "", "bucket")
+
+	c, err := NewCheckpoint(fs, "checkpoint", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Record(bucket); err != nil {
+		t.Fatalf("Record(...): %v", err)
+	}
+	if !c.Applied(bucket) {
+		t.Errorf("Applied(...): want true after Record")
+	}
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The entry should be persisted, so a resumed import skips it.
+	c, err = NewCheckpoint(fs, "checkpoint", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = c.Close() }()
+	if !c.Applied(bucket) {
+		t.Errorf("Applied(...): want true after resuming")
+	}
+	if c.Applied(checkpointResource("s3.aws.upbound.io/v1beta2", "Bucket", "", "bucket")) {
+		t.Errorf("Applied(...): want false for a different version")
+	}
+}
+
+func TestApplyResourcesCheckpoint(t *testing.T) {
+	resources := []unstructured.Unstructured{
+		*checkpointResource("example.org/v1", "Widget", "default", "w1"),
+		*checkpointResource("v1", "Namespace", "", "default"),
+		*checkpointResource("example.org/v1", "Widget", "default", "w2"),
+	}
+
+	fs := afero.NewMemMapFs()
+	c, err := NewCheckpoint(fs, "checkpoint", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = c.Close() }()
+	if err := c.Record(&resources[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	var applied []string
+	mapper := &mockRESTMapper{
+		mappingFunc: func(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+			return &meta.RESTMapping{Resource: schema.GroupVersionResource{Group: gk.Group, Version: "v1", Resource: "widgets"}}, nil
+		},
+	}
+	dc := &mockDynamicInterface{
+		resourceFunc: func(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+			return &mockNamespaceableResourceInterface{
+				namespaceFunc: func(ns string) dynamic.ResourceInterface {
+					return &mockResourceInterface{
+						applyFunc: func(ctx context.Context, name string, obj *unstructured.Unstructured, options v1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+							applied = append(applied, name)
+							return obj, nil
+						},
+					}
+				},
+			}
+		},
+	}
+
+	var progress []ApplyProgress
+	a := NewUnstructuredResourceApplier(dc, mapper, ApplierOptions{
+		Checkpoint: c,
+		Progress:   func(p ApplyProgress) { progress = append(progress, p) },
+	})
+	if err := a.ApplyResources(context.Background(), resources, false); err != nil {
+		t.Fatalf("ApplyResources(...): %v", err)
+	}
+
+	if diff := cmp.Diff([]string{"default", "w2"}, applied); diff != "" {
+		t.Errorf("Apply() calls: -want, +got:\n%s", diff)
+	}
+	wantProgress := []ApplyProgress{
+		{Phase: ApplyPhaseNamespaces, Done: 1, Total: 1},
+		{Phase: ApplyPhaseResources, Done: 1, Skipped: 1, Total: 2},
+		{Phase: ApplyPhaseResources, Done: 2, Skipped: 1, Total: 2},
+	}
+	if diff := cmp.Diff(wantProgress, progress); diff != "" {
+		t.Errorf("Progress: -want, +got:\n%s", diff)
+	}
+	for i := range resources {
+		if !c.Applied(&resources[i]) {
+			t.Errorf("Applied(%s): want true after ApplyResources", resources[i].GetName())
+		}
+	}
+}
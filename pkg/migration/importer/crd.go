@@ -0,0 +1,71 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package importer
+
+import (
+	"context"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	defaultEstablishTimeout      = 2 * time.Minute
+	defaultEstablishPollInterval = 2 * time.Second
+)
+
+//nolint:gochecknoglobals // Constant.
+var crdGVR = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+
+// waitForEstablished waits for the given CustomResourceDefinitions to be
+// established, then resets the REST mapper so that the types they define can
+// be mapped.
+func (a *UnstructuredResourceApplier) waitForEstablished(ctx context.Context, crds []*unstructured.Unstructured) error {
+	timeout := a.options.EstablishTimeout
+	if timeout <= 0 {
+		timeout = defaultEstablishTimeout
+	}
+
+	for _, crd := range crds {
+		// lastErr records the most recent error getting the CRD, so that we
+		// can surface it rather than a bare timeout.
+		var lastErr error
+		err := wait.PollUntilContextTimeout(ctx, a.establishPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			u, err := a.dynamicClient.Resource(crdGVR).Namespace("").Get(ctx, crd.GetName(), v1.GetOptions{})
+			if err != nil {
+				lastErr = err
+				return false, nil
+			}
+			return isEstablished(u), nil
+		})
+		if wait.Interrupted(err) && lastErr != nil {
+			err = lastErr
+		}
+		if err != nil {
+			return errors.Wrapf(err, "CustomResourceDefinition %q was not established", crd.GetName())
+		}
+	}
+
+	if rm, ok := a.resourceMapper.(meta.ResettableRESTMapper); ok {
+		rm.Reset()
+	}
+	return nil
+}
+
+// isEstablished returns true if the CustomResourceDefinition is established.
+func isEstablished(u *unstructured.Unstructured) bool {
+	status := xpv1.ConditionedStatus{}
+	if err := fieldpath.Pave(u.Object).GetValueInto("status", &status); err != nil {
+		return false
+	}
+	return status.GetCondition("Established").Status == corev1.ConditionTrue
+}
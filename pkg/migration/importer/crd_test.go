@@ -0,0 +1,179 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package importer
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// establishedCRD returns a CustomResourceDefinition whose Established
+// condition has the given status.
+func establishedCRD(name string, established bool) *unstructured.Unstructured {
+	status := "False"
+	if established {
+		status = "True"
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]interface{}{"name": name},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{
+					"type":   "Established",
+					"status": status,
+				},
+			},
+		},
+	}}
+}
+
+type resettableRESTMapper struct {
+	*mockRESTMapper
+	resetFunc func()
+}
+
+func (m *resettableRESTMapper) Reset() {
+	m.resetFunc()
+}
+
+func TestApplyResourcesWaitsForEstablishedCRDs(t *testing.T) {
+	resources := []unstructured.Unstructured{
+		{Object: map[string]interface{}{"apiVersion": "example.org/v1", "kind": "Widget", "metadata": map[string]interface{}{"name": "w"}}},
+		{Object: map[string]interface{}{"apiVersion": "apiextensions.k8s.io/v1", "kind": "CustomResourceDefinition", "metadata": map[string]interface{}{"name": "widgets.example.org"}}},
+	}
+	testError := errors.New("test error")
+
+	type args struct {
+		// pollsUntilEstablished is the number of times the CRD is polled
+		// before it's established, or -1 if it never is.
+		pollsUntilEstablished int
+		getErr                error
+		timeout               time.Duration
+	}
+	type want struct {
+		// events are the calls made, with repeated calls collapsed.
+		events []string
+		// polls is the number of times the CRD is polled, if not zero.
+		polls int
+		err   string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"EstablishedAfterPolls": {
+			reason: "Resources should be applied once the CRD is established and the REST mapper reset.",
+			args: args{
+				pollsUntilEstablished: 2,
+				timeout:               time.Minute,
+			},
+			want: want{
+				events: []string{"apply widgets.example.org", "get", "reset", "apply w"},
+				polls:  3,
+			},
+		},
+		"NeverEstablished": {
+			reason: "Resources should not be applied if the CRD is never established.",
+			args: args{
+				pollsUntilEstablished: -1,
+				timeout:               50 * time.Millisecond,
+			},
+			want: want{
+				events: []string{"apply widgets.example.org", "get"},
+				err:    `CustomResourceDefinition "widgets.example.org" was not established`,
+			},
+		},
+		"GetFails": {
+			reason: "The last error getting the CRD should be returned if it's never established.",
+			args: args{
+				getErr:  testError,
+				timeout: 50 * time.Millisecond,
+			},
+			want: want{
+				events: []string{"apply widgets.example.org", "get"},
+				err:    "test error",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var (
+				mu     sync.Mutex
+				events []string
+				polls  int
+			)
+			record := func(e string) {
+				mu.Lock()
+				defer mu.Unlock()
+				if len(events) > 0 && events[len(events)-1] == e {
+					return
+				}
+				events = append(events, e)
+			}
+			mapper := &resettableRESTMapper{
+				mockRESTMapper: &mockRESTMapper{
+					mappingFunc: func(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+						return &meta.RESTMapping{Resource: schema.GroupVersionResource{Group: gk.Group, Version: "v1", Resource: strings.ToLower(gk.Kind) + "s"}}, nil
+					},
+				},
+				resetFunc: func() { record("reset") },
+			}
+			dc := &mockDynamicInterface{
+				resourceFunc: func(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+					return &mockNamespaceableResourceInterface{
+						namespaceFunc: func(ns string) dynamic.ResourceInterface {
+							return &mockResourceInterface{
+								applyFunc: func(ctx context.Context, name string, obj *unstructured.Unstructured, options v1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+									record("apply " + name)
+									return obj, nil
+								},
+								getFunc: func(ctx context.Context, name string, options v1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+									record("get")
+									if tc.args.getErr != nil {
+										return nil, tc.args.getErr
+									}
+									established := tc.args.pollsUntilEstablished >= 0 && polls >= tc.args.pollsUntilEstablished
+									polls++
+									return establishedCRD(name, established), nil
+								},
+							}
+						},
+					}
+				},
+			}
+			a := NewUnstructuredResourceApplier(dc, mapper, ApplierOptions{EstablishTimeout: tc.args.timeout})
+			a.establishPollInterval = 10 * time.Millisecond
+
+			err := a.ApplyResources(context.Background(), resources, false)
+			gotErr := ""
+			if err != nil {
+				gotErr = err.Error()
+			}
+			if !strings.Contains(gotErr, tc.want.err) || (tc.want.err == "") != (err == nil) {
+				t.Errorf("\n%s\nApplyResources(...): error = %v, want error containing %q", tc.reason, err, tc.want.err)
+			}
+			if strings.Join(events, ",") != strings.Join(tc.want.events, ",") {
+				t.Errorf("\n%s\nApplyResources(...): events = %v, want %v", tc.reason, events, tc.want.events)
+			}
+			if tc.want.polls != 0 && polls != tc.want.polls {
+				t.Errorf("\n%s\nApplyResources(...): polled %d times, want %d", tc.reason, polls, tc.want.polls)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package importer
+
+import (
+	"slices"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// DryRunAction is what applying a resource would do to the target control
+// plane.
+type DryRunAction string
+
+const (
+	// DryRunActionCreate means the resource does not exist and would be
+	// created.
+	DryRunActionCreate DryRunAction = "create"
+	// DryRunActionUpdate means the resource exists and would be modified.
+	DryRunActionUpdate DryRunAction = "update"
+	// DryRunActionUnchanged means the resource exists and would not be
+	// modified.
+	DryRunActionUnchanged DryRunAction = "unchanged"
+	// DryRunActionUnknown means the resource's type is not served by the
+	// target control plane yet, so the API server could not evaluate it. This
+	// is expected for resources whose types are installed by packages or
+	// XRDs that are part of the same import.
+	DryRunActionUnknown DryRunAction = "unknown"
+)
+
+// DryRunResult is the outcome of a dry-run apply of a single resource.
+type DryRunResult struct {
+	GroupVersionKind schema.GroupVersionKind
+	Namespace        string
+	Name             string
+	Action           DryRunAction
+	// Diff is a human-readable diff between the existing and the would-be
+	// resource. It is only set for DryRunActionUpdate.
+	Diff string
+}
+
+// DryRunSummary counts dry-run results by action.
+type DryRunSummary map[DryRunAction]int
+
+// Summarize counts the given results by action.
+func Summarize(results []DryRunResult) DryRunSummary {
+	s := DryRunSummary{}
+	for _, r := range results {
+		s[r.Action]++
+	}
+	return s
+}
+
+// DryRunResults returns the results recorded by ApplyResources in dry-run
+// mode, in the order they were recorded.
+func (a *UnstructuredResourceApplier) DryRunResults() []DryRunResult {
+	a.dryRunMu.Lock()
+	defer a.dryRunMu.Unlock()
+
+	return slices.Clone(a.dryRunResults)
+}
+
+// recordDryRun records the dry-run result for u, given the resource as it
+// currently exists (nil if it doesn't) and as it would be after the apply (nil
+// if the API server couldn't evaluate it).
+func (a *UnstructuredResourceApplier) recordDryRun(u, existing, applied *unstructured.Unstructured) {
+	r := DryRunResult{
+		GroupVersionKind: u.GroupVersionKind(),
+		Namespace:        u.GetNamespace(),
+		Name:             u.GetName(),
+	}
+
+	switch {
+	case applied == nil:
+		r.Action = DryRunActionUnknown
+	case existing == nil:
+		r.Action = DryRunActionCreate
+	default:
+		r.Diff = cmp.Diff(withoutVolatileMetadata(existing), withoutVolatileMetadata(applied))
+		r.Action = DryRunActionUnchanged
+		if r.Diff != "" {
+			r.Action = DryRunActionUpdate
+		}
+	}
+
+	a.dryRunMu.Lock()
+	defer a.dryRunMu.Unlock()
+	a.dryRunResults = append(a.dryRunResults, r)
+}
+
+// withoutVolatileMetadata returns the content of u without the metadata fields that the API
+// server changes on every write, so that only meaningful changes are diffed.
+func withoutVolatileMetadata(u *unstructured.Unstructured) map[string]any {
+	c := u.DeepCopy()
+	for _, f := range []string{"managedFields", "resourceVersion", "generation"} {
+		unstructured.RemoveNestedField(c.Object, "metadata", f)
+	}
+	return c.Object
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package importer
+
+import (
+	"path"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// GVKFilter selects resources to import by their type. Patterns have the form
+// Kind.group, or just Kind for the core API group, and may contain shell-style
+// wildcards, e.g. "Event", "Secret" or "*.aws.upbound.io".
+type GVKFilter struct {
+	// Include, if not empty, limits the import to resources matching at least
+	// one of these patterns.
+	Include []string
+	// Exclude skips resources matching any of these patterns. Exclusion takes
+	// precedence over inclusion.
+	Exclude []string
+}
+
+// Validate returns an error if any of the filter's patterns are malformed.
+func (f GVKFilter) Validate() error {
+	for _, p := range append(append([]string{}, f.Include...), f.Exclude...) {
+		if _, err := path.Match(p, ""); err != nil {
+			return errors.Wrapf(err, "invalid resource type pattern %q", p)
+		}
+	}
+	return nil
+}
+
+// Matches returns true if resources of the given type should be imported.
+func (f GVKFilter) Matches(gvk schema.GroupVersionKind) bool {
+	if matchesAny(f.Exclude, gvk.GroupKind()) {
+		return false
+	}
+	return len(f.Include) == 0 || matchesAny(f.Include, gvk.GroupKind())
+}
+
+func matchesAny(patterns []string, gk schema.GroupKind) bool {
+	s := gk.Kind
+	if gk.Group != "" {
+		s += "." + gk.Group
+	}
+	for _, p := range patterns {
+		// Patterns are validated up front, so we can ignore the error here.
+		if ok, _ := path.Match(p, s); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// GroupRemapper returns a function that maps API groups using the given
+// old-to-new mapping. Groups not in the mapping are returned unchanged.
+func GroupRemapper(m map[string]string) func(group string) string {
+	return func(group string) string {
+		if to, ok := m[group]; ok {
+			return to
+		}
+		return group
+	}
+}
+
+// Selects returns true if the applier applies the given resource, rather than
+// skipping it because of its filter.
+func (a *UnstructuredResourceApplier) Selects(u unstructured.Unstructured) bool {
+	return a.options.Filter == nil || a.options.Filter.Matches(u.GroupVersionKind())
+}
+
+// filterResources returns the resources that should be applied, with their API
+// groups remapped and transforms applied.
+func (a *UnstructuredResourceApplier) filterResources(resources []unstructured.Unstructured) ([]unstructured.Unstructured, error) {
+	if a.options.Filter == nil && a.options.RemapGroup == nil && len(a.options.Transforms) == 0 {
+		return resources, nil
+	}
+
+	out := make([]unstructured.Unstructured, 0, len(resources))
+	for _, u := range resources {
+		if !a.Selects(u) {
+			continue
+		}
+		gvk := u.GroupVersionKind()
+		// Copy the object, so we don't modify the caller's resources.
+		u = *u.DeepCopy()
+		if a.options.RemapGroup != nil {
+			gvk.Group = a.options.RemapGroup(gvk.Group)
+			u.SetGroupVersionKind(gvk)
+		}
+		for _, t := range a.options.Transforms {
+			if err := t(&u); err != nil {
+				return nil, errors.Wrapf(err, "cannot transform resource %s/%s", u.GetKind(), u.GetName())
+			}
+		}
+		out = append(out, u)
+	}
+	return out, nil
+}
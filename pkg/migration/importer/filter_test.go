@@ -0,0 +1,177 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package importer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/upbound/up/pkg/migration/meta/v1alpha1"
+)
+
+func TestGVKFilterMatches(t *testing.T) {
+	type args struct {
+		filter GVKFilter
+		gvk    schema.GroupVersionKind
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NoPatterns": {
+			args: args{
+				gvk: schema.GroupVersionKind{Version: "v1", Kind: "Secret"},
+			},
+			want: true,
+		},
+		"ExcludedCoreKind": {
+			args: args{
+				filter: GVKFilter{Exclude: []string{"Secret"}},
+				gvk:    schema.GroupVersionKind{Version: "v1", Kind: "Secret"},
+			},
+			want: false,
+		},
+		"CoreKindPatternDoesNotMatchOtherGroups": {
+			args: args{
+				filter: GVKFilter{Exclude: []string{"Event"}},
+				gvk:    schema.GroupVersionKind{Group: "events.k8s.io", Version: "v1", Kind: "Event"},
+			},
+			want: true,
+		},
+		"ExcludedByWildcard": {
+			args: args{
+				filter: GVKFilter{Exclude: []string{"*.aws.upbound.io"}},
+				gvk:    schema.GroupVersionKind{Group: "ec2.aws.upbound.io", Version: "v1beta1", Kind: "VPC"},
+			},
+			want: false,
+		},
+		"NotIncluded": {
+			args: args{
+				filter: GVKFilter{Include: []string{"*.example.org"}},
+				gvk:    schema.GroupVersionKind{Group: "other.org", Version: "v1", Kind: "Widget"},
+			},
+			want: false,
+		},
+		"ExcludeWinsOverInclude": {
+			args: args{
+				filter: GVKFilter{Include: []string{"*.example.org"}, Exclude: []string{"Widget.example.org"}},
+				gvk:    schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Widget"},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.args.filter.Matches(tc.args.gvk)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Matches() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGVKFilterValidate(t *testing.T) {
+	if err := (GVKFilter{Include: []string{"[a-"}}).Validate(); err == nil {
+		t.Errorf("Validate() error = nil, want error for malformed pattern")
+	}
+	if err := (GVKFilter{Include: []string{"*.example.org"}, Exclude: []string{"Secret"}}).Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+}
+
+func TestApplyResourcesFilter(t *testing.T) {
+	resources := []unstructured.Unstructured{
+		{Object: map[string]interface{}{"apiVersion": "v1", "kind": "Event", "metadata": map[string]interface{}{"name": "e"}}},
+		{Object: map[string]interface{}{"apiVersion": "old.example.org/v1", "kind": "Widget", "metadata": map[string]interface{}{"name": "w"}}},
+	}
+
+	var mapped []schema.GroupKind
+	var applied []string
+	mapper := &mockRESTMapper{
+		mappingFunc: func(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+			mapped = append(mapped, gk)
+			return &meta.RESTMapping{Resource: schema.GroupVersionResource{Group: gk.Group, Version: "v1", Resource: "widgets"}}, nil
+		},
+	}
+	dc := &mockDynamicInterface{
+		resourceFunc: func(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+			return &mockNamespaceableResourceInterface{
+				namespaceFunc: func(ns string) dynamic.ResourceInterface {
+					return &mockResourceInterface{
+						applyFunc: func(ctx context.Context, name string, obj *unstructured.Unstructured, options v1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+							applied = append(applied, obj.GetAPIVersion()+"/"+obj.GetKind()+"/"+name)
+							return obj, nil
+						},
+					}
+				},
+			}
+		},
+	}
+	a := NewUnstructuredResourceApplier(dc, mapper, ApplierOptions{
+		Filter:     &GVKFilter{Exclude: []string{"Event"}},
+		RemapGroup: GroupRemapper(map[string]string{"old.example.org": "new.example.org"}),
+	})
+
+	if err := a.ApplyResources(context.Background(), resources, false); err != nil {
+		t.Fatalf("ApplyResources() error = %v, want nil", err)
+	}
+
+	if diff := cmp.Diff([]schema.GroupKind{{Group: "new.example.org", Kind: "Widget"}}, mapped); diff != "" {
+		t.Errorf("RESTMapping() calls mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"new.example.org/v1/Widget/w"}, applied); diff != "" {
+		t.Errorf("Apply() calls mismatch (-want +got):\n%s", diff)
+	}
+}
+
+type resourceReaderFn func(groupResource string) ([]unstructured.Unstructured, *v1alpha1.TypeMeta, error)
+
+func (fn resourceReaderFn) ReadResources(groupResource string) ([]unstructured.Unstructured, *v1alpha1.TypeMeta, error) {
+	return fn(groupResource)
+}
+
+func TestImportResourcesCountsFiltered(t *testing.T) {
+	r := resourceReaderFn(func(string) ([]unstructured.Unstructured, *v1alpha1.TypeMeta, error) {
+		return []unstructured.Unstructured{
+			{Object: map[string]interface{}{"apiVersion": "v1", "kind": "Event", "metadata": map[string]interface{}{"name": "e"}}},
+			{Object: map[string]interface{}{"apiVersion": "example.org/v1", "kind": "Widget", "metadata": map[string]interface{}{"name": "w"}}},
+		}, nil, nil
+	})
+	mapper := &mockRESTMapper{
+		mappingFunc: func(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+			return &meta.RESTMapping{Resource: schema.GroupVersionResource{Group: gk.Group, Version: "v1", Resource: "widgets"}}, nil
+		},
+	}
+	dc := &mockDynamicInterface{
+		resourceFunc: func(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+			return &mockNamespaceableResourceInterface{
+				namespaceFunc: func(ns string) dynamic.ResourceInterface {
+					return &mockResourceInterface{
+						applyFunc: func(ctx context.Context, name string, obj *unstructured.Unstructured, options v1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+							return obj, nil
+						},
+					}
+				},
+			}
+		},
+	}
+	a := NewUnstructuredResourceApplier(dc, mapper, ApplierOptions{
+		Filter: &GVKFilter{Exclude: []string{"Event"}},
+	})
+
+	got, err := NewPausingResourceImporter(r, a).ImportResources(context.Background(), "widgets.example.org", false, true, "", "")
+	if err != nil {
+		t.Fatalf("ImportResources() error = %v, want nil", err)
+	}
+	if got != 1 {
+		t.Errorf("ImportResources() = %d, want 1 resource imported, not counting the filtered Event", got)
+	}
+}
@@ -83,6 +83,8 @@ type Options struct {
 	MCPConnectorClusterID string
 	// MCPConnectorClaimNamespace indicates that claims names will be adjusted for MCP Connector compatibility.
 	MCPConnectorClaimNamespace string
+	// ApplierOptions configure how resources are applied to the target control plane.
+	ApplierOptions ApplierOptions
 }
 
 // ControlPlaneStateImporter is the importer for control plane state.
@@ -92,7 +94,11 @@ type ControlPlaneStateImporter struct {
 	appsClient      appsv1.AppsV1Interface
 	resourceMapper  meta.ResettableRESTMapper
 
-	fs *afero.Afero
+	fs      *afero.Afero
+	applier *UnstructuredResourceApplier
+	// stepProgress reports ApplyResources progress for the current import
+	// step, typically on its spinner.
+	stepProgress func(p ApplyProgress)
 
 	options Options
 }
@@ -133,7 +139,17 @@ func (im *ControlPlaneStateImporter) Import(ctx context.Context) error {
 	//////////////////////////////////////////
 	// Pausing resource importer will import all resources.
 	// It will import all Claims, Composites and Managed resource with the `crossplane.io/paused` annotation set to `true`.
-	r := NewPausingResourceImporter(NewFileSystemReader(*im.fs), NewUnstructuredResourceApplier(im.dynamicClient, im.resourceMapper))
+	ao := im.options.ApplierOptions
+	ao.Progress = func(p ApplyProgress) {
+		if im.stepProgress != nil {
+			im.stepProgress(p)
+		}
+		if im.options.ApplierOptions.Progress != nil {
+			im.options.ApplierOptions.Progress(p)
+		}
+	}
+	im.applier = NewUnstructuredResourceApplier(im.dynamicClient, im.resourceMapper, ao)
+	r := NewPausingResourceImporter(NewFileSystemReader(*im.fs), im.applier)
 
 	total := 0
 
@@ -145,6 +161,7 @@ func (im *ControlPlaneStateImporter) Import(ctx context.Context) error {
 	s.Start()
 	baseCounts := make(map[string]int, len(baseResources))
 	for i, gr := range baseResources {
+		im.stepProgress = spinnerProgress(s, fmt.Sprintf("(%d / %d) Importing %s...", i, len(baseResources), gr))
 		count, err := r.ImportResources(ctx, gr, false, im.options.PausedBeforeExport, im.options.MCPConnectorClusterID, im.options.MCPConnectorClaimNamespace)
 		if err != nil {
 			s.UpdateText(importBaseMsg + stepFailed)
@@ -162,6 +179,12 @@ func (im *ControlPlaneStateImporter) Import(ctx context.Context) error {
 	s.Success()
 	//////////////////////////////////////////
 
+	if im.options.ApplierOptions.DryRun {
+		// Nothing was persisted, so there are no packages or XRDs to wait
+		// for, and nothing to unpause.
+		return im.importRemaining(ctx, r)
+	}
+
 	// Wait for all Packages and XRDs to be ready before importing the resources that depend on them.
 	waitPkgsMsg := "Waiting for Packages... "
 	s = migration.DefaultSpinner(waitPkgsMsg)
@@ -195,9 +218,53 @@ func (im *ControlPlaneStateImporter) Import(ctx context.Context) error {
 	// Reset the resource mapper to make sure all CRDs introduced by packages or XRDs are available.
 	im.resourceMapper.Reset()
 
-	// Import remaining resources other than the base resources.
+	if err := im.importRemaining(ctx, r); err != nil {
+		return err
+	}
+	//////////////////////////////////////////
+
+	// At this stage, all the resources are imported, but Claims/Composites and
+	// Managed resources are paused. In the finalization step, we will unpause
+	// Claims and Composites but not Managed resources (i.e. not activate the
+	// control plane yet). If a resource was previously paused, and have the
+	// "migration.upbound.io/already-paused: true" annotation we will not remove
+	// paused.
+	finalizeMsg := "Finalizing import... "
+	s = migration.DefaultSpinner(finalizeMsg)
+	s.Start()
+	cm := category.NewAPICategoryModifier(im.dynamicClient, im.discoveryClient)
+	categories := []string{"composite", "claim"}
+	for _, category := range categories {
+		unpauseMsg := fmt.Sprintf("Unpausing %s resources ... ", category)
+		s.UpdateText(unpauseMsg)
+		if err := r.UnpauseResources(ctx, category, cm); err != nil {
+			s.Fail()
+			return errors.Wrapf(err, "cannot unpause %s", category)
+		}
+	}
+	s.UpdateText(finalizeMsg + "Done! 🎉")
+	s.Success()
+
+	if im.options.UnpauseAfterImport {
+		unpauseMsg := "Unpausing managed resources ... "
+		s := migration.DefaultSpinner(unpauseMsg)
+		s.Start()
+		if err := r.UnpauseResources(ctx, "managed", cm); err != nil {
+			s.Fail()
+			return errors.Wrap(err, "cannot unpause managed resources")
+		}
+		s.UpdateText(unpauseMsg + "Done! ▶️")
+		s.Success()
+	}
+	//////////////////////////////////////////
+
+	return nil
+}
+
+// importRemaining imports all resources other than the base resources.
+func (im *ControlPlaneStateImporter) importRemaining(ctx context.Context, r *PausingResourceImporter) error {
 	importRemainingMsg := "Importing remaining resources... "
-	s = migration.DefaultSpinner(importRemainingMsg)
+	s := migration.DefaultSpinner(importRemainingMsg)
 	s.Start()
 	grs, err := im.fs.ReadDir("/")
 	if err != nil {
@@ -220,6 +287,7 @@ func (im *ControlPlaneStateImporter) Import(ctx context.Context) error {
 			continue
 		}
 
+		im.stepProgress = spinnerProgress(s, fmt.Sprintf("(%d / %d) Importing %s...", i, len(grs), info.Name()))
 		count, err := r.ImportResources(ctx, info.Name(), true, im.options.PausedBeforeExport, im.options.MCPConnectorClusterID, im.options.MCPConnectorClaimNamespace)
 		if err != nil {
 			return errors.Wrapf(err, "cannot import %q resources", info.Name())
@@ -227,51 +295,36 @@ func (im *ControlPlaneStateImporter) Import(ctx context.Context) error {
 		remainingCounts[info.Name()] = count
 		s.UpdateText(fmt.Sprintf("(%d / %d) Importing %s...", i, len(grs), info.Name()))
 	}
-	total = 0
+	total := 0
 	for _, count := range remainingCounts {
 		total += count
 	}
 
 	s.UpdateText(importRemainingMsg + fmt.Sprintf("%d resources imported! 📥", total))
 	s.Success()
-	//////////////////////////////////////////
 
-	// At this stage, all the resources are imported, but Claims/Composites and
-	// Managed resources are paused. In the finalization step, we will unpause
-	// Claims and Composites but not Managed resources (i.e. not activate the
-	// control plane yet). If a resource was previously paused, and have the
-	// "migration.upbound.io/already-paused: true" annotation we will not remove
-	// paused.
-	finalizeMsg := "Finalizing import... "
-	s = migration.DefaultSpinner(finalizeMsg)
-	s.Start()
-	cm := category.NewAPICategoryModifier(im.dynamicClient, im.discoveryClient)
-	categories := []string{"composite", "claim"}
-	for _, category := range categories {
-		unpauseMsg := fmt.Sprintf("Unpausing %s resources ... ", category)
-		s.UpdateText(unpauseMsg)
-		if err := r.UnpauseResources(ctx, category, cm); err != nil {
-			s.Fail()
-			return errors.Wrapf(err, "cannot unpause %s", category)
-		}
-	}
-	s.UpdateText(finalizeMsg + "Done! 🎉")
-	s.Success()
+	return nil
+}
 
-	if im.options.UnpauseAfterImport {
-		unpauseMsg := "Unpausing managed resources ... "
-		s := migration.DefaultSpinner(unpauseMsg)
-		s.Start()
-		if err := r.UnpauseResources(ctx, "managed", cm); err != nil {
-			s.Fail()
-			return errors.Wrap(err, "cannot unpause managed resources")
+// spinnerProgress returns a function that reports ApplyResources progress on
+// the spinner, following the given message.
+func spinnerProgress(s migration.Spinner, msg string) func(p ApplyProgress) {
+	return func(p ApplyProgress) {
+		text := fmt.Sprintf("%s %s: %d / %d", msg, p.Phase, p.Done, p.Total)
+		if p.Skipped > 0 {
+			text += fmt.Sprintf(" (%d already applied)", p.Skipped)
 		}
-		s.UpdateText(unpauseMsg + "Done! ▶️")
-		s.Success()
+		s.UpdateText(text)
 	}
-	//////////////////////////////////////////
+}
 
-	return nil
+// DryRunResults returns what the last Import would have done to each resource
+// when run in dry-run mode.
+func (im *ControlPlaneStateImporter) DryRunResults() []DryRunResult {
+	if im.applier == nil {
+		return nil
+	}
+	return im.applier.DryRunResults()
 }
 
 // PreflightChecks performs preflight checks.
@@ -143,7 +143,28 @@ func (im *PausingResourceImporter) ImportResources(ctx context.Context, gr strin
 		return 0, errors.Wrapf(err, "cannot apply %q resources", gr)
 	}
 
-	return len(resources), nil
+	return im.applied(resources), nil
+}
+
+// resourceSelector is implemented by appliers that skip some of the resources
+// they're asked to apply.
+type resourceSelector interface {
+	Selects(u unstructured.Unstructured) bool
+}
+
+// applied returns how many of the given resources the applier applies.
+func (im *PausingResourceImporter) applied(resources []unstructured.Unstructured) int {
+	s, ok := im.applier.(resourceSelector)
+	if !ok {
+		return len(resources)
+	}
+	n := 0
+	for _, u := range resources {
+		if s.Selects(u) {
+			n++
+		}
+	}
+	return n
 }
 
 func (im *PausingResourceImporter) UnpauseResources(ctx context.Context, resourceType string, cm *category.APICategoryModifier) error {
@@ -0,0 +1,64 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package importer
+
+import (
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// TransformDropStatus is the name of the DropStatus transform.
+	TransformDropStatus = "drop-status"
+	// TransformStripManagedFields is the name of the StripManagedFields
+	// transform.
+	TransformStripManagedFields = "strip-managed-fields"
+)
+
+// A Transform modifies a resource before it's applied.
+type Transform func(u *unstructured.Unstructured) error
+
+// BuiltinTransform returns the built-in transform with the given name.
+func BuiltinTransform(name string) (Transform, error) {
+	switch name {
+	case TransformDropStatus:
+		return DropStatus, nil
+	case TransformStripManagedFields:
+		return StripManagedFields, nil
+	default:
+		return nil, errors.Errorf("unknown transform %q, must be one of %q or %q", name, TransformDropStatus, TransformStripManagedFields)
+	}
+}
+
+// DropStatus removes a resource's status, so that it isn't restored and is
+// instead populated by the target control plane.
+func DropStatus(u *unstructured.Unstructured) error {
+	unstructured.RemoveNestedField(u.Object, "status")
+	return nil
+}
+
+// StripManagedFields removes a resource's managed fields.
+func StripManagedFields(u *unstructured.Unstructured) error {
+	u.SetManagedFields(nil)
+	return nil
+}
+
+// NamespaceRemapper returns a transform that moves resources between
+// namespaces using the given old-to-new mapping, and renames the namespaces
+// themselves. Only a resource's own namespace is remapped; references to
+// other resources are left unchanged.
+func NamespaceRemapper(m map[string]string) Transform {
+	return func(u *unstructured.Unstructured) error {
+		if gk := u.GroupVersionKind().GroupKind(); gk == namespaceGroupKind {
+			if to, ok := m[u.GetName()]; ok {
+				u.SetName(to)
+			}
+			return nil
+		}
+		if to, ok := m[u.GetNamespace()]; ok && u.GetNamespace() != "" {
+			u.SetNamespace(to)
+		}
+		return nil
+	}
+}
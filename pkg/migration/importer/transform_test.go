@@ -0,0 +1,224 @@
+// Copyright 2025 Upbound Inc.
+// All rights reserved
+
+package importer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+func TestTransforms(t *testing.T) {
+	// Setup test resource
+	testResource := func() *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "test.upbound.io/v1",
+				"kind":       "TestResource",
+				"metadata": map[string]interface{}{
+					"name":      "test-resource",
+					"namespace": "test-namespace",
+					"managedFields": []interface{}{
+						map[string]interface{}{
+							"manager":   "crossplane",
+							"operation": "Apply",
+						},
+					},
+				},
+				"spec": map[string]interface{}{
+					"foo": "bar",
+				},
+				"status": map[string]interface{}{
+					"atProvider": map[string]interface{}{
+						"id": "test-id",
+					},
+				},
+			},
+		}
+	}
+	testNamespace := func(name string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Namespace",
+				"metadata": map[string]interface{}{
+					"name": name,
+				},
+			},
+		}
+	}
+
+	type args struct {
+		transform Transform
+		u         *unstructured.Unstructured
+	}
+	type want struct {
+		u   *unstructured.Unstructured
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"DropStatus": {
+			reason: "DropStatus should remove the resource's status.",
+			args: args{
+				transform: DropStatus,
+				u:         testResource(),
+			},
+			want: want{
+				u: func() *unstructured.Unstructured {
+					u := testResource()
+					delete(u.Object, "status")
+					return u
+				}(),
+			},
+		},
+		"StripManagedFields": {
+			reason: "StripManagedFields should remove the resource's managed fields.",
+			args: args{
+				transform: StripManagedFields,
+				u:         testResource(),
+			},
+			want: want{
+				u: func() *unstructured.Unstructured {
+					u := testResource()
+					unstructured.RemoveNestedField(u.Object, "metadata", "managedFields")
+					return u
+				}(),
+			},
+		},
+		"RemapNamespace": {
+			reason: "NamespaceRemapper should move a resource in a mapped namespace.",
+			args: args{
+				transform: NamespaceRemapper(map[string]string{"test-namespace": "new-namespace"}),
+				u:         testResource(),
+			},
+			want: want{
+				u: func() *unstructured.Unstructured {
+					u := testResource()
+					u.SetNamespace("new-namespace")
+					return u
+				}(),
+			},
+		},
+		"RemapNamespaceUnmapped": {
+			reason: "NamespaceRemapper should not move a resource in a namespace that isn't mapped.",
+			args: args{
+				transform: NamespaceRemapper(map[string]string{"other-namespace": "new-namespace"}),
+				u:         testResource(),
+			},
+			want: want{
+				u: testResource(),
+			},
+		},
+		"RemapNamespaceRenamesNamespace": {
+			reason: "NamespaceRemapper should rename a mapped namespace.",
+			args: args{
+				transform: NamespaceRemapper(map[string]string{"test-namespace": "new-namespace"}),
+				u:         testNamespace("test-namespace"),
+			},
+			want: want{
+				u: testNamespace("new-namespace"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.transform(tc.args.u)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nTransform(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.u, tc.args.u); diff != "" {
+				t.Errorf("\n%s\nTransform(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestBuiltinTransform(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		name   string
+		err    error
+	}{
+		"DropStatus": {
+			reason: "The drop-status transform should be built in.",
+			name:   TransformDropStatus,
+		},
+		"StripManagedFields": {
+			reason: "The strip-managed-fields transform should be built in.",
+			name:   TransformStripManagedFields,
+		},
+		"Unknown": {
+			reason: "An unknown transform should return an error.",
+			name:   "make-it-better",
+			err:    errors.Errorf("unknown transform %q, must be one of %q or %q", "make-it-better", TransformDropStatus, TransformStripManagedFields),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := BuiltinTransform(tc.name)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nBuiltinTransform(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if (got != nil) != (tc.err == nil) {
+				t.Errorf("\n%s\nBuiltinTransform(...): got transform %t, want %t", tc.reason, got != nil, tc.err == nil)
+			}
+		})
+	}
+}
+
+func TestApplyResourcesTransforms(t *testing.T) {
+	resources := []unstructured.Unstructured{
+		{Object: map[string]interface{}{"apiVersion": "example.org/v1", "kind": "Widget", "metadata": map[string]interface{}{"name": "w", "namespace": "old"}}},
+	}
+
+	var applied []string
+	mapper := &mockRESTMapper{
+		mappingFunc: func(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+			return &meta.RESTMapping{Resource: schema.GroupVersionResource{Group: gk.Group, Version: "v1", Resource: "widgets"}}, nil
+		},
+	}
+	dc := &mockDynamicInterface{
+		resourceFunc: func(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+			return &mockNamespaceableResourceInterface{
+				namespaceFunc: func(ns string) dynamic.ResourceInterface {
+					return &mockResourceInterface{
+						applyFunc: func(ctx context.Context, name string, obj *unstructured.Unstructured, options v1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+							applied = append(applied, ns+"/"+name)
+							return obj, nil
+						},
+					}
+				},
+			}
+		},
+	}
+	a := NewUnstructuredResourceApplier(dc, mapper, ApplierOptions{
+		Transforms: []Transform{NamespaceRemapper(map[string]string{"old": "new"})},
+	})
+
+	if err := a.ApplyResources(context.Background(), resources, false); err != nil {
+		t.Fatalf("ApplyResources() error = %v, want nil", err)
+	}
+
+	if diff := cmp.Diff([]string{"new/w"}, applied); diff != "" {
+		t.Errorf("Apply() calls mismatch (-want +got):\n%s", diff)
+	}
+	if got := resources[0].GetNamespace(); got != "old" {
+		t.Errorf("ApplyResources() modified the caller's resource: namespace = %q, want %q", got, "old")
+	}
+}
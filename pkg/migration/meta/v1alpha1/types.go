@@ -52,6 +52,12 @@ type ExportOptions struct {
 	IncludedExtraResources []string `json:"includedExtraResources,omitempty" yaml:"includedResources,omitempty"`
 	// ExcludedResources are the resources excluded from the export.
 	ExcludedResources []string `json:"excludedResources,omitempty" yaml:"excludedResources,omitempty"`
+	// IncludedTypes are the resource types included in the export.
+	IncludedTypes []string `json:"includedTypes,omitempty" yaml:"includedTypes,omitempty"`
+	// ExcludedTypes are the resource types excluded from the export.
+	ExcludedTypes []string `json:"excludedTypes,omitempty" yaml:"excludedTypes,omitempty"`
+	// LabelSelector selects the resources included in the export by label.
+	LabelSelector string `json:"labelSelector,omitempty" yaml:"labelSelector,omitempty"`
 	// PausedBeforeExport stores whether the resources were paused before the export.
 	PausedBeforeExport bool `json:"pausedBeforeExport,omitempty" yaml:"pausedBeforeExport,omitempty"`
 }